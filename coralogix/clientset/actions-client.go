@@ -36,6 +36,19 @@ func (a ActionsClient) GetAction(ctx context.Context, req *actions.GetActionRequ
 	return client.GetAction(callProperties.Ctx, req, callProperties.CallOptions...)
 }
 
+func (a ActionsClient) ListActions(ctx context.Context, req *actions.ListActionsRequest) (*actions.ListActionsResponse, error) {
+	callProperties, err := a.callPropertiesCreator.GetCallProperties(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := callProperties.Connection
+	defer conn.Close()
+	client := actions.NewActionsServiceClient(conn)
+
+	return client.ListActions(callProperties.Ctx, req, callProperties.CallOptions...)
+}
+
 func (a ActionsClient) UpdateAction(ctx context.Context, req *actions.ReplaceActionRequest) (*actions.ReplaceActionResponse, error) {
 	callProperties, err := a.callPropertiesCreator.GetCallProperties(ctx)
 	if err != nil {
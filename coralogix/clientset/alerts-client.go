@@ -36,6 +36,19 @@ func (a AlertsClient) GetAlert(ctx context.Context, req *alerts.GetAlertByUnique
 	return client.GetAlertByUniqueId(callProperties.Ctx, req, callProperties.CallOptions...)
 }
 
+func (a AlertsClient) GetAlerts(ctx context.Context, req *alerts.GetAlertsRequest) (*alerts.GetAlertsResponse, error) {
+	callProperties, err := a.callPropertiesCreator.GetCallProperties(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := callProperties.Connection
+	defer conn.Close()
+	client := alerts.NewAlertServiceClient(conn)
+
+	return client.GetAlerts(callProperties.Ctx, req, callProperties.CallOptions...)
+}
+
 func (a AlertsClient) UpdateAlert(ctx context.Context, req *alerts.UpdateAlertByUniqueIdRequest) (*alerts.UpdateAlertByUniqueIdResponse, error) {
 	callProperties, err := a.callPropertiesCreator.GetCallProperties(ctx)
 	if err != nil {
@@ -4,17 +4,24 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
+	"net/url"
+	"strings"
 	"time"
 
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 )
 
 type CallPropertiesCreator struct {
 	targetUrl string
 	apiKey    string
+	// restUrl and insecure are set for custom endpoints (e.g. a local mock server), which serve the gRPC and
+	// the REST APIs on the same address.
+	restUrl  string
+	insecure bool
 	//allowRetry bool
 }
 
@@ -27,7 +34,7 @@ type CallProperties struct {
 func (c CallPropertiesCreator) GetCallProperties(ctx context.Context) (*CallProperties, error) {
 	ctx = createAuthContext(ctx, c.apiKey)
 
-	conn, err := createSecureConnection(c.targetUrl)
+	conn, err := createConnection(c.targetUrl, c.insecure)
 	if err != nil {
 		return nil, err
 	}
@@ -44,7 +51,11 @@ func createCallOptions() []grpc.CallOption {
 	return callOptions
 }
 
-func createSecureConnection(targetUrl string) (*grpc.ClientConn, error) {
+func createConnection(targetUrl string, insecureConnection bool) (*grpc.ClientConn, error) {
+	if insecureConnection {
+		return grpc.Dial(targetUrl,
+			grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
 	return grpc.Dial(targetUrl,
 		grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{})))
 }
@@ -55,7 +66,27 @@ func createAuthContext(ctx context.Context, apiKey string) context.Context {
 	return ctx
 }
 
+// restTargetUrl returns the base url of a REST API, whose host replaces grpcHostPart with restHostPart in the
+// gRPC host.
+func (c CallPropertiesCreator) restTargetUrl(grpcHostPart, restHostPart string) string {
+	if c.restUrl != "" {
+		return c.restUrl
+	}
+	return "https://" + strings.Replace(c.targetUrl, grpcHostPart, restHostPart, 1)
+}
+
+// NewCallPropertiesCreator accepts either a gRPC host:port, or a custom endpoint url (http://host:port or
+// https://host:port) which serves both the gRPC and the REST APIs.
 func NewCallPropertiesCreator(targetUrl, apiKey string) *CallPropertiesCreator {
+	if endpoint, err := url.Parse(targetUrl); err == nil && (endpoint.Scheme == "http" || endpoint.Scheme == "https") {
+		return &CallPropertiesCreator{
+			targetUrl: endpoint.Host,
+			apiKey:    apiKey,
+			restUrl:   strings.TrimRight(targetUrl, "/"),
+			insecure:  endpoint.Scheme == "http",
+		}
+	}
+
 	return &CallPropertiesCreator{
 		targetUrl: targetUrl,
 		apiKey:    apiKey,
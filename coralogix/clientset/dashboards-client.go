@@ -36,6 +36,19 @@ func (d DashboardsClient) GetDashboard(ctx context.Context, req *dashboards.GetD
 	return client.GetDashboard(callProperties.Ctx, req, callProperties.CallOptions...)
 }
 
+func (d DashboardsClient) GetDashboardCatalog(ctx context.Context, req *dashboards.GetDashboardCatalogRequest) (*dashboards.GetDashboardCatalogResponse, error) {
+	callProperties, err := d.callPropertiesCreator.GetCallProperties(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := callProperties.Connection
+	defer conn.Close()
+	client := dashboards.NewDashboardCatalogServiceClient(conn)
+
+	return client.GetDashboardCatalog(callProperties.Ctx, req, callProperties.CallOptions...)
+}
+
 func (d DashboardsClient) UpdateDashboard(ctx context.Context, req *dashboards.ReplaceDashboardRequest) (*dashboards.ReplaceDashboardResponse, error) {
 	callProperties, err := d.callPropertiesCreator.GetCallProperties(ctx)
 	if err != nil {
@@ -34,6 +34,24 @@ func (e EnrichmentsClient) CreateEnrichments(ctx context.Context, req []*enrichm
 	return enrichments[from:to], nil
 }
 
+func (e EnrichmentsClient) GetEnrichments(ctx context.Context) ([]*enrichment.Enrichment, error) {
+	callProperties, err := e.callPropertiesCreator.GetCallProperties(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := callProperties.Connection
+	defer conn.Close()
+	client := enrichment.NewEnrichmentServiceClient(conn)
+
+	resp, err := client.GetEnrichments(callProperties.Ctx, &enrichment.GetEnrichmentsRequest{}, callProperties.CallOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetEnrichments(), nil
+}
+
 func (e EnrichmentsClient) GetEnrichmentsByType(ctx context.Context, enrichmentType string) ([]*enrichment.Enrichment, error) {
 	callProperties, err := e.callPropertiesCreator.GetCallProperties(ctx)
 	if err != nil {
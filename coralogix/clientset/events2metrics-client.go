@@ -36,6 +36,19 @@ func (e Events2MetricsClient) GetEvents2Metric(ctx context.Context, req *e2m.Get
 	return client.GetE2M(callProperties.Ctx, req, callProperties.CallOptions...)
 }
 
+func (e Events2MetricsClient) ListEvents2Metrics(ctx context.Context, req *e2m.ListE2MRequest) (*e2m.ListE2MResponse, error) {
+	callProperties, err := e.callPropertiesCreator.GetCallProperties(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := callProperties.Connection
+	defer conn.Close()
+	client := e2m.NewEvents2MetricServiceClient(conn)
+
+	return client.ListE2M(callProperties.Ctx, req, callProperties.CallOptions...)
+}
+
 func (e Events2MetricsClient) UpdateEvents2Metric(ctx context.Context, req *e2m.ReplaceE2MRequest) (*e2m.ReplaceE2MResponse, error) {
 	callProperties, err := e.callPropertiesCreator.GetCallProperties(ctx)
 	if err != nil {
@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strings"
 
 	"terraform-provider-coralogix/coralogix/clientset/rest"
 
@@ -68,7 +67,7 @@ func (g GrafanaDashboardClient) GetTargetURL() string {
 }
 
 func NewGrafanaClient(c *CallPropertiesCreator) *GrafanaDashboardClient {
-	targetUrl := "https://" + strings.Replace(c.targetUrl, "grpc", "http", 1)
+	targetUrl := c.restTargetUrl("grpc", "http")
 	client := rest.NewRestClient(targetUrl, c.apiKey)
 	return &GrafanaDashboardClient{client: client, targetUrl: targetUrl}
 }
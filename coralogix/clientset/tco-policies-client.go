@@ -3,7 +3,6 @@ package clientset
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"terraform-provider-coralogix/coralogix/clientset/rest"
 )
@@ -38,7 +37,7 @@ func (t TCOPolicies) ReorderTCOPolicies(ctx context.Context, jsonContent string)
 }
 
 func NewTCOPoliciesClient(c *CallPropertiesCreator) *TCOPolicies {
-	targetUrl := "https://" + strings.Replace(c.targetUrl, "ng-api-grpc", "webapi", 1) + "/api/v1/external/tco"
+	targetUrl := c.restTargetUrl("ng-api-grpc", "webapi") + "/api/v1/external/tco"
 	client := rest.NewRestClient(targetUrl, c.apiKey)
 	return &TCOPolicies{client: client}
 }
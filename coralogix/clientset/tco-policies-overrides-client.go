@@ -3,7 +3,6 @@ package clientset
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"terraform-provider-coralogix/coralogix/clientset/rest"
 )
@@ -30,7 +29,7 @@ func (t TCOPoliciesOverrides) DeleteTCOPolicyOverride(ctx context.Context, id st
 }
 
 func NewTCOPoliciesOverridesClient(c *CallPropertiesCreator) *TCOPoliciesOverrides {
-	targetUrl := "https://" + strings.Replace(c.targetUrl, "ng-api-grpc", "webapi", 1) + "/api/v1/external/tco"
+	targetUrl := c.restTargetUrl("ng-api-grpc", "webapi") + "/api/v1/external/tco"
 	client := rest.NewRestClient(targetUrl, c.apiKey)
 	return &TCOPoliciesOverrides{client: client}
 }
@@ -3,7 +3,6 @@ package clientset
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"terraform-provider-coralogix/coralogix/clientset/rest"
 )
@@ -20,6 +19,10 @@ func (w WebhooksClient) GetWebhook(ctx context.Context, webhookId string) (strin
 	return w.client.Get(ctx, fmt.Sprintf("/api/v1/external/integrations/%s", webhookId))
 }
 
+func (w WebhooksClient) ListWebhooks(ctx context.Context) (string, error) {
+	return w.client.Get(ctx, "/api/v1/external/integrations")
+}
+
 func (w WebhooksClient) UpdateWebhook(ctx context.Context, body string) (string, error) {
 	return w.client.Post(ctx, "/api/v1/external/integrations", "application/json", body)
 }
@@ -29,7 +32,7 @@ func (w WebhooksClient) DeleteWebhook(ctx context.Context, webhookId string) (st
 }
 
 func NewWebhooksClient(c *CallPropertiesCreator) *WebhooksClient {
-	targetUrl := "https://" + strings.Replace(c.targetUrl, "grpc", "http", 1)
+	targetUrl := c.restTargetUrl("grpc", "http")
 	client := rest.NewRestClient(targetUrl, c.apiKey)
 	return &WebhooksClient{client: client}
 }
@@ -2,46 +2,96 @@ package coralogix
 
 import (
 	"context"
+	"fmt"
 	"log"
 
-	"terraform-provider-coralogix/coralogix/clientset"
-	alertsv1 "terraform-provider-coralogix/coralogix/clientset/grpc/alerts/v2"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/wrapperspb"
+	"terraform-provider-coralogix/coralogix/clientset"
+	alerts "terraform-provider-coralogix/coralogix/clientset/grpc/alerts/v2"
 )
 
-func dataSourceCoralogixAlert() *schema.Resource {
-	alertSchema := datasourceSchemaFromResourceSchema(AlertSchema())
-	alertSchema["id"] = &schema.Schema{
-		Type:     schema.TypeString,
-		Required: true,
+var _ datasource.DataSourceWithConfigure = &AlertDataSource{}
+
+func NewAlertDataSource() datasource.DataSource {
+	return &AlertDataSource{}
+}
+
+type AlertDataSource struct {
+	client *clientset.AlertsClient
+}
+
+func (d *AlertDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert"
+}
+
+func (d *AlertDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
 	}
 
-	return &schema.Resource{
-		ReadContext: dataSourceCoralogixAlertRead,
+	clientSet, ok := req.ProviderData.(*clientset.ClientSet)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *clientset.ClientSet, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
 
-		Schema: alertSchema,
+	d.client = clientSet.Alerts()
+}
+
+func (d *AlertDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	var r AlertResource
+	var resourceResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resourceResp)
+
+	resp.Schema = frameworkDatasourceSchemaFromFrameworkResourceSchema(resourceResp.Schema)
+	resp.Schema.Attributes["id"] = datasourceschema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "Alert ID.",
 	}
 }
 
-func dataSourceCoralogixAlertRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	id := wrapperspb.String(d.Get("id").(string))
-	getAlertRequest := &alertsv1.GetAlertByUniqueIdRequest{
-		Id: id,
+func (d *AlertDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AlertResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	log.Printf("[INFO] Reading alert %s", id)
-	alertResp, err := meta.(*clientset.ClientSet).Alerts().GetAlert(ctx, getAlertRequest)
+	//Get refreshed Alert value from Coralogix
+	id := data.ID.ValueString()
+	log.Printf("[INFO] Reading alert: %s", id)
+	getAlertResp, err := d.client.GetAlert(ctx, &alerts.GetAlertByUniqueIdRequest{Id: wrapperspb.String(id)})
 	if err != nil {
 		log.Printf("[ERROR] Received error: %#v", err)
-		return handleRpcErrorWithID(err, "alert", id.GetValue())
+		if status.Code(err) == codes.NotFound {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Alert %q is not found", id),
+				fmt.Sprintf("%s does not exist in Coralogix backend", id),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error reading Alert",
+				handleRpcErrorNewFramework(err, "Alert"),
+			)
+		}
+		return
 	}
-	alert := alertResp.GetAlert()
-	log.Printf("[INFO] Received alert: %#v", alert)
+	log.Printf("[INFO] Received alert: %#v", getAlertResp)
 
-	d.SetId(alert.GetId().GetValue())
+	data, diags := flattenAlert(ctx, getAlertResp.GetAlert(), timeZoneOfScheduling(data.Scheduling))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	return setAlert(d, alert)
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
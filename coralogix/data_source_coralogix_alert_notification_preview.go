@@ -0,0 +1,326 @@
+package coralogix
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	// alertNotificationPlaceholderSamples holds a sample value for every placeholder Coralogix substitutes in alert
+	// descriptions and webhook payloads.
+	alertNotificationPlaceholderSamples = map[string]string{
+		"ALERT_ID":                 "3dd1a5a1-40b7-4ba2-a1c1-4a8a5d0a5d0b",
+		"ALERT_NAME":               "Sample alert",
+		"ALERT_DESCRIPTION":        "Sample alert description",
+		"ALERT_THRESHOLD":          "10",
+		"ALERT_TIMEWINDOW_MINUTES": "10",
+		"ALERT_GROUPBY_LABELS":     "EventType",
+		"ALERT_ACTION":             "trigger",
+		"ALERT_URL":                "https://example.coralogix.com/#/insights?id=3dd1a5a1-40b7-4ba2-a1c1-4a8a5d0a5d0b",
+		"LOG_URL":                  "https://example.coralogix.com/#/query-new/logs?id=1",
+		"CORALOGIX_ICON_URL":       "https://coralogix.com/wp-content/uploads/2021/02/coralogix-icon.png",
+		"SERVICE":                  "checkout-service",
+		"DURATION":                 "1200",
+		"ERRORS":                   "2",
+		"SPANS":                    "40",
+		"TEAM_NAME":                "sample-team",
+		"APPLICATION_NAME":         "sample-application",
+		"SUBSYSTEM_NAME":           "sample-subsystem",
+		"EVENT_SEVERITY":           "Error",
+		"COMPUTER_NAME":            "sample-host",
+		"IP_ADDRESS":               "10.0.0.1",
+		"EVENT_TIMESTAMP":          "2023-01-01T00:00:00.000Z",
+		"EVENT_TIMESTAMP_MS":       "1672531200000",
+		"HIT_COUNT":                "12",
+		"LOG_TEXT":                 "sample log text",
+		"JSON_KEY":                 "sample-value",
+		"METRIC_KEY":               "sample_metric",
+		"METRIC_OPERATOR":          "Avg",
+		"TIMEFRAME":                "10 minutes",
+		"TIMEFRAME_OVER_THRESHOLD": "60",
+		"METRIC_CRITERIA":          "more than",
+		"RATIO_QUERY_ONE":          "level:ERROR",
+		"RATIO_QUERY_TWO":          "level:INFO",
+		"RATIO_TIMEFRAME":          "10 minutes",
+		"RATIO_GROUP_BY_KEYS":      "EventType",
+		"RATIO_GROUP_BY_TABLE":     "EventType: 2.5",
+		"UNIQUE_COUNT_VALUES_LIST": "value1, value2",
+		"NEW_VALUE_TRACKED_KEY":    "sample-new-value",
+		"META_LABELS":              "alert_type:security",
+	}
+	alertNotificationPlaceholderRegex = regexp.MustCompile(`\$[A-Z][A-Z0-9_]*`)
+	webhookTypesWithJsonPayload       = map[string]bool{"custom": true, "sendlog": true, "demisto": true}
+)
+
+func dataSourceCoralogixAlertNotificationPreview() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCoralogixAlertNotificationPreviewRead,
+
+		Schema: map[string]*schema.Schema{
+			"alert": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Alert ID, used for $ALERT_ID and $ALERT_URL.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Alert name, used for $ALERT_NAME.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Alert description. It's rendered into rendered_description and used for $ALERT_DESCRIPTION.",
+						},
+						"meta_labels": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Alert meta labels, used for $META_LABELS.",
+						},
+					},
+				},
+				Description: "The alert to preview the notification of, e.g. values of a coralogix_alert resource.",
+			},
+			"webhook": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(validWebhookTypes, false),
+							Description:  fmt.Sprintf("The webhook type. Can be one of %q. The payload of %q webhooks has to be valid JSON.", validWebhookTypes, sortedKeys(webhookTypesWithJsonPayload)),
+						},
+						"payload": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The webhook payload, e.g. coralogix_webhook.example.custom[0].payload.",
+						},
+					},
+				},
+				Description: "The webhook the alert notifies.",
+			},
+			"sample_values": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Values to render instead of the built-in samples, by placeholder name without the $ prefix (e.g. HIT_COUNT). The values are inserted as is, except inside the strings of JSON payloads, where they're escaped.",
+			},
+			"rendered_description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The alert description with every known placeholder replaced by a sample value.",
+			},
+			"rendered_payload": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The webhook payload with every known placeholder replaced by a sample value.",
+			},
+			"placeholders": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The known placeholders used by the description and the payload, sorted.",
+			},
+			"unknown_placeholders": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Placeholders which Coralogix doesn't substitute (e.g. typos), sorted. They are left as is in the rendered values.",
+			},
+		},
+
+		Description: "Renders an alert description and webhook payload locally with sample values for Coralogix placeholders (e.g. $ALERT_NAME), to catch typos and invalid JSON payloads before an alert fires.",
+	}
+}
+
+func dataSourceCoralogixAlertNotificationPreviewRead(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	samples := alertNotificationSamples(d)
+
+	var description, payload, webhookType string
+	if alert, ok := d.GetOk("alert"); ok && alert.([]interface{})[0] != nil {
+		description = alert.([]interface{})[0].(map[string]interface{})["description"].(string)
+	}
+	if webhook, ok := d.GetOk("webhook"); ok && webhook.([]interface{})[0] != nil {
+		webhookMap := webhook.([]interface{})[0].(map[string]interface{})
+		webhookType = webhookMap["type"].(string)
+		payload = webhookMap["payload"].(string)
+	}
+
+	known, unknown := make(map[string]bool), make(map[string]bool)
+	renderedDescription := renderAlertNotificationTemplate(description, false, samples, known, unknown)
+	samples["ALERT_DESCRIPTION"] = renderedDescription
+	renderedPayload := renderAlertNotificationTemplate(payload, webhookTypesWithJsonPayload[webhookType], samples, known, unknown)
+
+	var diags diag.Diagnostics
+	if len(unknown) > 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Unknown alert notification placeholders",
+			Detail:   fmt.Sprintf("%q won't be substituted by Coralogix. Known placeholders are %q", sortedKeys(unknown), sortedKeys(alertNotificationPlaceholderSamplesSet())),
+		})
+	}
+	if payload != "" && webhookTypesWithJsonPayload[webhookType] {
+		var js interface{}
+		if err := json.Unmarshal([]byte(renderedPayload), &js); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Invalid webhook payload",
+				Detail:   fmt.Sprintf("%s webhooks expect a JSON payload, but the rendered payload is not valid JSON - %s", webhookType, err),
+			})
+			return diags
+		}
+	}
+
+	d.SetId(alertNotificationPreviewID(description, webhookType, payload, samples))
+	if err := d.Set("rendered_description", renderedDescription); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("rendered_payload", renderedPayload); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("placeholders", sortedKeys(known)); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("unknown_placeholders", sortedKeys(unknown)); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// alertNotificationPreviewID hashes every input of the rendered values: the templates, the webhook type (which decides
+// whether the payload is JSON), and the sample values, which include the alert's own values and sample_values.
+func alertNotificationPreviewID(description, webhookType, payload string, samples map[string]string) string {
+	// encoding/json sorts map keys, and marshalling strings doesn't fail.
+	inputs, _ := json.Marshal([]interface{}{description, webhookType, payload, samples})
+	return fmt.Sprintf("%x", sha256.Sum256(inputs))
+}
+
+// alertNotificationSamples returns the sample value of every known placeholder, taking the alert's own values and
+// sample_values into account.
+func alertNotificationSamples(d *schema.ResourceData) map[string]string {
+	samples := make(map[string]string, len(alertNotificationPlaceholderSamples))
+	for k, v := range alertNotificationPlaceholderSamples {
+		samples[k] = v
+	}
+
+	if alert, ok := d.GetOk("alert"); ok && alert.([]interface{})[0] != nil {
+		alertMap := alert.([]interface{})[0].(map[string]interface{})
+		if id := alertMap["id"].(string); id != "" {
+			samples["ALERT_ID"] = id
+			samples["ALERT_URL"] = fmt.Sprintf("https://example.coralogix.com/#/insights?id=%s", id)
+		}
+		if name := alertMap["name"].(string); name != "" {
+			samples["ALERT_NAME"] = name
+		}
+		if metaLabels := alertMap["meta_labels"].(map[string]interface{}); len(metaLabels) > 0 {
+			labels := make([]string, 0, len(metaLabels))
+			for k, v := range metaLabels {
+				labels = append(labels, fmt.Sprintf("%s:%s", k, v))
+			}
+			sort.Strings(labels)
+			samples["META_LABELS"] = strings.Join(labels, ",")
+		}
+	}
+
+	for k, v := range d.Get("sample_values").(map[string]interface{}) {
+		samples[strings.TrimPrefix(k, "$")] = v.(string)
+	}
+
+	return samples
+}
+
+// renderAlertNotificationTemplate replaces every known placeholder in template with its sample value.
+// The names of the known and unknown placeholders found in template are added to known and unknown.
+// For JSON templates, the values of placeholders inside strings are JSON-escaped, so a value with quotes
+// doesn't break the payload - only the placeholders outside strings can produce invalid JSON.
+func renderAlertNotificationTemplate(template string, isJSON bool, samples map[string]string, known, unknown map[string]bool) string {
+	var rendered strings.Builder
+	var scanned int
+	var inString, escaped bool
+	for _, match := range alertNotificationPlaceholderRegex.FindAllStringIndex(template, -1) {
+		if isJSON {
+			inString, escaped = scanJSONStrings(template[scanned:match[0]], inString, escaped)
+		}
+		rendered.WriteString(template[scanned:match[0]])
+		scanned = match[1]
+
+		placeholder := template[match[0]:match[1]]
+		name := strings.TrimPrefix(placeholder, "$")
+		if _, ok := alertNotificationPlaceholderSamples[name]; !ok {
+			unknown[placeholder] = true
+			rendered.WriteString(placeholder)
+			continue
+		}
+		known[placeholder] = true
+		if inString {
+			rendered.WriteString(escapeJSONString(samples[name]))
+		} else {
+			rendered.WriteString(samples[name])
+		}
+	}
+	rendered.WriteString(template[scanned:])
+	return rendered.String()
+}
+
+// scanJSONStrings returns whether the end of text is inside a JSON string, and whether its last character is an
+// escaping backslash, given the state at the start of text.
+func scanJSONStrings(text string, inString, escaped bool) (bool, bool) {
+	for _, c := range text {
+		switch {
+		case escaped:
+			escaped = false
+		case inString && c == '\\':
+			escaped = true
+		case c == '"':
+			inString = !inString
+		}
+	}
+	return inString, escaped
+}
+
+// escapeJSONString returns the value as the content of a JSON string, without the quotes.
+func escapeJSONString(value string) string {
+	var b strings.Builder
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(value)
+	escaped := strings.TrimSuffix(b.String(), "\n")
+	return escaped[1 : len(escaped)-1]
+}
+
+func alertNotificationPlaceholderSamplesSet() map[string]bool {
+	placeholders := make(map[string]bool, len(alertNotificationPlaceholderSamples))
+	for name := range alertNotificationPlaceholderSamples {
+		placeholders["$"+name] = true
+	}
+	return placeholders
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,177 @@
+package coralogix
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var alertNotificationPreviewDataSourceName = "data.coralogix_alert_notification_preview.test"
+
+func TestAccCoralogixDataSourceAlertNotificationPreview_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoralogixDataSourceAlertNotificationPreview(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(alertNotificationPreviewDataSourceName, "rendered_description", "5 errors in sample-application ($APLICATION_NAME)"),
+					resource.TestCheckResourceAttr(alertNotificationPreviewDataSourceName, "rendered_payload", `{"description":"5 errors in sample-application ($APLICATION_NAME)","labels":"team:api","name":"Errors alert"}`),
+					resource.TestCheckResourceAttr(alertNotificationPreviewDataSourceName, "placeholders.#", "5"),
+					resource.TestCheckResourceAttr(alertNotificationPreviewDataSourceName, "unknown_placeholders.#", "1"),
+					resource.TestCheckResourceAttr(alertNotificationPreviewDataSourceName, "unknown_placeholders.0", "$APLICATION_NAME"),
+				),
+			},
+			{
+				Config:      testAccCoralogixDataSourceAlertNotificationPreviewInvalidJson(),
+				ExpectError: regexp.MustCompile("Invalid webhook payload"),
+			},
+		},
+	})
+}
+
+func testAccCoralogixDataSourceAlertNotificationPreview() string {
+	return `data "coralogix_alert_notification_preview" "test" {
+  alert {
+    name        = "Errors alert"
+    description = "$HIT_COUNT errors in $APPLICATION_NAME ($APLICATION_NAME)"
+    meta_labels = {
+      team = "api"
+    }
+  }
+  webhook {
+    type    = "custom"
+    payload = jsonencode({
+      "name" : "$ALERT_NAME",
+      "description" : "$ALERT_DESCRIPTION",
+      "labels" : "$META_LABELS",
+    })
+  }
+  sample_values = {
+    HIT_COUNT = "5"
+  }
+}
+`
+}
+
+func testAccCoralogixDataSourceAlertNotificationPreviewInvalidJson() string {
+	return `data "coralogix_alert_notification_preview" "test" {
+  webhook {
+    type    = "custom"
+    payload = "{\"hits\": $HIT_COUNT, \"name\": \"$ALERT_NAME\",}"
+  }
+}
+`
+}
+
+func TestRenderAlertNotificationTemplate(t *testing.T) {
+	samples := map[string]string{
+		"ALERT_NAME":        `Errors in "checkout"`,
+		"ALERT_DESCRIPTION": "C:\\logs\nsecond line",
+		"HIT_COUNT":         "12",
+	}
+
+	tests := []struct {
+		name     string
+		template string
+		isJSON   bool
+		want     string
+	}{
+		{
+			name:     "text",
+			template: `$ALERT_NAME: $HIT_COUNT hits`,
+			want:     `Errors in "checkout": 12 hits`,
+		},
+		{
+			name:     "json string",
+			template: `{"name": "$ALERT_NAME", "description": "alert $ALERT_DESCRIPTION"}`,
+			isJSON:   true,
+			want:     `{"name": "Errors in \"checkout\"", "description": "alert C:\\logs\nsecond line"}`,
+		},
+		{
+			name:     "json value outside strings",
+			template: `{"hits": $HIT_COUNT, "name": "$ALERT_NAME"}`,
+			isJSON:   true,
+			want:     `{"hits": 12, "name": "Errors in \"checkout\""}`,
+		},
+		{
+			name:     "json escaped quotes",
+			template: `{"text": "say \"$ALERT_NAME\" \\", "hits": $HIT_COUNT}`,
+			isJSON:   true,
+			want:     `{"text": "say \"Errors in \"checkout\"\" \\", "hits": 12}`,
+		},
+		{
+			name:     "unknown placeholder",
+			template: `{"name": "$ALERT_NAM"}`,
+			isJSON:   true,
+			want:     `{"name": "$ALERT_NAM"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			known, unknown := make(map[string]bool), make(map[string]bool)
+			if got := renderAlertNotificationTemplate(tt.template, tt.isJSON, samples, known, unknown); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAlertNotificationPreviewQuotedDescription(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceCoralogixAlertNotificationPreview().Schema, map[string]interface{}{
+		"alert": []interface{}{map[string]interface{}{
+			"name":        `The "checkout" alert`,
+			"description": `$HIT_COUNT errors in "$APPLICATION_NAME"`,
+		}},
+		"webhook": []interface{}{map[string]interface{}{
+			"type":    "custom",
+			"payload": `{"name": "$ALERT_NAME", "description": "$ALERT_DESCRIPTION", "hits": $HIT_COUNT}`,
+		}},
+	})
+
+	if diags := dataSourceCoralogixAlertNotificationPreviewRead(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("read: %v", diags)
+	}
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("rendered_payload").(string)), &payload); err != nil {
+		t.Fatalf("rendered_payload isn't valid JSON: %s", err)
+	}
+	if want := `12 errors in "sample-application"`; payload["description"] != want {
+		t.Errorf("description: got %v, want %s", payload["description"], want)
+	}
+	if want := `The "checkout" alert`; payload["name"] != want {
+		t.Errorf("name: got %v, want %s", payload["name"], want)
+	}
+}
+
+func TestAlertNotificationPreviewID(t *testing.T) {
+	read := func(config map[string]interface{}) string {
+		t.Helper()
+		d := schema.TestResourceDataRaw(t, dataSourceCoralogixAlertNotificationPreview().Schema, config)
+		if diags := dataSourceCoralogixAlertNotificationPreviewRead(context.Background(), d, nil); diags.HasError() {
+			t.Fatalf("read: %v", diags)
+		}
+		return d.Id()
+	}
+	alert := func(name string) []interface{} {
+		return []interface{}{map[string]interface{}{"name": name, "description": "$ALERT_NAME: $HIT_COUNT errors"}}
+	}
+
+	id := read(map[string]interface{}{"alert": alert("checkout")})
+	if id != read(map[string]interface{}{"alert": alert("checkout")}) {
+		t.Errorf("expected the same inputs to get the same id")
+	}
+	if id == read(map[string]interface{}{"alert": alert("payments")}) {
+		t.Errorf("expected a different alert name to change the id")
+	}
+	if id == read(map[string]interface{}{"alert": alert("checkout"), "sample_values": map[string]interface{}{"HIT_COUNT": "3"}}) {
+		t.Errorf("expected different sample_values to change the id")
+	}
+}
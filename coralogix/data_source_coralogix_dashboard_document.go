@@ -0,0 +1,129 @@
+package coralogix
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	dashboards "terraform-provider-coralogix/coralogix/clientset/grpc/coralogix-dashboards/v1"
+)
+
+func dataSourceCoralogixDashboardDocument() *schema.Resource {
+	dashboardSchema := DashboardSchema()
+
+	return &schema.Resource{
+		ReadContext: dataSourceCoralogixDashboardDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Dashboard id. When not set, content_json has no id and coralogix_dashboard assigns one on creation, and the data source id is a hash of content_json.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Dashboard name.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Dashboard description.",
+			},
+			"sections_json": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateDiagFunc: dashboardElementJsonValidationFunc(func() proto.Message {
+						return &dashboards.Section{}
+					}),
+				},
+				Description: "Sections of the dashboard, from top to bottom, as rendered by coralogix_dashboard_section.json.",
+			},
+			"variable":            dashboardSchema["variable"],
+			"filter":              dashboardSchema["filter"],
+			"relative_time_frame": dashboardSchema["relative_time_frame"],
+			"absolute_time_frame": dashboardSchema["absolute_time_frame"],
+			"content_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The dashboard, rendered as canonical json. Can be passed to coralogix_dashboard.content_json.",
+			},
+		},
+
+		Description: "Renders a full dashboard out of sections rendered by coralogix_dashboard_section, to be used as coralogix_dashboard.content_json.",
+	}
+}
+
+func dataSourceCoralogixDashboardDocumentRead(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	sections, err := unmarshalDashboardSections(d.Get("sections_json"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	variables, diags := expandVariables(d.Get("variable"))
+	filters, dgs := expandDashboardFilters(d.Get("filter"))
+	diags = append(diags, dgs...)
+	if diags.HasError() {
+		return diags
+	}
+
+	dashboard := &dashboards.Dashboard{
+		Name:        wrapperspb.String(d.Get("name").(string)),
+		Description: wrapperspb.String(d.Get("description").(string)),
+		Layout: &dashboards.Layout{
+			Sections: sections,
+		},
+		Variables: variables,
+		Filters:   filters,
+	}
+	if id := d.Get("id").(string); id != "" {
+		dashboard.Id = wrapperspb.String(id)
+	}
+	if diags = append(diags, expandDashboardTimeFrame(dashboard, d)...); diags.HasError() {
+		return diags
+	}
+
+	contentJson, err := canonicalProtoJson(dashboard)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(dashboardDocumentID(dashboard.GetId().GetValue(), contentJson))
+	if err = d.Set("content_json", contentJson); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// dashboardDocumentID returns the dashboard id, or a hash of the dashboard's content when it has none (the
+// coralogix_dashboard resource assigns one on creation). The id isn't derived from the name, so that two
+// dashboards with the same name don't share an id, and renaming a dashboard doesn't replace it.
+func dashboardDocumentID(id, contentJson string) string {
+	if id != "" {
+		return id
+	}
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(contentJson)))
+}
+
+func unmarshalDashboardSections(v interface{}) ([]*dashboards.Section, error) {
+	sectionsJson := v.([]interface{})
+	result := make([]*dashboards.Section, 0, len(sectionsJson))
+	for i, s := range sectionsJson {
+		section := new(dashboards.Section)
+		if err := protojson.Unmarshal([]byte(s.(string)), section); err != nil {
+			return nil, fmt.Errorf("section %d is not a valid section json - %s", i, err)
+		}
+		result = append(result, section)
+	}
+	return result, nil
+}
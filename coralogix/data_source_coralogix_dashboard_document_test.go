@@ -0,0 +1,101 @@
+package coralogix
+
+import (
+	"context"
+	"testing"
+
+	dashboards "terraform-provider-coralogix/coralogix/clientset/grpc/coralogix-dashboards/v1"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var dashboardDocumentDataSourceName = "data.coralogix_dashboard_document.test"
+
+func TestAccCoralogixDataSourceDashboardDocument_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDashboardDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoralogixDataSourceDashboardWidget() +
+					testAccCoralogixDataSourceDashboardSection() +
+					testAccCoralogixDataSourceDashboardDocument(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dashboardDocumentDataSourceName, "content_json"),
+					resource.TestCheckResourceAttrSet(dashboardResourceName, "id"),
+				),
+			},
+			{
+				Config: testAccCoralogixDataSourceDashboardWidget() +
+					testAccCoralogixDataSourceDashboardSection() +
+					testAccCoralogixDataSourceDashboardDocumentWithID(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dashboardDocumentDataSourceName, "id", "tf-acc-composed-dashboard"),
+					resource.TestCheckResourceAttrPair(dashboardResourceName, "id", dashboardDocumentDataSourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCoralogixDataSourceDashboardDocument() string {
+	return `data "coralogix_dashboard_document" "test" {
+  name                = "composed dashboard"
+  description         = "rendered from coralogix_dashboard_widget and coralogix_dashboard_section"
+  sections_json       = [data.coralogix_dashboard_section.test.json]
+  relative_time_frame = "1h"
+}
+
+resource "coralogix_dashboard" "test" {
+  content_json = data.coralogix_dashboard_document.test.content_json
+}
+`
+}
+
+func testAccCoralogixDataSourceDashboardDocumentWithID() string {
+	return `data "coralogix_dashboard_document" "test" {
+  id                  = "tf-acc-composed-dashboard"
+  name                = "composed dashboard"
+  sections_json       = [data.coralogix_dashboard_section.test.json]
+  relative_time_frame = "1h"
+}
+
+resource "coralogix_dashboard" "test" {
+  content_json = data.coralogix_dashboard_document.test.content_json
+}
+`
+}
+
+func TestDashboardDocumentID(t *testing.T) {
+	read := func(config map[string]interface{}) (*schema.ResourceData, *dashboards.Dashboard) {
+		t.Helper()
+
+		d := schema.TestResourceDataRaw(t, dataSourceCoralogixDashboardDocument().Schema, config)
+		if diags := dataSourceCoralogixDashboardDocumentRead(context.Background(), d, nil); diags.HasError() {
+			t.Fatalf("read: %v", diags)
+		}
+		dashboard := new(dashboards.Dashboard)
+		if err := protojson.Unmarshal([]byte(d.Get("content_json").(string)), dashboard); err != nil {
+			t.Fatal(err)
+		}
+		return d, dashboard
+	}
+
+	// Without an id, the dashboard has none, so dashboards with the same name don't share one.
+	d1, dashboard := read(map[string]interface{}{"name": "service", "description": "first"})
+	if dashboard.Id != nil {
+		t.Errorf("content_json id: got %s, want none", dashboard.GetId().GetValue())
+	}
+	d2, _ := read(map[string]interface{}{"name": "service", "description": "second"})
+	if d1.Id() == "" || d1.Id() == d2.Id() {
+		t.Errorf("data source ids: got %q and %q, want distinct hashes of the content", d1.Id(), d2.Id())
+	}
+
+	d, dashboard := read(map[string]interface{}{"name": "service", "id": "service-dashboard"})
+	if dashboard.GetId().GetValue() != "service-dashboard" || d.Id() != "service-dashboard" {
+		t.Errorf("ids: got content_json id %q and data source id %q, want service-dashboard", dashboard.GetId().GetValue(), d.Id())
+	}
+}
@@ -0,0 +1,111 @@
+package coralogix
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	dashboards "terraform-provider-coralogix/coralogix/clientset/grpc/coralogix-dashboards/v1"
+)
+
+func dataSourceCoralogixDashboardSection() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCoralogixDashboardSectionRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Section id. When not set, json has no section id and coralogix_dashboard derives one.",
+			},
+			"row": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Row id. When not set, the row has no id and coralogix_dashboard derives one.",
+						},
+						"height": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"widgets_json": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateDiagFunc: dashboardElementJsonValidationFunc(func() proto.Message {
+									return &dashboards.Widget{}
+								}),
+							},
+							Description: "Widgets of the row, from left to right, as rendered by coralogix_dashboard_widget.json.",
+						},
+					},
+				},
+			},
+			"json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The section, rendered as canonical json. Can be passed to coralogix_dashboard_document.",
+			},
+		},
+
+		Description: "Renders a dashboard section out of rows of widgets rendered by coralogix_dashboard_widget.",
+	}
+}
+
+func dataSourceCoralogixDashboardSectionRead(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	rows, diags := expandDashboardSectionRows(d.Get("row"))
+	if diags.HasError() {
+		return diags
+	}
+
+	section := &dashboards.Section{
+		Id:   expandDashboardElementUUID(d.Get("id")),
+		Rows: rows,
+	}
+
+	sectionJson, err := canonicalProtoJson(section)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(dashboardElementID(section.GetId().GetValue(), sectionJson))
+	if err = d.Set("json", sectionJson); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func expandDashboardSectionRows(v interface{}) ([]*dashboards.Row, diag.Diagnostics) {
+	rows := v.([]interface{})
+	result := make([]*dashboards.Row, 0, len(rows))
+	var diags diag.Diagnostics
+	for _, r := range rows {
+		m := r.(map[string]interface{})
+		widgets, err := unmarshalDashboardWidgets(m["widgets_json"])
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+			continue
+		}
+		row := &dashboards.Row{
+			Id: expandDashboardElementUUID(m["id"]),
+			Appearance: &dashboards.Row_Appearance{
+				Height: wrapperspb.Int32(int32(m["height"].(int))),
+			},
+			Widgets: widgets,
+		}
+		result = append(result, row)
+	}
+	return result, diags
+}
@@ -0,0 +1,92 @@
+package coralogix
+
+import (
+	"context"
+	"testing"
+
+	dashboards "terraform-provider-coralogix/coralogix/clientset/grpc/coralogix-dashboards/v1"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var dashboardSectionDataSourceName = "data.coralogix_dashboard_section.test"
+
+func TestAccCoralogixDataSourceDashboardSection_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoralogixDataSourceDashboardWidget() +
+					testAccCoralogixDataSourceDashboardSection(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dashboardSectionDataSourceName, "id"),
+					resource.TestCheckResourceAttr(dashboardSectionDataSourceName, "row.0.height", "19"),
+					resource.TestCheckResourceAttrSet(dashboardSectionDataSourceName, "json"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCoralogixDataSourceDashboardSection() string {
+	return `data "coralogix_dashboard_section" "test" {
+  row {
+    height       = 19
+    widgets_json = [data.coralogix_dashboard_widget.test.json]
+  }
+}
+`
+}
+
+func TestDashboardSectionIdenticalWidgets(t *testing.T) {
+	widgetData := schema.TestResourceDataRaw(t, dataSourceCoralogixDashboardWidget().Schema, map[string]interface{}{
+		"title": "errors",
+		"definition": []interface{}{map[string]interface{}{
+			"line_chart": []interface{}{map[string]interface{}{
+				"query_definition": []interface{}{map[string]interface{}{
+					"query": []interface{}{map[string]interface{}{
+						"metrics": []interface{}{map[string]interface{}{
+							"promql_query": `http_requests_total{status=~"5.."}`,
+						}},
+					}},
+				}},
+			}},
+		}},
+	})
+	if diags := dataSourceCoralogixDashboardWidgetRead(context.Background(), widgetData, nil); diags.HasError() {
+		t.Fatalf("widget read: %v", diags)
+	}
+	widgetJson := widgetData.Get("json").(string)
+
+	sectionData := schema.TestResourceDataRaw(t, dataSourceCoralogixDashboardSection().Schema, map[string]interface{}{
+		"row": []interface{}{map[string]interface{}{
+			"height":       19,
+			"widgets_json": []interface{}{widgetJson, widgetJson},
+		}},
+	})
+	if diags := dataSourceCoralogixDashboardSectionRead(context.Background(), sectionData, nil); diags.HasError() {
+		t.Fatalf("section read: %v", diags)
+	}
+	section := new(dashboards.Section)
+	if err := protojson.Unmarshal([]byte(sectionData.Get("json").(string)), section); err != nil {
+		t.Fatal(err)
+	}
+
+	// The rendered json has no ids, coralogix_dashboard fills them in.
+	if row := section.GetRows()[0]; section.Id != nil || row.Id != nil || row.GetWidgets()[0].Id != nil {
+		t.Errorf("expected the rendered section to have no ids, got %v", section)
+	}
+	dashboard := &dashboards.Dashboard{
+		Id:     wrapperspb.String("dashboard"),
+		Layout: &dashboards.Layout{Sections: []*dashboards.Section{section}},
+	}
+	fillDashboardLayoutIDs(dashboard)
+	widgets := dashboard.GetLayout().GetSections()[0].GetRows()[0].GetWidgets()
+	if widgets[0].GetId().GetValue() == "" || widgets[0].GetId().GetValue() == widgets[1].GetId().GetValue() {
+		t.Errorf("expected identical widgets to get distinct ids, got %q and %q", widgets[0].GetId().GetValue(), widgets[1].GetId().GetValue())
+	}
+}
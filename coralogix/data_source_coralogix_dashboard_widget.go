@@ -0,0 +1,138 @@
+package coralogix
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	dashboards "terraform-provider-coralogix/coralogix/clientset/grpc/coralogix-dashboards/v1"
+)
+
+func dataSourceCoralogixDashboardWidget() *schema.Resource {
+	widgetSchema := dashboardWidgetSchema()
+	widgetSchema["id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "Widget id. When not set, json has no widget id and coralogix_dashboard derives one, so identical widgets in a dashboard get distinct ids.",
+	}
+	widgetSchema["json"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The widget, rendered as canonical json. Can be passed to coralogix_dashboard_section.",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceCoralogixDashboardWidgetRead,
+
+		Schema: widgetSchema,
+
+		Description: "Renders a single dashboard widget as json, for composing dashboards with coralogix_dashboard_section and coralogix_dashboard_document.",
+	}
+}
+
+func dataSourceCoralogixDashboardWidgetRead(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	widgetMap := map[string]interface{}{
+		"id":          d.Get("id"),
+		"title":       d.Get("title"),
+		"description": d.Get("description"),
+		"definition":  d.Get("definition"),
+		"appearance":  d.Get("appearance"),
+	}
+	widget, err := expandWidget(widgetMap)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if widget.GetDefinition() == nil {
+		return diag.Errorf("widget definition must contain exactly one of \"line_chart\", \"data_table\" or \"gauge\"")
+	}
+
+	widgetJson, err := canonicalProtoJson(widget)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(dashboardElementID(widget.GetId().GetValue(), widgetJson))
+	if err = d.Set("json", widgetJson); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// dashboardWidgetSchema returns the schema of a single widget as it appears in layout.section.row.widget.
+func dashboardWidgetSchema() map[string]*schema.Schema {
+	layout := DashboardSchema()["layout"].Elem.(*schema.Resource)
+	section := layout.Schema["section"].Elem.(*schema.Resource)
+	row := section.Schema["row"].Elem.(*schema.Resource)
+	return row.Schema["widget"].Elem.(*schema.Resource).Schema
+}
+
+// canonicalProtoJson marshals m into json with sorted keys and no insignificant whitespace.
+// protojson output is intentionally unstable, so it is re-encoded with encoding/json.
+func canonicalProtoJson(m proto.Message) (string, error) {
+	b, err := protojson.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+	var v interface{}
+	if err = json.Unmarshal(b, &v); err != nil {
+		return "", err
+	}
+	buf := new(bytes.Buffer)
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	if err = encoder.Encode(v); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// dashboardElementID returns the id of a rendered section, row or widget, or a hash of its json when it has none.
+// Elements without an id get theirs from coralogix_dashboard, which tells identical elements apart by their
+// position, so the data sources don't derive one from the content.
+func dashboardElementID(id, contentJson string) string {
+	if id != "" {
+		return id
+	}
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(contentJson)))
+}
+
+func dashboardIDFromSeed(seed string) string {
+	sum := sha256.Sum256([]byte(seed))
+	b := make([]byte, 21)
+	for i := range b {
+		b[i] = letterBytes[int(sum[i])%len(letterBytes)]
+	}
+	return string(b)
+}
+
+func dashboardElementJsonValidationFunc(newElement func() proto.Message) schema.SchemaValidateDiagFunc {
+	return func(v interface{}, _ cty.Path) diag.Diagnostics {
+		if err := protojson.Unmarshal([]byte(v.(string)), newElement()); err != nil {
+			return diag.Errorf("json content is not matching %T schema. got an err while unmarshalling - %s", newElement(), err)
+		}
+		return nil
+	}
+}
+
+func unmarshalDashboardWidgets(v interface{}) ([]*dashboards.Widget, error) {
+	widgetsJson := v.([]interface{})
+	result := make([]*dashboards.Widget, 0, len(widgetsJson))
+	for i, w := range widgetsJson {
+		widget := new(dashboards.Widget)
+		if err := protojson.Unmarshal([]byte(w.(string)), widget); err != nil {
+			return nil, fmt.Errorf("widget %d is not a valid widget json - %s", i, err)
+		}
+		result = append(result, widget)
+	}
+	return result, nil
+}
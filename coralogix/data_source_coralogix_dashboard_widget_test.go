@@ -0,0 +1,49 @@
+package coralogix
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var dashboardWidgetDataSourceName = "data.coralogix_dashboard_widget.test"
+
+func TestAccCoralogixDataSourceDashboardWidget_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoralogixDataSourceDashboardWidget(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dashboardWidgetDataSourceName, "id"),
+					resource.TestCheckResourceAttr(dashboardWidgetDataSourceName, "title", "status 4XX"),
+					resource.TestMatchResourceAttr(dashboardWidgetDataSourceName, "json", regexp.MustCompile(regexp.QuoteMeta(`"promqlQuery":{"value":"http_requests_total{status!~\"4..\"}"}`))),
+				),
+			},
+		},
+	})
+}
+
+func testAccCoralogixDataSourceDashboardWidget() string {
+	return `data "coralogix_dashboard_widget" "test" {
+  title = "status 4XX"
+  definition {
+    line_chart {
+      query_definition {
+        query {
+          metrics {
+            promql_query = "http_requests_total{status!~\"4..\"}"
+          }
+        }
+      }
+      legend {
+        is_visible = true
+        column     = ["Max", "Last"]
+      }
+    }
+  }
+}
+`
+}
@@ -0,0 +1,873 @@
+package coralogix
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	dashboards "terraform-provider-coralogix/coralogix/clientset/grpc/coralogix-dashboards/v1"
+)
+
+var (
+	grafanaUnitToProtoUnit = map[string]dashboards.Unit{
+		"µs":        dashboards.Unit_UNIT_MICROSECONDS,
+		"ms":        dashboards.Unit_UNIT_MILLISECONDS,
+		"s":         dashboards.Unit_UNIT_SECONDS,
+		"decbytes":  dashboards.Unit_UNIT_BYTES,
+		"deckbytes": dashboards.Unit_UNIT_KBYTES,
+		"decmbytes": dashboards.Unit_UNIT_MBYTES,
+		"decgbytes": dashboards.Unit_UNIT_GBYTES,
+		"bytes":     dashboards.Unit_UNIT_BYTES_IEC,
+		"kbytes":    dashboards.Unit_UNIT_KIBYTES,
+		"mbytes":    dashboards.Unit_UNIT_MIBYTES,
+		"gbytes":    dashboards.Unit_UNIT_GIBYTES,
+	}
+	grafanaUnitToProtoGaugeUnit = map[string]dashboards.Gauge_Unit{
+		"percent":   dashboards.Gauge_UNIT_PERCENT,
+		"µs":        dashboards.Gauge_UNIT_MICROSECONDS,
+		"ms":        dashboards.Gauge_UNIT_MILLISECONDS,
+		"s":         dashboards.Gauge_UNIT_SECONDS,
+		"decbytes":  dashboards.Gauge_UNIT_BYTES,
+		"deckbytes": dashboards.Gauge_UNIT_KBYTES,
+		"decmbytes": dashboards.Gauge_UNIT_MBYTES,
+		"decgbytes": dashboards.Gauge_UNIT_GBYTES,
+		"bytes":     dashboards.Gauge_UNIT_BYTES_IEC,
+		"kbytes":    dashboards.Gauge_UNIT_KIBYTES,
+		"mbytes":    dashboards.Gauge_UNIT_MIBYTES,
+		"gbytes":    dashboards.Gauge_UNIT_GIBYTES,
+	}
+	grafanaReducerToProtoGaugeAggregation = map[string]dashboards.Gauge_Aggregation{
+		"last":        dashboards.Gauge_AGGREGATION_LAST,
+		"lastNotNull": dashboards.Gauge_AGGREGATION_LAST,
+		"min":         dashboards.Gauge_AGGREGATION_MIN,
+		"max":         dashboards.Gauge_AGGREGATION_MAX,
+		"mean":        dashboards.Gauge_AGGREGATION_AVG,
+		"sum":         dashboards.Gauge_AGGREGATION_SUM,
+	}
+	grafanaSupportedPanelTypes = []string{"timeseries", "graph", "stat", "gauge", "table", "piechart", "barchart"}
+	grafanaRelativeTimeRegex   = regexp.MustCompile(`^now-(\d+[smhdw])$`)
+	grafanaLabelValuesRegex    = regexp.MustCompile(`^\s*label_values\(\s*([^,\s]+)\s*,\s*([^)\s]+)\s*\)\s*$`)
+	promqlGroupByRegex         = regexp.MustCompile(`\b(?:by|without)\s*\(([^)]*)\)`)
+	grafanaLegendLabelRegex    = regexp.MustCompile(`{{\s*([A-Za-z_][A-Za-z0-9_]*)\s*}}`)
+)
+
+type grafanaDashboard struct {
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Panels      []grafanaPanel `json:"panels"`
+	Rows        []struct {
+		Title  string         `json:"title"`
+		Panels []grafanaPanel `json:"panels"`
+	} `json:"rows"`
+	Time struct {
+		From string `json:"from"`
+		To   string `json:"to"`
+	} `json:"time"`
+	Templating struct {
+		List []grafanaVariable `json:"list"`
+	} `json:"templating"`
+}
+
+type grafanaPanel struct {
+	Type        string          `json:"type"`
+	Title       string          `json:"title"`
+	Description string          `json:"description"`
+	Datasource  json.RawMessage `json:"datasource"`
+	GridPos     struct {
+		H int `json:"h"`
+		W int `json:"w"`
+		X int `json:"x"`
+		Y int `json:"y"`
+	} `json:"gridPos"`
+	Targets     []grafanaTarget `json:"targets"`
+	Panels      []grafanaPanel  `json:"panels"`
+	FieldConfig struct {
+		Defaults struct {
+			Min        *float64 `json:"min"`
+			Max        *float64 `json:"max"`
+			Unit       string   `json:"unit"`
+			Thresholds struct {
+				Steps []struct {
+					Color string   `json:"color"`
+					Value *float64 `json:"value"`
+				} `json:"steps"`
+			} `json:"thresholds"`
+		} `json:"defaults"`
+	} `json:"fieldConfig"`
+	Options struct {
+		ReduceOptions struct {
+			Calcs []string `json:"calcs"`
+		} `json:"reduceOptions"`
+		Legend struct {
+			ShowLegend *bool `json:"showLegend"`
+		} `json:"legend"`
+	} `json:"options"`
+}
+
+type grafanaTarget struct {
+	RefId        string          `json:"refId"`
+	Hide         bool            `json:"hide"`
+	Datasource   json.RawMessage `json:"datasource"`
+	Expr         string          `json:"expr"`
+	Query        string          `json:"query"`
+	LegendFormat string          `json:"legendFormat"`
+	Metrics      []struct {
+		Type  string `json:"type"`
+		Field string `json:"field"`
+	} `json:"metrics"`
+	BucketAggs []struct {
+		Type  string `json:"type"`
+		Field string `json:"field"`
+	} `json:"bucketAggs"`
+}
+
+type grafanaVariable struct {
+	Type    string          `json:"type"`
+	Name    string          `json:"name"`
+	Label   string          `json:"label"`
+	Query   json.RawMessage `json:"query"`
+	Options []struct {
+		Value string `json:"value"`
+	} `json:"options"`
+}
+
+// grafanaQuery is a single Grafana target, translated to either a PromQL or a Lucene query.
+type grafanaQuery struct {
+	promql      string
+	lucene      string
+	aggregation *dashboards.LogsAggregation
+	groupBy     []string
+	legend      string
+}
+
+type grafanaConversionIssue struct {
+	title  string
+	kind   string
+	reason string
+}
+
+func dataSourceCoralogixGrafanaDashboardConversion() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCoralogixGrafanaDashboardConversionRead,
+
+		Schema: map[string]*schema.Schema{
+			"grafana_json": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				Description:      "The Grafana dashboard json, e.g. the config_json of a coralogix_hosted_dashboard.",
+				DiffSuppressFunc: SuppressEquivalentJSONDiffs,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the converted dashboard. Defaults to the Grafana dashboard title.",
+			},
+			"id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Id of the converted dashboard. When not set, content_json has no id and coralogix_dashboard assigns one on creation, and the data source id is a hash of content_json.",
+			},
+			"content_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The converted dashboard json. Can be passed to coralogix_dashboard.content_json.",
+			},
+			"unconverted_panels": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"title": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+				Description: "Panels (and variables) that could not be converted, or were converted only partially, with the reason.",
+			},
+		},
+
+		Description: fmt.Sprintf("Converts a Grafana dashboard json into coralogix_dashboard content_json. Supported panel types are %q, with PromQL and Lucene targets.", grafanaSupportedPanelTypes),
+	}
+}
+
+func dataSourceCoralogixGrafanaDashboardConversionRead(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	var grafana grafanaDashboard
+	if err := json.Unmarshal([]byte(d.Get("grafana_json").(string)), &grafana); err != nil {
+		return diag.Errorf("grafana_json is not a valid Grafana dashboard - %s", err)
+	}
+
+	name := grafana.Title
+	if n, ok := d.GetOk("name"); ok {
+		name = n.(string)
+	}
+
+	dashboard, issues := convertGrafanaDashboard(&grafana)
+	dashboard.Name = wrapperspb.String(name)
+	// Without a dashboard id, coralogix_dashboard assigns the dashboard's and the layout's ids on creation.
+	if id := d.Get("id").(string); id != "" {
+		dashboard.Id = wrapperspb.String(id)
+		fillDashboardLayoutIDs(dashboard)
+	}
+
+	contentJson, err := canonicalProtoJson(dashboard)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(dashboardDocumentID(dashboard.GetId().GetValue(), contentJson))
+	if err = d.Set("content_json", contentJson); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("unconverted_panels", flattenGrafanaConversionIssues(issues)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func flattenGrafanaConversionIssues(issues []grafanaConversionIssue) interface{} {
+	result := make([]interface{}, 0, len(issues))
+	for _, issue := range issues {
+		result = append(result, map[string]interface{}{
+			"title":  issue.title,
+			"type":   issue.kind,
+			"reason": issue.reason,
+		})
+	}
+	return result
+}
+
+func convertGrafanaDashboard(grafana *grafanaDashboard) (*dashboards.Dashboard, []grafanaConversionIssue) {
+	var issues []grafanaConversionIssue
+
+	panelsPerSection := splitGrafanaPanelsToSections(grafana)
+	sections := make([]*dashboards.Section, 0, len(panelsPerSection))
+	for _, panels := range panelsPerSection {
+		section, sectionIssues := convertGrafanaPanelsToSection(panels)
+		issues = append(issues, sectionIssues...)
+		if len(section.GetRows()) != 0 {
+			sections = append(sections, section)
+		}
+	}
+
+	variables, variableIssues := convertGrafanaVariables(grafana.Templating.List)
+	issues = append(issues, variableIssues...)
+
+	dashboard := &dashboards.Dashboard{
+		Description: wrapperspb.String(grafana.Description),
+		Layout: &dashboards.Layout{
+			Sections: sections,
+		},
+		Variables: variables,
+	}
+
+	if err := convertGrafanaTimeFrame(dashboard, grafana.Time.From, grafana.Time.To); err != nil {
+		issues = append(issues, grafanaConversionIssue{title: "time", kind: "time", reason: err.Error()})
+	}
+
+	return dashboard, issues
+}
+
+// splitGrafanaPanelsToSections maps every Grafana row to a section.
+// Panels that appear before the first row get a section of their own.
+func splitGrafanaPanelsToSections(grafana *grafanaDashboard) [][]grafanaPanel {
+	var sections [][]grafanaPanel
+	for _, row := range grafana.Rows {
+		sections = append(sections, row.Panels)
+	}
+
+	var current []grafanaPanel
+	for _, panel := range grafana.Panels {
+		if panel.Type != "row" {
+			current = append(current, panel)
+			continue
+		}
+		if len(current) != 0 {
+			sections = append(sections, current)
+		}
+		// collapsed rows hold their panels, expanded rows are followed by them.
+		current = append([]grafanaPanel{}, panel.Panels...)
+	}
+	if len(current) != 0 {
+		sections = append(sections, current)
+	}
+
+	return sections
+}
+
+// convertGrafanaPanelsToSection groups panels with the same gridPos.y into a row, ordered by gridPos.x. The
+// widgets keep the width of their panels (gridPos.w), and the row gets the height of its highest panel.
+func convertGrafanaPanelsToSection(panels []grafanaPanel) (*dashboards.Section, []grafanaConversionIssue) {
+	sort.SliceStable(panels, func(i, j int) bool {
+		if panels[i].GridPos.Y != panels[j].GridPos.Y {
+			return panels[i].GridPos.Y < panels[j].GridPos.Y
+		}
+		return panels[i].GridPos.X < panels[j].GridPos.X
+	})
+
+	var issues []grafanaConversionIssue
+	var rows []*dashboards.Row
+	var currentRow *dashboards.Row
+	currentY := -1
+	for _, panel := range panels {
+		widget, issue := convertGrafanaPanel(panel)
+		if issue != nil {
+			issues = append(issues, *issue)
+		}
+		if widget == nil {
+			continue
+		}
+
+		if currentRow == nil || panel.GridPos.Y != currentY {
+			currentRow = &dashboards.Row{Appearance: &dashboards.Row_Appearance{Height: wrapperspb.Int32(0)}}
+			rows = append(rows, currentRow)
+			currentY = panel.GridPos.Y
+		}
+		if int32(panel.GridPos.H) > currentRow.Appearance.Height.GetValue() {
+			currentRow.Appearance.Height = wrapperspb.Int32(int32(panel.GridPos.H))
+		}
+		currentRow.Widgets = append(currentRow.Widgets, widget)
+	}
+
+	return &dashboards.Section{Rows: rows}, issues
+}
+
+func convertGrafanaPanel(panel grafanaPanel) (*dashboards.Widget, *grafanaConversionIssue) {
+	var definition *dashboards.Widget_Definition
+	var err error
+	var partial []string
+	switch panel.Type {
+	case "timeseries", "graph":
+		definition, partial, err = convertGrafanaLineChart(panel)
+	case "stat", "gauge":
+		definition, partial, err = convertGrafanaGauge(panel)
+	case "table":
+		definition, partial, err = convertGrafanaDataTable(panel)
+	case "piechart":
+		definition, partial, err = convertGrafanaPieChart(panel)
+	case "barchart":
+		definition, partial, err = convertGrafanaBarChart(panel)
+	default:
+		err = fmt.Errorf("panel type %q is not supported, supported types are %q", panel.Type, grafanaSupportedPanelTypes)
+	}
+
+	if err != nil {
+		return nil, &grafanaConversionIssue{title: panel.Title, kind: panel.Type, reason: err.Error()}
+	}
+
+	widget := &dashboards.Widget{
+		Title:       wrapperspb.String(panel.Title),
+		Description: wrapperspb.String(panel.Description),
+		Definition:  definition,
+		Appearance:  &dashboards.Widget_Appearance{Width: wrapperspb.Int32(int32(panel.GridPos.W))},
+	}
+	if len(partial) != 0 {
+		return widget, &grafanaConversionIssue{title: panel.Title, kind: panel.Type, reason: "partially converted: " + strings.Join(partial, "; ")}
+	}
+	return widget, nil
+}
+
+// convertGrafanaTargets converts every visible target of the panel.
+// Targets that can't be converted are returned as reasons, and it's an error if none could be converted.
+func convertGrafanaTargets(panel grafanaPanel) ([]*grafanaQuery, []string, error) {
+	var queries []*grafanaQuery
+	var failures []string
+	for _, target := range panel.Targets {
+		if target.Hide {
+			continue
+		}
+		query, err := convertGrafanaTarget(panel.Datasource, target)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("target %s - %s", target.RefId, err))
+			continue
+		}
+		queries = append(queries, query)
+	}
+
+	if len(queries) == 0 {
+		if len(failures) == 0 {
+			return nil, nil, fmt.Errorf("panel has no targets")
+		}
+		return nil, nil, fmt.Errorf("%s", strings.Join(failures, "; "))
+	}
+	return queries, failures, nil
+}
+
+// convertSingleGrafanaTarget is used by widgets that accept only one query.
+func convertSingleGrafanaTarget(panel grafanaPanel) (*grafanaQuery, []string, error) {
+	queries, failures, err := convertGrafanaTargets(panel)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(queries) > 1 {
+		failures = append(failures, fmt.Sprintf("only the first of %d targets was converted", len(queries)))
+	}
+	return queries[0], failures, nil
+}
+
+func convertGrafanaTarget(panelDatasource json.RawMessage, target grafanaTarget) (*grafanaQuery, error) {
+	datasourceType := grafanaDatasourceType(target.Datasource)
+	if datasourceType == "" {
+		datasourceType = grafanaDatasourceType(panelDatasource)
+	}
+
+	switch {
+	case strings.Contains(datasourceType, "loki"):
+		return nil, fmt.Errorf("loki (LogQL) targets are not supported")
+	case strings.Contains(datasourceType, "elasticsearch"), strings.Contains(datasourceType, "opensearch"),
+		target.Expr == "" && target.Query != "":
+		aggregation, err := convertGrafanaLogsAggregation(target)
+		if err != nil {
+			return nil, err
+		}
+		var groupBy []string
+		for _, bucket := range target.BucketAggs {
+			if bucket.Type == "terms" && bucket.Field != "" {
+				groupBy = append(groupBy, bucket.Field)
+			}
+		}
+		return &grafanaQuery{
+			lucene:      target.Query,
+			aggregation: aggregation,
+			groupBy:     groupBy,
+			legend:      target.LegendFormat,
+		}, nil
+	case target.Expr != "":
+		return &grafanaQuery{
+			promql:  target.Expr,
+			groupBy: promqlGroupByLabels(target.Expr, target.LegendFormat),
+			legend:  target.LegendFormat,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("target has neither a PromQL expr nor a Lucene query")
+}
+
+// grafanaDatasourceType returns the datasource type from either a datasource object or a legacy datasource name.
+func grafanaDatasourceType(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+	var datasource struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(raw, &datasource); err == nil {
+		return strings.ToLower(datasource.Type)
+	}
+	var name string
+	if err := json.Unmarshal(raw, &name); err == nil {
+		return strings.ToLower(name)
+	}
+	return ""
+}
+
+func convertGrafanaLogsAggregation(target grafanaTarget) (*dashboards.LogsAggregation, error) {
+	if len(target.Metrics) == 0 {
+		return &dashboards.LogsAggregation{Value: &dashboards.LogsAggregation_Count_{Count: &dashboards.LogsAggregation_Count{}}}, nil
+	}
+
+	metric := target.Metrics[0]
+	field := wrapperspb.String(metric.Field)
+	switch metric.Type {
+	case "count":
+		return &dashboards.LogsAggregation{Value: &dashboards.LogsAggregation_Count_{Count: &dashboards.LogsAggregation_Count{}}}, nil
+	case "cardinality":
+		return &dashboards.LogsAggregation{Value: &dashboards.LogsAggregation_CountDistinct_{CountDistinct: &dashboards.LogsAggregation_CountDistinct{Field: field}}}, nil
+	case "sum":
+		return &dashboards.LogsAggregation{Value: &dashboards.LogsAggregation_Sum_{Sum: &dashboards.LogsAggregation_Sum{Field: field}}}, nil
+	case "avg":
+		return &dashboards.LogsAggregation{Value: &dashboards.LogsAggregation_Average_{Average: &dashboards.LogsAggregation_Average{Field: field}}}, nil
+	case "min":
+		return &dashboards.LogsAggregation{Value: &dashboards.LogsAggregation_Min_{Min: &dashboards.LogsAggregation_Min{Field: field}}}, nil
+	case "max":
+		return &dashboards.LogsAggregation{Value: &dashboards.LogsAggregation_Max_{Max: &dashboards.LogsAggregation_Max{Field: field}}}, nil
+	}
+
+	return nil, fmt.Errorf("elasticsearch metric %q is not supported", metric.Type)
+}
+
+// promqlGroupByLabels returns the labels the query is grouped by, taken from a by (...) clause
+// or, when there is none, from the {{label}} placeholders of the legend.
+func promqlGroupByLabels(expr, legend string) []string {
+	var labels []string
+	if match := promqlGroupByRegex.FindStringSubmatch(expr); match != nil {
+		for _, label := range strings.Split(match[1], ",") {
+			if label = strings.TrimSpace(label); label != "" {
+				labels = append(labels, label)
+			}
+		}
+		return labels
+	}
+	for _, match := range grafanaLegendLabelRegex.FindAllStringSubmatch(legend, -1) {
+		labels = append(labels, match[1])
+	}
+	return labels
+}
+
+// grafanaLegendToSeriesNameTemplate converts {{label}} placeholders to Coralogix {{ label }} placeholders.
+func grafanaLegendToSeriesNameTemplate(legend string) string {
+	if legend == "__auto" {
+		return ""
+	}
+	return grafanaLegendLabelRegex.ReplaceAllString(legend, "{{ $1 }}")
+}
+
+func convertGrafanaLineChart(panel grafanaPanel) (*dashboards.Widget_Definition, []string, error) {
+	queries, partial, err := convertGrafanaTargets(panel)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	unit := grafanaUnitToProtoUnit[panel.FieldConfig.Defaults.Unit]
+	queryDefinitions := make([]*dashboards.LineChart_QueryDefinition, 0, len(queries))
+	for _, query := range queries {
+		lineChartQuery := &dashboards.LineChart_Query{}
+		if query.promql != "" {
+			lineChartQuery.Value = &dashboards.LineChart_Query_Metrics{
+				Metrics: &dashboards.LineChart_MetricsQuery{
+					PromqlQuery: &dashboards.PromQlQuery{Value: wrapperspb.String(query.promql)},
+				},
+			}
+		} else {
+			lineChartQuery.Value = &dashboards.LineChart_Query_Logs{
+				Logs: &dashboards.LineChart_LogsQuery{
+					LuceneQuery:  &dashboards.LuceneQuery{Value: wrapperspb.String(query.lucene)},
+					GroupBy:      stringSliceToWrappedStringSlice(query.groupBy),
+					Aggregations: []*dashboards.LogsAggregation{query.aggregation},
+				},
+			}
+		}
+		queryDefinitions = append(queryDefinitions, &dashboards.LineChart_QueryDefinition{
+			Query:              lineChartQuery,
+			SeriesNameTemplate: wrapperspb.String(grafanaLegendToSeriesNameTemplate(query.legend)),
+			Unit:               unit,
+		})
+	}
+
+	showLegend := panel.Options.Legend.ShowLegend == nil || *panel.Options.Legend.ShowLegend
+	return &dashboards.Widget_Definition{
+		Value: &dashboards.Widget_Definition_LineChart{
+			LineChart: &dashboards.LineChart{
+				Legend:           &dashboards.Legend{IsVisible: wrapperspb.Bool(showLegend)},
+				QueryDefinitions: queryDefinitions,
+			},
+		},
+	}, partial, nil
+}
+
+func convertGrafanaGauge(panel grafanaPanel) (*dashboards.Widget_Definition, []string, error) {
+	query, partial, err := convertSingleGrafanaTarget(panel)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	aggregation := dashboards.Gauge_AGGREGATION_LAST
+	if calcs := panel.Options.ReduceOptions.Calcs; len(calcs) != 0 {
+		var ok bool
+		if aggregation, ok = grafanaReducerToProtoGaugeAggregation[calcs[0]]; !ok {
+			aggregation = dashboards.Gauge_AGGREGATION_LAST
+			partial = append(partial, fmt.Sprintf("reducer %q is not supported, using last", calcs[0]))
+		}
+	}
+
+	gaugeQuery := &dashboards.Gauge_Query{}
+	if query.promql != "" {
+		gaugeQuery.Value = &dashboards.Gauge_Query_Metrics{
+			Metrics: &dashboards.Gauge_MetricsQuery{
+				PromqlQuery: &dashboards.PromQlQuery{Value: wrapperspb.String(query.promql)},
+				Aggregation: aggregation,
+			},
+		}
+	} else {
+		gaugeQuery.Value = &dashboards.Gauge_Query_Logs{
+			Logs: &dashboards.Gauge_LogsQuery{
+				LuceneQuery:     &dashboards.LuceneQuery{Value: wrapperspb.String(query.lucene)},
+				LogsAggregation: query.aggregation,
+				Aggregation:     aggregation,
+			},
+		}
+	}
+
+	defaults := panel.FieldConfig.Defaults
+	unit, ok := grafanaUnitToProtoGaugeUnit[defaults.Unit]
+	if !ok {
+		unit = dashboards.Gauge_UNIT_NUMBER
+	}
+	min, max := 0.0, 100.0
+	if defaults.Min != nil {
+		min = *defaults.Min
+	}
+	if defaults.Max != nil {
+		max = *defaults.Max
+	}
+	thresholds := make([]*dashboards.Gauge_Threshold, 0, len(defaults.Thresholds.Steps))
+	for _, step := range defaults.Thresholds.Steps {
+		from := min
+		if step.Value != nil {
+			from = *step.Value
+		}
+		thresholds = append(thresholds, &dashboards.Gauge_Threshold{
+			From:  wrapperspb.Double(from),
+			Color: wrapperspb.String(step.Color),
+		})
+	}
+
+	return &dashboards.Widget_Definition{
+		Value: &dashboards.Widget_Definition_Gauge{
+			Gauge: &dashboards.Gauge{
+				Query:        gaugeQuery,
+				Min:          wrapperspb.Double(min),
+				Max:          wrapperspb.Double(max),
+				ShowInnerArc: wrapperspb.Bool(true),
+				ShowOuterArc: wrapperspb.Bool(true),
+				Unit:         unit,
+				Thresholds:   thresholds,
+			},
+		},
+	}, partial, nil
+}
+
+func convertGrafanaDataTable(panel grafanaPanel) (*dashboards.Widget_Definition, []string, error) {
+	query, partial, err := convertSingleGrafanaTarget(panel)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dataTableQuery := &dashboards.DataTable_Query{}
+	if query.promql != "" {
+		dataTableQuery.Value = &dashboards.DataTable_Query_Metrics{
+			Metrics: &dashboards.DataTable_MetricsQuery{
+				PromqlQuery: &dashboards.PromQlQuery{Value: wrapperspb.String(query.promql)},
+			},
+		}
+	} else {
+		dataTableQuery.Value = &dashboards.DataTable_Query_Logs{
+			Logs: &dashboards.DataTable_LogsQuery{
+				LuceneQuery: &dashboards.LuceneQuery{Value: wrapperspb.String(query.lucene)},
+			},
+		}
+	}
+
+	return &dashboards.Widget_Definition{
+		Value: &dashboards.Widget_Definition_DataTable{
+			DataTable: &dashboards.DataTable{
+				Query:          dataTableQuery,
+				ResultsPerPage: wrapperspb.Int32(20),
+				RowStyle:       dashboards.RowStyle_ROW_STYLE_ONE_LINE,
+			},
+		},
+	}, partial, nil
+}
+
+func convertGrafanaPieChart(panel grafanaPanel) (*dashboards.Widget_Definition, []string, error) {
+	query, partial, err := convertSingleGrafanaTarget(panel)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pieChartQuery := &dashboards.PieChart_Query{}
+	if query.promql != "" {
+		pieChartQuery.Value = &dashboards.PieChart_Query_Metrics{
+			Metrics: &dashboards.PieChart_MetricsQuery{
+				PromqlQuery: &dashboards.PromQlQuery{Value: wrapperspb.String(query.promql)},
+				GroupNames:  stringSliceToWrappedStringSlice(query.groupBy),
+			},
+		}
+	} else {
+		pieChartQuery.Value = &dashboards.PieChart_Query_Logs{
+			Logs: &dashboards.PieChart_LogsQuery{
+				LuceneQuery: &dashboards.LuceneQuery{Value: wrapperspb.String(query.lucene)},
+				Aggregation: query.aggregation,
+				GroupNames:  stringSliceToWrappedStringSlice(query.groupBy),
+			},
+		}
+	}
+
+	showLegend := panel.Options.Legend.ShowLegend == nil || *panel.Options.Legend.ShowLegend
+	return &dashboards.Widget_Definition{
+		Value: &dashboards.Widget_Definition_PieChart{
+			PieChart: &dashboards.PieChart{
+				Query:             pieChartQuery,
+				ShowLegend:        wrapperspb.Bool(showLegend),
+				GroupNameTemplate: wrapperspb.String(grafanaLegendToSeriesNameTemplate(query.legend)),
+				LabelDefinition: &dashboards.PieChart_LabelDefinition{
+					LabelSource:    dashboards.PieChart_LABEL_SOURCE_INNER,
+					IsVisible:      wrapperspb.Bool(true),
+					ShowName:       wrapperspb.Bool(true),
+					ShowValue:      wrapperspb.Bool(true),
+					ShowPercentage: wrapperspb.Bool(true),
+				},
+				Unit: grafanaUnitToProtoUnit[panel.FieldConfig.Defaults.Unit],
+			},
+		},
+	}, partial, nil
+}
+
+func convertGrafanaBarChart(panel grafanaPanel) (*dashboards.Widget_Definition, []string, error) {
+	query, partial, err := convertSingleGrafanaTarget(panel)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	barChartQuery := &dashboards.BarChart_Query{}
+	if query.promql != "" {
+		barChartQuery.Value = &dashboards.BarChart_Query_Metrics{
+			Metrics: &dashboards.BarChart_MetricsQuery{
+				PromqlQuery: &dashboards.PromQlQuery{Value: wrapperspb.String(query.promql)},
+				GroupNames:  stringSliceToWrappedStringSlice(query.groupBy),
+			},
+		}
+	} else {
+		barChartQuery.Value = &dashboards.BarChart_Query_Logs{
+			Logs: &dashboards.BarChart_LogsQuery{
+				LuceneQuery: &dashboards.LuceneQuery{Value: wrapperspb.String(query.lucene)},
+				Aggregation: query.aggregation,
+				GroupNames:  stringSliceToWrappedStringSlice(query.groupBy),
+			},
+		}
+	}
+
+	return &dashboards.Widget_Definition{
+		Value: &dashboards.Widget_Definition_BarChart{
+			BarChart: &dashboards.BarChart{
+				Query:             barChartQuery,
+				GroupNameTemplate: wrapperspb.String(grafanaLegendToSeriesNameTemplate(query.legend)),
+				ColorsBy: &dashboards.BarChart_ColorsBy{
+					Value: &dashboards.BarChart_ColorsBy_GroupBy{GroupBy: &dashboards.BarChart_ColorsBy_ColorsByGroupBy{}},
+				},
+				XAxis: &dashboards.BarChart_XAxis{
+					Type: &dashboards.BarChart_XAxis_Value{Value: &dashboards.BarChart_XAxis_XAxisByValue{}},
+				},
+				Unit: grafanaUnitToProtoUnit[panel.FieldConfig.Defaults.Unit],
+			},
+		},
+	}, partial, nil
+}
+
+// convertGrafanaVariables converts custom variables to constant lists and
+// Prometheus label_values(metric, label) query variables to metric label sources.
+func convertGrafanaVariables(grafanaVariables []grafanaVariable) ([]*dashboards.Variable, []grafanaConversionIssue) {
+	var variables []*dashboards.Variable
+	var issues []grafanaConversionIssue
+	for _, variable := range grafanaVariables {
+		var source *dashboards.MultiSelect_Source
+		var reason string
+		switch variable.Type {
+		case "custom":
+			values := make([]string, 0, len(variable.Options))
+			for _, option := range variable.Options {
+				values = append(values, option.Value)
+			}
+			source = &dashboards.MultiSelect_Source{
+				Value: &dashboards.MultiSelect_Source_ConstantList{
+					ConstantList: &dashboards.MultiSelect_ConstantListSource{Values: stringSliceToWrappedStringSlice(values)},
+				},
+			}
+		case "query":
+			if match := grafanaLabelValuesRegex.FindStringSubmatch(grafanaVariableQuery(variable.Query)); match != nil {
+				source = &dashboards.MultiSelect_Source{
+					Value: &dashboards.MultiSelect_Source_MetricLabel{
+						MetricLabel: &dashboards.MultiSelect_MetricLabelSource{
+							MetricName: wrapperspb.String(match[1]),
+							Label:      wrapperspb.String(match[2]),
+						},
+					},
+				}
+			} else {
+				reason = fmt.Sprintf("only label_values(metric, label) queries are supported, got %q", grafanaVariableQuery(variable.Query))
+			}
+		default:
+			reason = fmt.Sprintf("only custom and query variables are supported, got a %q variable", variable.Type)
+		}
+
+		if source == nil {
+			issues = append(issues, grafanaConversionIssue{title: variable.Name, kind: "variable", reason: reason})
+			continue
+		}
+
+		variables = append(variables, &dashboards.Variable{
+			Name: wrapperspb.String(variable.Name),
+			Definition: &dashboards.Variable_Definition{
+				Value: &dashboards.Variable_Definition_MultiSelect{
+					MultiSelect: &dashboards.MultiSelect{
+						Source: source,
+						Selection: &dashboards.MultiSelect_Selection{
+							Value: &dashboards.MultiSelect_Selection_All{All: &dashboards.MultiSelect_Selection_AllSelection{}},
+						},
+					},
+				},
+			},
+		})
+	}
+	return variables, issues
+}
+
+// grafanaVariableQuery returns the query of a variable, that is either a string or a {"query": "..."} object.
+func grafanaVariableQuery(raw json.RawMessage) string {
+	var query string
+	if err := json.Unmarshal(raw, &query); err == nil {
+		return query
+	}
+	var queryObject struct {
+		Query string `json:"query"`
+	}
+	if err := json.Unmarshal(raw, &queryObject); err == nil {
+		return queryObject.Query
+	}
+	return ""
+}
+
+func convertGrafanaTimeFrame(dashboard *dashboards.Dashboard, from, to string) error {
+	if from == "" {
+		return nil
+	}
+
+	if match := grafanaRelativeTimeRegex.FindStringSubmatch(from); match != nil && to == "now" {
+		relativeTimeFrame, err := expandDashboardRelativeTimeFrame(match[1])
+		if err != nil {
+			return err
+		}
+		dashboard.TimeFrame = &dashboards.Dashboard_RelativeTimeFrame{RelativeTimeFrame: durationpb.New(relativeTimeFrame)}
+		return nil
+	}
+
+	start, startErr := time.Parse(time.RFC3339, from)
+	end, endErr := time.Parse(time.RFC3339, to)
+	if startErr == nil && endErr == nil {
+		dashboard.TimeFrame = &dashboards.Dashboard_AbsoluteTimeFrame{
+			AbsoluteTimeFrame: &dashboards.TimeFrame{From: timestamppb.New(start), To: timestamppb.New(end)},
+		}
+		return nil
+	}
+
+	return fmt.Errorf("time frame from %q to %q is not supported, only now-<duration> to now and RFC3339 times are", from, to)
+}
+
+func stringSliceToWrappedStringSlice(s []string) []*wrapperspb.StringValue {
+	result := make([]*wrapperspb.StringValue, 0, len(s))
+	for _, v := range s {
+		result = append(result, wrapperspb.String(v))
+	}
+	return result
+}
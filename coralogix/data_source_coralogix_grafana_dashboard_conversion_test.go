@@ -0,0 +1,200 @@
+package coralogix
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	dashboards "terraform-provider-coralogix/coralogix/clientset/grpc/coralogix-dashboards/v1"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var grafanaDashboardConversionDataSourceName = "data.coralogix_grafana_dashboard_conversion.test"
+
+func TestAccCoralogixDataSourceGrafanaDashboardConversion_basic(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		panic(err)
+	}
+	parent := filepath.Dir(wd)
+	filePath := parent + "/examples/grafana_dashboard_conversion/grafana_dashboard.json"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDashboardDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoralogixDataSourceGrafanaDashboardConversion(filePath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(grafanaDashboardConversionDataSourceName, "content_json"),
+					resource.TestCheckResourceAttr(grafanaDashboardConversionDataSourceName, "unconverted_panels.#", "2"),
+					resource.TestCheckResourceAttr(grafanaDashboardConversionDataSourceName, "unconverted_panels.0.title", "requests per status"),
+					resource.TestCheckResourceAttr(grafanaDashboardConversionDataSourceName, "unconverted_panels.1.type", "heatmap"),
+					resource.TestCheckResourceAttrSet(dashboardResourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCoralogixDataSourceGrafanaDashboardConversion(jsonFilePath string) string {
+	return fmt.Sprintf(`data "coralogix_grafana_dashboard_conversion" "test" {
+  grafana_json = file("%s")
+}
+
+resource "coralogix_dashboard" "test" {
+  content_json = data.coralogix_grafana_dashboard_conversion.test.content_json
+}
+`, jsonFilePath)
+}
+
+func TestGrafanaDashboardConversion(t *testing.T) {
+	tests := []struct {
+		fixture string
+		// sections lists the rows of every section, as the "title/width" of their widgets.
+		sections [][]string
+		// heights lists the height of every row, by section.
+		heights [][]int32
+		// definitions lists the widget definition types, by title.
+		definitions map[string]string
+		variables   []string
+		// issues lists the unconverted panels, as "title/type: reason", where the reason is a prefix.
+		issues []string
+	}{
+		{
+			fixture:  "panels",
+			sections: [][]string{{"requests/12 cpu/6 errors/6", "slow requests/8 requests per region/8 errors per service/8"}},
+			heights:  [][]int32{{8, 10}},
+			definitions: map[string]string{
+				"requests":            "line_chart",
+				"cpu":                 "gauge",
+				"errors":              "gauge",
+				"slow requests":       "data_table",
+				"requests per region": "pie_chart",
+				"errors per service":  "bar_chart",
+			},
+		},
+		{
+			fixture: "rows",
+			sections: [][]string{
+				{"before the rows/24"},
+				{"in the expanded row/24"},
+				{"in the collapsed row/12"},
+			},
+		},
+		{
+			fixture:  "legacy_rows",
+			sections: [][]string{{"first graph/12"}, {"second graph/12"}},
+		},
+		{
+			fixture:   "variables",
+			sections:  [][]string{{"up/24"}},
+			variables: []string{"env", "namespace"},
+			issues: []string{
+				`pod/variable: only label_values(metric, label) queries are supported, got "query_result(kube_pod_info)"`,
+				`interval/variable: only custom and query variables are supported, got a "interval" variable`,
+			},
+		},
+		{
+			fixture:  "unsupported",
+			sections: [][]string{{"mixed/12"}},
+			issues: []string{
+				`latency heatmap/heatmap: panel type "heatmap" is not supported`,
+				`logs rate/timeseries: target A - loki (LogQL) targets are not supported`,
+				`no targets/stat: panel has no targets`,
+				`mixed/timeseries: partially converted: target B - loki (LogQL) targets are not supported`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			grafanaJson, err := os.ReadFile(filepath.Join("testdata", "grafana_dashboard_conversion", tt.fixture+".json"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			d := schema.TestResourceDataRaw(t, dataSourceCoralogixGrafanaDashboardConversion().Schema, map[string]interface{}{
+				"grafana_json": string(grafanaJson),
+			})
+			if diags := dataSourceCoralogixGrafanaDashboardConversionRead(context.Background(), d, nil); diags.HasError() {
+				t.Fatalf("read: %v", diags)
+			}
+			dashboard := new(dashboards.Dashboard)
+			if err = protojson.Unmarshal([]byte(d.Get("content_json").(string)), dashboard); err != nil {
+				t.Fatal(err)
+			}
+
+			// Without an id, coralogix_dashboard assigns the ids.
+			if dashboard.Id != nil {
+				t.Errorf("id: got %s, want none", dashboard.GetId().GetValue())
+			}
+			if got := dashboard.GetName().GetValue(); got != strings.ReplaceAll(tt.fixture, "_", " ") {
+				t.Errorf("name: got %q, want the title of the Grafana dashboard", got)
+			}
+
+			var sections [][]string
+			var heights [][]int32
+			definitions := make(map[string]string)
+			for _, section := range dashboard.GetLayout().GetSections() {
+				var rows []string
+				var rowHeights []int32
+				for _, row := range section.GetRows() {
+					var widgets []string
+					for _, widget := range row.GetWidgets() {
+						if widget.GetId() != nil {
+							t.Errorf("widget %s: got id %s, want none", widget.GetTitle().GetValue(), widget.GetId().GetValue())
+						}
+						widgets = append(widgets, fmt.Sprintf("%s/%d", widget.GetTitle().GetValue(), widget.GetAppearance().GetWidth().GetValue()))
+						definition := widget.GetDefinition().ProtoReflect()
+						definition.Range(func(field protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+							definitions[widget.GetTitle().GetValue()] = string(field.Name())
+							return false
+						})
+					}
+					rows = append(rows, strings.Join(widgets, " "))
+					rowHeights = append(rowHeights, row.GetAppearance().GetHeight().GetValue())
+				}
+				sections = append(sections, rows)
+				heights = append(heights, rowHeights)
+			}
+			if !reflect.DeepEqual(sections, tt.sections) {
+				t.Errorf("sections: got %q, want %q", sections, tt.sections)
+			}
+			if tt.heights != nil && !reflect.DeepEqual(heights, tt.heights) {
+				t.Errorf("row heights: got %v, want %v", heights, tt.heights)
+			}
+			for title, want := range tt.definitions {
+				if definitions[title] != want {
+					t.Errorf("widget %s: got a %s, want a %s", title, definitions[title], want)
+				}
+			}
+
+			var variables []string
+			for _, variable := range dashboard.GetVariables() {
+				variables = append(variables, variable.GetName().GetValue())
+			}
+			if !reflect.DeepEqual(variables, tt.variables) {
+				t.Errorf("variables: got %q, want %q", variables, tt.variables)
+			}
+
+			issues := d.Get("unconverted_panels").([]interface{})
+			if len(issues) != len(tt.issues) {
+				t.Fatalf("unconverted_panels: got %v, want %q", issues, tt.issues)
+			}
+			for i, want := range tt.issues {
+				issue := issues[i].(map[string]interface{})
+				if got := fmt.Sprintf("%s/%s: %s", issue["title"], issue["type"], issue["reason"]); !strings.HasPrefix(got, want) {
+					t.Errorf("unconverted_panels.%d: got %q, want %q", i, got, want)
+				}
+			}
+		})
+	}
+}
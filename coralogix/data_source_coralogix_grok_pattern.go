@@ -0,0 +1,237 @@
+package coralogix
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	// grokPatterns is the standard grok pattern library (Logstash's legacy grok-patterns). The lookarounds and atomic
+	// groups of the original definitions are left out, so the expanded regular expressions are RE2 compatible as well.
+	grokPatterns = map[string]string{
+		"USERNAME":           `[a-zA-Z0-9._-]+`,
+		"USER":               `%{USERNAME}`,
+		"EMAILLOCALPART":     `[a-zA-Z0-9!#$%&'*+/=?^_{|}~-]+(?:\.[a-zA-Z0-9!#$%&'*+/=?^_{|}~-]+)*`,
+		"EMAILADDRESS":       `%{EMAILLOCALPART}@%{HOSTNAME}`,
+		"INT":                `(?:[+-]?(?:[0-9]+))`,
+		"BASE10NUM":          `(?:[+-]?(?:[0-9]+(?:\.[0-9]+)?|\.[0-9]+))`,
+		"NUMBER":             `(?:%{BASE10NUM})`,
+		"BASE16NUM":          `(?:[+-]?(?:0x)?(?:[0-9A-Fa-f]+))`,
+		"BASE16FLOAT":        `\b(?:[+-]?(?:0x)?(?:(?:[0-9A-Fa-f]+(?:\.[0-9A-Fa-f]*)?)|(?:\.[0-9A-Fa-f]+)))\b`,
+		"POSINT":             `\b(?:[1-9][0-9]*)\b`,
+		"NONNEGINT":          `\b(?:[0-9]+)\b`,
+		"WORD":               `\b\w+\b`,
+		"NOTSPACE":           `\S+`,
+		"SPACE":              `\s*`,
+		"DATA":               `.*?`,
+		"GREEDYDATA":         `.*`,
+		"QUOTEDSTRING":       `(?:"(?:\\.|[^\\"])*"|'(?:\\.|[^\\'])*'|` + "`(?:\\\\.|[^\\\\`])*`)",
+		"UUID":               `[A-Fa-f0-9]{8}-(?:[A-Fa-f0-9]{4}-){3}[A-Fa-f0-9]{12}`,
+		"URN":                `urn:[0-9A-Za-z][0-9A-Za-z-]{0,31}:(?:%[0-9a-fA-F]{2}|[0-9A-Za-z()+,.:=@;$_!*'/?#-])+`,
+		"MAC":                `(?:%{CISCOMAC}|%{WINDOWSMAC}|%{COMMONMAC})`,
+		"CISCOMAC":           `(?:(?:[A-Fa-f0-9]{4}\.){2}[A-Fa-f0-9]{4})`,
+		"WINDOWSMAC":         `(?:(?:[A-Fa-f0-9]{2}-){5}[A-Fa-f0-9]{2})`,
+		"COMMONMAC":          `(?:(?:[A-Fa-f0-9]{2}:){5}[A-Fa-f0-9]{2})`,
+		"IPV6":               `(?:(?:(?:[0-9A-Fa-f]{1,4}:){7}(?:[0-9A-Fa-f]{1,4}|:))|(?:(?:[0-9A-Fa-f]{1,4}:){6}(?::[0-9A-Fa-f]{1,4}|(?:(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)(?:\.(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)){3})|:))|(?:(?:[0-9A-Fa-f]{1,4}:){5}(?:(?:(?::[0-9A-Fa-f]{1,4}){1,2})|:(?:(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)(?:\.(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)){3})|:))|(?:(?:[0-9A-Fa-f]{1,4}:){4}(?:(?:(?::[0-9A-Fa-f]{1,4}){1,3})|(?:(?::[0-9A-Fa-f]{1,4})?:(?:(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)(?:\.(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)){3}))|:))|(?:(?:[0-9A-Fa-f]{1,4}:){3}(?:(?:(?::[0-9A-Fa-f]{1,4}){1,4})|(?:(?::[0-9A-Fa-f]{1,4}){0,2}:(?:(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)(?:\.(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)){3}))|:))|(?:(?:[0-9A-Fa-f]{1,4}:){2}(?:(?:(?::[0-9A-Fa-f]{1,4}){1,5})|(?:(?::[0-9A-Fa-f]{1,4}){0,3}:(?:(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)(?:\.(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)){3}))|:))|(?:(?:[0-9A-Fa-f]{1,4}:){1}(?:(?:(?::[0-9A-Fa-f]{1,4}){1,6})|(?:(?::[0-9A-Fa-f]{1,4}){0,4}:(?:(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)(?:\.(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)){3}))|:))|(?::(?:(?:(?::[0-9A-Fa-f]{1,4}){1,7})|(?:(?::[0-9A-Fa-f]{1,4}){0,5}:(?:(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)(?:\.(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)){3}))|:)))(?:%.+)?`,
+		"IPV4":               `(?:(?:25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\.){3}(?:25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)`,
+		"IP":                 `(?:%{IPV6}|%{IPV4})`,
+		"HOSTNAME":           `\b(?:[0-9A-Za-z][0-9A-Za-z-]{0,62})(?:\.(?:[0-9A-Za-z][0-9A-Za-z-]{0,62}))*(?:\.?|\b)`,
+		"IPORHOST":           `(?:%{IP}|%{HOSTNAME})`,
+		"HOSTPORT":           `%{IPORHOST}:%{POSINT}`,
+		"PATH":               `(?:%{UNIXPATH}|%{WINPATH})`,
+		"UNIXPATH":           `(?:/(?:[\w_%!$@:.,+~-]+|\\.)*)+`,
+		"TTY":                `(?:/dev/(?:pts|tty(?:[pq])?)(?:\w+)?/?(?:[0-9]+))`,
+		"WINPATH":            `(?:[A-Za-z]+:|\\)(?:\\[^\\?*]*)+`,
+		"URIPROTO":           `[A-Za-z](?:[A-Za-z0-9+\-.]+)+`,
+		"URIHOST":            `%{IPORHOST}(?::%{POSINT})?`,
+		"URIPATH":            `(?:/[A-Za-z0-9$.+!*'(){},~:;=@#%&_\-]*)+`,
+		"URIQUERY":           `[A-Za-z0-9$.+!*'|(){},~@#%&/=:;_?\-\[\]<>]*`,
+		"URIPARAM":           `\?%{URIQUERY}`,
+		"URIPATHPARAM":       `%{URIPATH}(?:%{URIPARAM})?`,
+		"URI":                `%{URIPROTO}://(?:%{USER}(?::[^@]*)?@)?(?:%{URIHOST})?(?:%{URIPATH}(?:%{URIPARAM})?)?`,
+		"MONTH":              `\b(?:[Jj]an(?:uary|uar)?|[Ff]eb(?:ruary|ruar)?|[Mm](?:a|ä)?r(?:ch|z)?|[Aa]pr(?:il)?|[Mm]a(?:y|i)?|[Jj]un(?:e|i)?|[Jj]ul(?:y|i)?|[Aa]ug(?:ust)?|[Ss]ep(?:tember)?|[Oo](?:c|k)?t(?:ober)?|[Nn]ov(?:ember)?|[Dd]e(?:c|z)(?:ember)?)\b`,
+		"MONTHNUM":           `(?:0?[1-9]|1[0-2])`,
+		"MONTHNUM2":          `(?:0[1-9]|1[0-2])`,
+		"MONTHDAY":           `(?:(?:0[1-9])|(?:[12][0-9])|(?:3[01])|[1-9])`,
+		"DAY":                `(?:Mon(?:day)?|Tue(?:sday)?|Wed(?:nesday)?|Thu(?:rsday)?|Fri(?:day)?|Sat(?:urday)?|Sun(?:day)?)`,
+		"YEAR":               `(?:\d\d){1,2}`,
+		"HOUR":               `(?:2[0123]|[01]?[0-9])`,
+		"MINUTE":             `(?:[0-5][0-9])`,
+		"SECOND":             `(?:(?:[0-5]?[0-9]|60)(?:[:.,][0-9]+)?)`,
+		"TIME":               `%{HOUR}:%{MINUTE}(?::%{SECOND})`,
+		"DATE_US":            `%{MONTHNUM}[/-]%{MONTHDAY}[/-]%{YEAR}`,
+		"DATE_EU":            `%{MONTHDAY}[./-]%{MONTHNUM}[./-]%{YEAR}`,
+		"ISO8601_TIMEZONE":   `(?:Z|[+-]%{HOUR}(?::?%{MINUTE}))`,
+		"ISO8601_SECOND":     `%{SECOND}`,
+		"TIMESTAMP_ISO8601":  `%{YEAR}-%{MONTHNUM}-%{MONTHDAY}[T ]%{HOUR}:?%{MINUTE}(?::?%{SECOND})?%{ISO8601_TIMEZONE}?`,
+		"DATE":               `%{DATE_US}|%{DATE_EU}`,
+		"DATESTAMP":          `%{DATE}[- ]%{TIME}`,
+		"TZ":                 `(?:[APMCE][SD]T|UTC)`,
+		"DATESTAMP_RFC822":   `%{DAY} %{MONTH} %{MONTHDAY} %{YEAR} %{TIME} %{TZ}`,
+		"DATESTAMP_RFC2822":  `%{DAY}, %{MONTHDAY} %{MONTH} %{YEAR} %{TIME} %{ISO8601_TIMEZONE}`,
+		"DATESTAMP_OTHER":    `%{DAY} %{MONTH} %{MONTHDAY} %{TIME} %{TZ} %{YEAR}`,
+		"DATESTAMP_EVENTLOG": `%{YEAR}%{MONTHNUM2}%{MONTHDAY}%{HOUR}%{MINUTE}%{SECOND}`,
+		"SYSLOGTIMESTAMP":    `%{MONTH} +%{MONTHDAY} %{TIME}`,
+		"PROG":               `[\x21-\x5a\x5c\x5e-\x7e]+`,
+		"SYSLOGPROG":         `%{PROG:program}(?:\[%{POSINT:pid}\])?`,
+		"SYSLOGHOST":         `%{IPORHOST}`,
+		"SYSLOGFACILITY":     `<%{NONNEGINT:facility}.%{NONNEGINT:priority}>`,
+		"HTTPDATE":           `%{MONTHDAY}/%{MONTH}/%{YEAR}:%{TIME} %{INT}`,
+		"QS":                 `%{QUOTEDSTRING}`,
+		"SYSLOGBASE":         `%{SYSLOGTIMESTAMP:timestamp} (?:%{SYSLOGFACILITY} )?%{SYSLOGHOST:logsource} %{SYSLOGPROG}:`,
+		"LOGLEVEL":           `(?:[Aa]lert|ALERT|[Tt]race|TRACE|[Dd]ebug|DEBUG|[Nn]otice|NOTICE|[Ii]nfo?(?:rmation)?|INFO?(?:RMATION)?|[Ww]arn?(?:ing)?|WARN?(?:ING)?|[Ee]rr?(?:or)?|ERR?(?:OR)?|[Cc]rit?(?:ical)?|CRIT?(?:ICAL)?|[Ff]atal|FATAL|[Ss]evere|SEVERE|EMERG(?:ENCY)?|[Ee]merg(?:ency)?)`,
+		"HTTPDUSER":          `%{EMAILADDRESS}|%{USER}`,
+		"HTTPDERROR_DATE":    `%{DAY} %{MONTH} %{MONTHDAY} %{TIME} %{YEAR}`,
+		"COMMONAPACHELOG":    `%{IPORHOST:clientip} %{HTTPDUSER:ident} %{HTTPDUSER:auth} \[%{HTTPDATE:timestamp}\] "(?:%{WORD:verb} %{NOTSPACE:request}(?: HTTP/%{NUMBER:httpversion})?|%{DATA:rawrequest})" %{NUMBER:response} (?:%{NUMBER:bytes}|-)`,
+		"COMBINEDAPACHELOG":  `%{COMMONAPACHELOG} %{QS:referrer} %{QS:agent}`,
+	}
+	grokReferenceRegex          = regexp.MustCompile(`%\{(\w+)(?::([^:}]+))?(?::(\w+))?\}`)
+	grokPatternNameRegex        = regexp.MustCompile(`^\w+$`)
+	grokFieldNameSeparatorRegex = regexp.MustCompile(`[^A-Za-z0-9_]+`)
+)
+
+func dataSourceCoralogixGrokPattern() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCoralogixGrokPatternRead,
+
+		Schema: map[string]*schema.Schema{
+			"pattern": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description: "The grok expression, e.g. %{COMBINEDAPACHELOG} or %{IP:client} %{WORD:method}. " +
+					"Semantic types (e.g. %{NUMBER:bytes:int}) are ignored, since capture groups are parsed as strings. " +
+					"Note that %{ starts a template directive in Terraform strings, so it has to be escaped as %%{.",
+			},
+			"custom_patterns": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: validation.MapKeyMatch(grokPatternNameRegex, "pattern names may contain only letters, digits and underscores"),
+				Description:      "Additional patterns by name, like the patterns_dir files of Logstash. They may refer to each other and to the standard patterns, and override standard patterns of the same name.",
+			},
+			"regular_expression": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The expanded regular expression, with a named capture group for every named grok field. Can be used as the regular_expression of parse and extract rules.",
+			},
+			"field_names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The capture group names, in order. Field names which aren't valid group names are converted, e.g. [client][ip] and client.ip into client_ip.",
+			},
+		},
+
+		Description: "Expands a grok expression (Logstash's grok filter syntax) into a regular expression with named capture groups, for parse and extract rules of coralogix_rules_group.",
+	}
+}
+
+func dataSourceCoralogixGrokPatternRead(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	pattern := d.Get("pattern").(string)
+	patterns := make(map[string]string, len(grokPatterns))
+	for name, definition := range grokPatterns {
+		patterns[name] = definition
+	}
+	customPatterns := d.Get("custom_patterns").(map[string]interface{})
+	for name, definition := range customPatterns {
+		patterns[name] = definition.(string)
+	}
+
+	expander := &grokExpander{patterns: patterns, expanding: make(map[string]bool)}
+	expression, err := expander.expand(pattern)
+	if err != nil {
+		return diag.Errorf("invalid grok pattern - %s", err)
+	}
+	diags := validateRulesRegularExpression(expression, cty.GetAttrPath("pattern"))
+	if diags.HasError() {
+		return diags
+	}
+
+	input, _ := json.Marshal([]interface{}{pattern, customPatterns})
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256(input)))
+	if err = d.Set("regular_expression", expression); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	if err = d.Set("field_names", expander.fields); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+type grokExpander struct {
+	patterns map[string]string
+	// expanding holds the patterns which are being expanded, to detect patterns which refer to themselves.
+	expanding map[string]bool
+	fields    []string
+}
+
+// expand replaces every %{PATTERN} reference in pattern with the pattern's definition, as a capture group named
+// after the field for %{PATTERN:field} references, or as a non-capturing group otherwise.
+func (e *grokExpander) expand(pattern string) (string, error) {
+	var err error
+	expanded := grokReferenceRegex.ReplaceAllStringFunc(pattern, func(reference string) string {
+		if err != nil {
+			return ""
+		}
+		match := grokReferenceRegex.FindStringSubmatch(reference)
+		name, field := match[1], match[2]
+		definition, ok := e.patterns[name]
+		if !ok {
+			err = fmt.Errorf("unknown pattern %q in %s, it's neither a standard pattern nor one of custom_patterns", name, reference)
+			return ""
+		}
+		if e.expanding[name] {
+			err = fmt.Errorf("pattern %q refers to itself", name)
+			return ""
+		}
+
+		var groupName string
+		if field != "" {
+			if groupName, err = e.addField(field); err != nil {
+				return ""
+			}
+		}
+		e.expanding[name] = true
+		var definitionExpanded string
+		definitionExpanded, err = e.expand(definition)
+		delete(e.expanding, name)
+		if err != nil {
+			return ""
+		}
+
+		if groupName == "" {
+			return fmt.Sprintf("(?:%s)", definitionExpanded)
+		}
+		return fmt.Sprintf("(?P<%s>%s)", groupName, definitionExpanded)
+	})
+	return expanded, err
+}
+
+// addField returns the capture group name of a grok field, e.g. client_ip for [client][ip] or client.ip.
+func (e *grokExpander) addField(field string) (string, error) {
+	groupName := strings.Trim(grokFieldNameSeparatorRegex.ReplaceAllString(field, "_"), "_")
+	if groupName == "" {
+		return "", fmt.Errorf("field name %q has no letters or digits", field)
+	}
+	if isDigit(groupName[0]) {
+		groupName = "_" + groupName
+	}
+	for _, f := range e.fields {
+		if f == groupName {
+			return "", fmt.Errorf("field %q is captured more than once, but capture group names have to be unique", groupName)
+		}
+	}
+	e.fields = append(e.fields, groupName)
+	return groupName, nil
+}
@@ -0,0 +1,50 @@
+package coralogix
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var grokPatternDataSourceName = "data.coralogix_grok_pattern.test"
+
+func TestAccCoralogixDataSourceGrokPattern_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoralogixDataSourceGrokPattern(`%%{COMBINEDAPACHELOG}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(grokPatternDataSourceName, "field_names.#", "12"),
+					resource.TestCheckResourceAttr(grokPatternDataSourceName, "field_names.0", "clientip"),
+					resource.TestCheckResourceAttr(grokPatternDataSourceName, "field_names.11", "agent"),
+				),
+			},
+			{
+				Config: testAccCoralogixDataSourceGrokPattern(`%%{ORDER_ID:[order][id]} %%{WORD:status}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(grokPatternDataSourceName, "regular_expression", `(?P<order_id>[A-Z]{3}-(?:(?:[+-]?(?:[0-9]+)))) (?P<status>\b\w+\b)`),
+					resource.TestCheckResourceAttr(grokPatternDataSourceName, "field_names.#", "2"),
+					resource.TestCheckResourceAttr(grokPatternDataSourceName, "field_names.0", "order_id"),
+					resource.TestCheckResourceAttr(grokPatternDataSourceName, "field_names.1", "status"),
+				),
+			},
+			{
+				Config:      testAccCoralogixDataSourceGrokPattern(`%%{IPADDRESS:client}`),
+				ExpectError: regexp.MustCompile(`unknown pattern "IPADDRESS"`),
+			},
+		},
+	})
+}
+
+func testAccCoralogixDataSourceGrokPattern(pattern string) string {
+	return `data "coralogix_grok_pattern" "test" {
+  pattern = "` + pattern + `"
+  custom_patterns = {
+    ORDER_ID = "[A-Z]{3}-%%{INT}"
+  }
+}
+`
+}
@@ -0,0 +1,415 @@
+package coralogix
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	prometheusSeverityToAlertSeverity = map[string]string{
+		"critical":    "Critical",
+		"error":       "Error",
+		"warning":     "Warning",
+		"warn":        "Warning",
+		"info":        "Info",
+		"information": "Info",
+	}
+	alertMetricTimeFrameDurations = map[string]time.Duration{
+		"1Min":  time.Minute,
+		"5Min":  5 * time.Minute,
+		"10Min": 10 * time.Minute,
+		"15Min": 15 * time.Minute,
+		"20Min": 20 * time.Minute,
+		"30Min": 30 * time.Minute,
+		"1H":    time.Hour,
+		"2H":    2 * time.Hour,
+		"4H":    4 * time.Hour,
+		"6H":    6 * time.Hour,
+		"12H":   12 * time.Hour,
+		"24H":   24 * time.Hour,
+	}
+	promqlSetOperatorRegex       = regexp.MustCompile(`(?i)\b(?:and|or|unless)\b|[<>]|[=!]=`)
+	prometheusDurationRegex      = regexp.MustCompile(`^(?:\d+(?:ms|[smhdwy]))+$`)
+	prometheusDurationPartsRegex = regexp.MustCompile(`(\d+)(ms|[smhdwy])`)
+	prometheusDurationUnits      = map[string]time.Duration{
+		"ms": time.Millisecond,
+		"s":  time.Second,
+		"m":  time.Minute,
+		"h":  time.Hour,
+		"d":  24 * time.Hour,
+		"w":  7 * 24 * time.Hour,
+		"y":  365 * 24 * time.Hour,
+	}
+)
+
+type prometheusRulesFile struct {
+	Groups []struct {
+		Name  string `yaml:"name"`
+		Rules []struct {
+			Alert       string            `yaml:"alert"`
+			Record      string            `yaml:"record"`
+			Expr        string            `yaml:"expr"`
+			For         string            `yaml:"for"`
+			Labels      map[string]string `yaml:"labels"`
+			Annotations map[string]string `yaml:"annotations"`
+		} `yaml:"rules"`
+	} `yaml:"groups"`
+}
+
+// prometheusAlertCondition is a PromQL alerting expression, split into a query and a threshold condition.
+type prometheusAlertCondition struct {
+	query     string
+	condition string
+	threshold float64
+}
+
+func dataSourceCoralogixPrometheusAlertingRules() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCoralogixPrometheusAlertingRulesRead,
+
+		Schema: map[string]*schema.Schema{
+			"yaml_content": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validatePrometheusAlertingRulesYamlContent,
+				Description:  "Prometheus rules file content (groups[].rules[]). Recording rules are ignored.",
+			},
+			"default_severity": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Info",
+				ValidateFunc: validation.StringInSlice(alertValidSeverities, false),
+				Description:  fmt.Sprintf("Severity of rules without a known severity label. Can be one of %q", alertValidSeverities),
+			},
+			"alert": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description annotation of the rule, or its summary annotation when there is no description.",
+						},
+						"severity": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Alert severity, taken from the severity label of the rule.",
+						},
+						"meta_labels": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The labels of the rule, except for severity.",
+						},
+						"search_query": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "PromQL query for metric.promql.search_query.",
+						},
+						"condition": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The condition operator of the alert. Can be one of \"more_than\" or \"less_than\".",
+						},
+						"threshold": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"time_window": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The smallest metric alert time window that covers the rule's 'for' duration.",
+						},
+					},
+				},
+				Description: "Metric PromQL alert definitions, one per alerting rule, in file order.",
+			},
+			"unconverted_rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+				Description: "Alerting rules that could not be converted, with the reason.",
+			},
+		},
+
+		Description: "Converts Prometheus alerting rules into metric PromQL alert definitions, to be used with coralogix_alert (e.g. via for_each).",
+	}
+}
+
+func dataSourceCoralogixPrometheusAlertingRulesRead(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	yamlContent := d.Get("yaml_content").(string)
+	var rulesFile prometheusRulesFile
+	if err := yaml.Unmarshal([]byte(yamlContent), &rulesFile); err != nil {
+		return diag.FromErr(err)
+	}
+
+	defaultSeverity := d.Get("default_severity").(string)
+	alertsDefinitions := make([]interface{}, 0)
+	unconvertedRules := make([]interface{}, 0)
+	for _, group := range rulesFile.Groups {
+		for _, rule := range group.Rules {
+			if rule.Alert == "" {
+				continue
+			}
+			alert, err := convertPrometheusAlertingRule(rule.Expr, rule.For, rule.Labels, rule.Annotations, defaultSeverity)
+			if err != nil {
+				unconvertedRules = append(unconvertedRules, map[string]interface{}{
+					"group":  group.Name,
+					"name":   rule.Alert,
+					"reason": err.Error(),
+				})
+				continue
+			}
+			alert["group"] = group.Name
+			alert["name"] = rule.Alert
+			alertsDefinitions = append(alertsDefinitions, alert)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(yamlContent))))
+	if err := d.Set("alert", alertsDefinitions); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("unconverted_rules", unconvertedRules); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func convertPrometheusAlertingRule(expr, forDuration string, labels, annotations map[string]string, defaultSeverity string) (map[string]interface{}, error) {
+	if strings.TrimSpace(expr) == "" {
+		return nil, fmt.Errorf("expr can not be empty")
+	}
+	timeWindow, err := prometheusForToMetricTimeFrame(forDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	severity := defaultSeverity
+	metaLabels := make(map[string]interface{})
+	for k, v := range labels {
+		if k == "severity" {
+			if s, ok := prometheusSeverityToAlertSeverity[strings.ToLower(v)]; ok {
+				severity = s
+			}
+			continue
+		}
+		metaLabels[k] = v
+	}
+
+	description := annotations["description"]
+	if description == "" {
+		description = annotations["summary"]
+	}
+
+	condition := splitPrometheusAlertExpression(expr)
+
+	return map[string]interface{}{
+		"description":  description,
+		"severity":     severity,
+		"meta_labels":  metaLabels,
+		"search_query": condition.query,
+		"condition":    condition.condition,
+		"threshold":    condition.threshold,
+		"time_window":  timeWindow,
+	}, nil
+}
+
+// splitPrometheusAlertExpression splits an alerting expression such as `rate(errors[5m]) > 0.5` into its query
+// and threshold. Prometheus fires for every series the expression returns, so expressions that can't be split
+// into a more_than/less_than threshold (==, !=, bool modifiers, no comparison at all) are wrapped with count(),
+// to fire when the expression returns any series.
+func splitPrometheusAlertExpression(expr string) prometheusAlertCondition {
+	expr = strings.TrimSpace(expr)
+	if i, operator := lastTopLevelPromqlComparison(expr); i > 0 && !promqlSetOperatorRegex.MatchString(topLevelPromql(expr[:i])) {
+		query := strings.TrimSpace(expr[:i])
+		thresholdStr := strings.TrimSpace(expr[i+len(operator):])
+		if threshold, err := strconv.ParseFloat(thresholdStr, 64); err == nil && query != "" {
+			switch operator {
+			case ">", ">=":
+				return prometheusAlertCondition{query: query, condition: "more_than", threshold: threshold}
+			case "<", "<=":
+				return prometheusAlertCondition{query: query, condition: "less_than", threshold: threshold}
+			}
+		}
+	}
+
+	return prometheusAlertCondition{query: fmt.Sprintf("count(%s)", expr), condition: "more_than", threshold: 0}
+}
+
+// topLevelPromql returns expr with everything nested in parentheses, brackets, braces or string literals removed.
+func topLevelPromql(expr string) string {
+	var sb strings.Builder
+	depth := 0
+	var quote byte
+	for i := 0; i < len(expr); i++ {
+		c := expr[i]
+		if quote != 0 {
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+			continue
+		}
+		switch c {
+		case '"', '\'', '`':
+			quote = c
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			depth--
+		default:
+			if depth == 0 {
+				sb.WriteByte(c)
+			}
+		}
+	}
+	return sb.String()
+}
+
+// lastTopLevelPromqlComparison returns the position and the operator of the last comparison operator
+// which is not nested in parentheses, brackets, braces or string literals.
+func lastTopLevelPromqlComparison(expr string) (int, string) {
+	depth := 0
+	var quote byte
+	position, operator := -1, ""
+	for i := 0; i < len(expr); i++ {
+		c := expr[i]
+		if quote != 0 {
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+			continue
+		}
+		switch c {
+		case '"', '\'', '`':
+			quote = c
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			depth--
+		case '>', '<', '=', '!':
+			if depth != 0 {
+				continue
+			}
+			op := string(c)
+			if i+1 < len(expr) && expr[i+1] == '=' {
+				op += "="
+			}
+			if op == "=" || op == "!" {
+				continue
+			}
+			position, operator = i, op
+			i += len(op) - 1
+		}
+	}
+	return position, operator
+}
+
+// prometheusForToMetricTimeFrame returns the smallest metric alert time frame which is at least as long as the
+// rule's 'for' duration.
+func prometheusForToMetricTimeFrame(forDuration string) (string, error) {
+	duration, err := parsePrometheusDuration(forDuration)
+	if err != nil {
+		return "", err
+	}
+
+	timeFrames := make([]string, 0, len(alertMetricTimeFrameDurations))
+	for timeFrame := range alertMetricTimeFrameDurations {
+		timeFrames = append(timeFrames, timeFrame)
+	}
+	sort.Slice(timeFrames, func(i, j int) bool {
+		return alertMetricTimeFrameDurations[timeFrames[i]] < alertMetricTimeFrameDurations[timeFrames[j]]
+	})
+	for _, timeFrame := range timeFrames {
+		if duration <= alertMetricTimeFrameDurations[timeFrame] {
+			return timeFrame, nil
+		}
+	}
+	return "", fmt.Errorf("for duration %q is longer than the longest metric alert time window (24H)", forDuration)
+}
+
+func parsePrometheusDuration(s string) (time.Duration, error) {
+	if s == "" || s == "0" {
+		return 0, nil
+	}
+	if !prometheusDurationRegex.MatchString(s) {
+		return 0, fmt.Errorf("%q is not a valid Prometheus duration", s)
+	}
+	var duration time.Duration
+	for _, part := range prometheusDurationPartsRegex.FindAllStringSubmatch(s, -1) {
+		n, err := strconv.Atoi(part[1])
+		if err != nil {
+			return 0, fmt.Errorf("%q is not a valid Prometheus duration - %s", s, err)
+		}
+		duration += time.Duration(n) * prometheusDurationUnits[part[2]]
+	}
+	return duration, nil
+}
+
+func validatePrometheusAlertingRulesYamlContent(config interface{}, _ string) ([]string, []error) {
+	var rulesFile prometheusRulesFile
+	if err := yaml.Unmarshal([]byte(config.(string)), &rulesFile); err != nil {
+		return nil, []error{err}
+	}
+
+	if len(rulesFile.Groups) == 0 {
+		return nil, []error{fmt.Errorf("groups list can not be empty")}
+	}
+
+	errors := make([]error, 0)
+	for i, group := range rulesFile.Groups {
+		if group.Name == "" {
+			errors = append(errors, fmt.Errorf("groups[%d] name can not be empty", i))
+		}
+		for j, rule := range group.Rules {
+			if rule.Alert != "" && rule.Record != "" {
+				errors = append(errors, fmt.Errorf("groups[%d].rules[%d] can not be both an alerting and a recording rule", i, j))
+			}
+		}
+	}
+	if len(errors) != 0 {
+		return nil, errors
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,52 @@
+package coralogix
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var prometheusAlertingRulesDataSourceName = "data.coralogix_prometheus_alerting_rules.test"
+
+func TestAccCoralogixDataSourcePrometheusAlertingRules_basic(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		panic(err)
+	}
+	parent := filepath.Dir(wd)
+	filePath := parent + "/examples/prometheus_alerting_rules/alerting-rules.yaml"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoralogixDataSourcePrometheusAlertingRules(filePath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(prometheusAlertingRulesDataSourceName, "alert.#", "3"),
+					resource.TestCheckResourceAttr(prometheusAlertingRulesDataSourceName, "alert.0.name", "HighErrorRate"),
+					resource.TestCheckResourceAttr(prometheusAlertingRulesDataSourceName, "alert.0.severity", "Critical"),
+					resource.TestCheckResourceAttr(prometheusAlertingRulesDataSourceName, "alert.0.search_query", `sum(rate(http_requests_total{status=~"5.."}[5m])) by (job)`),
+					resource.TestCheckResourceAttr(prometheusAlertingRulesDataSourceName, "alert.0.condition", "more_than"),
+					resource.TestCheckResourceAttr(prometheusAlertingRulesDataSourceName, "alert.0.threshold", "0.5"),
+					resource.TestCheckResourceAttr(prometheusAlertingRulesDataSourceName, "alert.0.time_window", "10Min"),
+					resource.TestCheckResourceAttr(prometheusAlertingRulesDataSourceName, "alert.0.meta_labels.team", "api"),
+					resource.TestCheckResourceAttr(prometheusAlertingRulesDataSourceName, "alert.1.condition", "less_than"),
+					resource.TestCheckResourceAttr(prometheusAlertingRulesDataSourceName, "alert.1.description", "Throughput dropped"),
+					resource.TestCheckResourceAttr(prometheusAlertingRulesDataSourceName, "alert.2.search_query", "count(up == 0)"),
+					resource.TestCheckResourceAttr(prometheusAlertingRulesDataSourceName, "unconverted_rules.#", "1"),
+					resource.TestCheckResourceAttr(prometheusAlertingRulesDataSourceName, "unconverted_rules.0.name", "DiskFillingUp"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCoralogixDataSourcePrometheusAlertingRules(yamlFilePath string) string {
+	return fmt.Sprintf(`data "coralogix_prometheus_alerting_rules" "test" {
+  yaml_content = file("%s")
+}
+`, yamlFilePath)
+}
@@ -2,45 +2,94 @@ package coralogix
 
 import (
 	"context"
+	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"terraform-provider-coralogix/coralogix/clientset"
-	v1 "terraform-provider-coralogix/coralogix/clientset/grpc/rules-groups/v1"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	rulesv1 "terraform-provider-coralogix/coralogix/clientset/grpc/rules-groups/v1"
 )
 
-func dataSourceCoralogixRulesGroup() *schema.Resource {
-	rulesGroupSchema := datasourceSchemaFromResourceSchema(RulesGroupSchema())
-	rulesGroupSchema["id"] = &schema.Schema{
-		Type:     schema.TypeString,
-		Required: true,
+var _ datasource.DataSourceWithConfigure = &RulesGroupDataSource{}
+
+func NewRulesGroupDataSource() datasource.DataSource {
+	return &RulesGroupDataSource{}
+}
+
+type RulesGroupDataSource struct {
+	client *clientset.RuleGroupsClient
+}
+
+func (d *RulesGroupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rules_group"
+}
+
+func (d *RulesGroupDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clientSet, ok := req.ProviderData.(*clientset.ClientSet)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *clientset.ClientSet, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
 	}
 
-	return &schema.Resource{
-		ReadContext: dataSourceCoralogixRulesGroupRead,
+	d.client = clientSet.RuleGroups()
+}
+
+func (d *RulesGroupDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	var r RulesGroupResource
+	var resourceResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resourceResp)
 
-		Schema: rulesGroupSchema,
+	resp.Schema = frameworkDatasourceSchemaFromFrameworkResourceSchema(resourceResp.Schema)
+	resp.Schema.Attributes["id"] = datasourceschema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "Rule-group ID.",
 	}
 }
 
-func dataSourceCoralogixRulesGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	id := d.Get("id").(string)
-	getRuleGroupRequest := &v1.GetRuleGroupRequest{
-		GroupId: id,
+func (d *RulesGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RulesGroupResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
+	id := data.ID.ValueString()
 	log.Printf("[INFO] Reading rule-group %s", id)
-	ruleGroupResp, err := meta.(*clientset.ClientSet).RuleGroups().GetRuleGroup(ctx, getRuleGroupRequest)
+	getRuleGroupResp, err := d.client.GetRuleGroup(ctx, &rulesv1.GetRuleGroupRequest{GroupId: id})
 	if err != nil {
 		log.Printf("[ERROR] Received error: %#v", err)
-		return handleRpcErrorWithID(err, "rule-group", id)
+		if status.Code(err) == codes.NotFound {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Rule-Group %q is not found", id),
+				fmt.Sprintf("%s does not exist in Coralogix backend", id),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error reading Rules Group",
+				handleRpcErrorNewFramework(err, "rule-group"),
+			)
+		}
+		return
 	}
-	ruleGroup := ruleGroupResp.GetRuleGroup()
+	ruleGroup := getRuleGroupResp.GetRuleGroup()
 	log.Printf("[INFO] Received rule-group: %#v", ruleGroup)
 
-	d.SetId(ruleGroup.GetId().GetValue())
+	data, diags := flattenRuleGroup(ctx, ruleGroup, data.Creator)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	return setRuleGroup(d, ruleGroup)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,695 @@
+package coralogix
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &RulesGroupSimulationDataSource{}
+
+	rulesTypes = []string{"parse", "block", "json_extract", "replace", "extract_timestamp", "remove_fields",
+		"json_stringify", "extract", "parse_json_field"}
+	strftimeToGoLayout = map[byte]string{
+		'Y': "2006", 'y': "06", 'm': "01", 'd': "02", 'e': "_2", 'H': "15", 'I': "03", 'M': "04", 'S': "05",
+		'f': "999999999", 'L': "000", 'p': "PM", 'b': "Jan", 'h': "Jan", 'B': "January", 'a': "Mon", 'A': "Monday",
+		'j': "002", 'z': "-0700", 'Z': "MST", 'T': "15:04:05", 'D': "01/02/06", 'F': "2006-01-02", '%': "%",
+	}
+	javaSDFToGoLayout = map[string]string{
+		"yyyy": "2006", "yy": "06", "MMMM": "January", "MMM": "Jan", "MM": "01", "M": "1", "dd": "02", "d": "2",
+		"DDD": "002", "HH": "15", "H": "15", "hh": "03", "h": "3", "mm": "04", "m": "4", "ss": "05", "s": "5",
+		"SSSSSSSSS": "000000000", "SSSSSS": "000000", "SSS": "000", "a": "PM", "EEEE": "Monday", "EEE": "Mon",
+		"Z": "-0700", "XXX": "Z07:00", "XX": "Z0700", "X": "Z07", "z": "MST",
+	}
+)
+
+func NewRulesGroupSimulationDataSource() datasource.DataSource {
+	return &RulesGroupSimulationDataSource{}
+}
+
+type RulesGroupSimulationDataSource struct{}
+
+type RulesGroupSimulationDataSourceModel struct {
+	ID         types.String                      `tfsdk:"id"`
+	RulesGroup types.Object                      `tfsdk:"rules_group"`
+	Logs       []SimulatedLogModel               `tfsdk:"logs"`
+	Result     []RulesGroupSimulationResultModel `tfsdk:"result"`
+}
+
+type SimulatedLogModel struct {
+	Text            types.String `tfsdk:"text"`
+	ApplicationName types.String `tfsdk:"application_name"`
+	SubsystemName   types.String `tfsdk:"subsystem_name"`
+	Severity        types.String `tfsdk:"severity"`
+}
+
+type RulesGroupSimulationResultModel struct {
+	Text       types.String         `tfsdk:"text"`
+	Severity   types.String         `tfsdk:"severity"`
+	Metadata   types.Map            `tfsdk:"metadata"`
+	Timestamp  types.String         `tfsdk:"timestamp"`
+	Matched    types.Bool           `tfsdk:"matched"`
+	Blocked    types.Bool           `tfsdk:"blocked"`
+	FiredRules []SimulatedFiredRule `tfsdk:"fired_rules"`
+}
+
+type SimulatedFiredRule struct {
+	SubgroupIndex types.Int64  `tfsdk:"subgroup_index"`
+	Name          types.String `tfsdk:"name"`
+	Type          types.String `tfsdk:"type"`
+}
+
+func (d *RulesGroupSimulationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rules_group_simulation"
+}
+
+// rulesGroupResourceAttributes returns the attributes of coralogix_rules_group, which the simulated rules-group is
+// configured with.
+func rulesGroupResourceAttributes(ctx context.Context) map[string]resourceschema.Attribute {
+	var r RulesGroupResource
+	var resourceResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resourceResp)
+	return resourceResp.Schema.Attributes
+}
+
+func (d *RulesGroupSimulationDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "A hash of the simulated rules-group and logs.",
+			},
+			"rules_group": schema.SingleNestedAttribute{
+				Required:            true,
+				Attributes:          convertInputAttributes(rulesGroupResourceAttributes(ctx), "order"),
+				MarkdownDescription: "The rules-group to simulate, with the attributes of the coralogix_rules_group resource (e.g. `rule_subgroups = [{rules = [{parse = {...}}]}]`).",
+			},
+			"logs": schema.ListNestedAttribute{
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"text": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The log's text. JSON objects are treated as JSON logs.",
+						},
+						"application_name": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The log's application name.",
+						},
+						"subsystem_name": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The log's subsystem name.",
+						},
+						"severity": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(rulesValidSeverities...),
+							},
+							MarkdownDescription: fmt.Sprintf("The log's severity. Can be one of %q. Defaults to Info.", rulesValidSeverities),
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				MarkdownDescription: "Sample logs to run the rules-group on.",
+			},
+			"result": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"text": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The log's text after the rules ran.",
+						},
+						"severity": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The log's severity after the rules ran.",
+						},
+						"metadata": schema.MapAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: fmt.Sprintf("Metadata fields populated by json_extract rules, by destination field (%q).", rulesValidDestinationFields),
+						},
+						"timestamp": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The timestamp extracted by an extract_timestamp rule, in RFC 3339 format. Empty when no timestamp was extracted.",
+						},
+						"matched": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the log matched the rules-group's applications, subsystems and severities.",
+						},
+						"blocked": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether a block rule blocked the log.",
+						},
+						"fired_rules": schema.ListNestedAttribute{
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"subgroup_index": schema.Int64Attribute{
+										Computed:            true,
+										MarkdownDescription: "The index of the rule's subgroup in `rule_subgroups`.",
+									},
+									"name": schema.StringAttribute{
+										Computed: true,
+									},
+									"type": schema.StringAttribute{
+										Computed: true,
+									},
+								},
+							},
+							MarkdownDescription: "The rules which fired on the log, in the order they fired.",
+						},
+					},
+				},
+				MarkdownDescription: "The simulation result of every log, in the order of the logs.",
+			},
+		},
+		MarkdownDescription: "Runs a rules-group locally on sample logs, without sending anything to Coralogix. " +
+			"It's an approximation of Coralogix's parsing - regular expressions are evaluated with Go's RE2 syntax.",
+	}
+}
+
+func (d *RulesGroupSimulationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RulesGroupSimulationDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rulesGroup := frameworkObjectToMap(ctx, rulesGroupResourceAttributes(ctx), data.RulesGroup)
+	if err := validateRulesGroupRegexRules(rulesGroup["rule_subgroups"].([]interface{})); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("rules_group"), "Invalid rules-group", err.Error())
+		return
+	}
+	group, err := expandSimulatedRulesGroup(rulesGroup)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("rules_group"), "Invalid rules-group", err.Error())
+		return
+	}
+
+	logs := make([]interface{}, 0, len(data.Logs))
+	data.Result = make([]RulesGroupSimulationResultModel, 0, len(data.Logs))
+	for _, l := range data.Logs {
+		severity := l.Severity.ValueString()
+		if severity == "" {
+			severity = "Info"
+		}
+		simulatedLog := newSimulatedLog(l.Text.ValueString(), l.ApplicationName.ValueString(), l.SubsystemName.ValueString(), severity)
+		data.Result = append(data.Result, group.run(simulatedLog))
+		logs = append(logs, []string{l.Text.ValueString(), l.ApplicationName.ValueString(), l.SubsystemName.ValueString(), severity})
+	}
+
+	input, _ := json.Marshal([]interface{}{rulesGroup, logs})
+	data.ID = types.StringValue(fmt.Sprintf("%x", sha256.Sum256(input)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+type simulatedRulesGroup struct {
+	active                               bool
+	applications, subsystems, severities []string
+	subgroups                            []simulatedRuleSubgroup
+}
+
+type simulatedRuleSubgroup struct {
+	index int
+	rules []simulatedRule
+}
+
+type simulatedRule struct {
+	name, ruleType string
+	params         map[string]interface{}
+	regex          *regexp.Regexp
+}
+
+// expandSimulatedRulesGroup expands the rules-group, as read by frameworkObjectToMap.
+func expandSimulatedRulesGroup(m map[string]interface{}) (*simulatedRulesGroup, error) {
+	applications, _ := m["applications"].([]interface{})
+	subsystems, _ := m["subsystems"].([]interface{})
+	severities, _ := m["severities"].([]interface{})
+	group := &simulatedRulesGroup{
+		active:       m["active"].(bool),
+		applications: interfaceSliceToStringSlice(applications),
+		subsystems:   interfaceSliceToStringSlice(subsystems),
+		severities:   interfaceSliceToStringSlice(severities),
+	}
+
+	subgroups, _ := m["rule_subgroups"].([]interface{})
+	for i, sg := range subgroups {
+		subgroupMap := sg.(map[string]interface{})
+		subgroup := simulatedRuleSubgroup{index: i}
+		for j, r := range subgroupMap["rules"].([]interface{}) {
+			rule, err := expandSimulatedRule(r.(map[string]interface{}))
+			if err != nil {
+				return nil, fmt.Errorf("rule_subgroups.%d.rules.%d: %w", i, j, err)
+			}
+			if rule != nil {
+				subgroup.rules = append(subgroup.rules, *rule)
+			}
+		}
+		// Inactive subgroups are still expanded, so their rules are validated like the active ones.
+		if subgroupMap["active"].(bool) {
+			group.subgroups = append(group.subgroups, subgroup)
+		}
+	}
+
+	return group, nil
+}
+
+// expandSimulatedRule returns the rule, or nil when the rule isn't active. Inactive rules are validated too.
+func expandSimulatedRule(m map[string]interface{}) (*simulatedRule, error) {
+	var ruleType string
+	var setRuleTypes []string
+	for _, t := range rulesTypes {
+		if _, ok := m[t].(map[string]interface{}); ok {
+			ruleType = t
+			setRuleTypes = append(setRuleTypes, t)
+		}
+	}
+	if len(setRuleTypes) != 1 {
+		return nil, fmt.Errorf("exactly one of %q must be provided inside rule, got %q", rulesTypes, setRuleTypes)
+	}
+
+	params := m[ruleType].(map[string]interface{})
+	rule := &simulatedRule{
+		name:     params["name"].(string),
+		ruleType: ruleType,
+		params:   params,
+	}
+	if expression, ok := params["regular_expression"].(string); ok {
+		regex, err := regexp.Compile(expression)
+		if err != nil {
+			return nil, fmt.Errorf("rule %q regular_expression can't be simulated - %w", rule.name, err)
+		}
+		rule.regex = regex
+	}
+	if !params["active"].(bool) {
+		return nil, nil
+	}
+	if ruleType == "block" && !params["blocking_all_matching_blocks"].(bool) {
+		rule.ruleType = "allow"
+	}
+	return rule, nil
+}
+
+func (g *simulatedRulesGroup) matches(l *simulatedLog) bool {
+	return simulatedMatcherMatches(g.applications, l.application) &&
+		simulatedMatcherMatches(g.subsystems, l.subsystem) &&
+		simulatedMatcherMatches(g.severities, l.severity)
+}
+
+func simulatedMatcherMatches(values []string, value string) bool {
+	if len(values) == 0 {
+		return true
+	}
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// run runs the rules-group on l. Subgroups run in order, and only the first rule of every subgroup which applies to
+// the log fires. A blocked log isn't processed any further.
+func (g *simulatedRulesGroup) run(l *simulatedLog) RulesGroupSimulationResultModel {
+	matched := g.active && g.matches(l)
+	firedRules := make([]SimulatedFiredRule, 0)
+	if matched {
+	subgroups:
+		for _, subgroup := range g.subgroups {
+			for _, rule := range subgroup.rules {
+				if !rule.apply(l) {
+					continue
+				}
+				firedRules = append(firedRules, SimulatedFiredRule{
+					SubgroupIndex: types.Int64Value(int64(subgroup.index)),
+					Name:          types.StringValue(rule.name),
+					Type:          types.StringValue(rule.ruleType),
+				})
+				if l.blocked {
+					break subgroups
+				}
+				break
+			}
+		}
+	}
+
+	metadata := make(map[string]attr.Value, len(l.metadata))
+	for field, value := range l.metadata {
+		metadata[field] = types.StringValue(value)
+	}
+	return RulesGroupSimulationResultModel{
+		Text:       types.StringValue(l.text),
+		Severity:   types.StringValue(l.severity),
+		Metadata:   types.MapValueMust(types.StringType, metadata),
+		Timestamp:  types.StringValue(l.timestamp),
+		Matched:    types.BoolValue(matched),
+		Blocked:    types.BoolValue(l.blocked),
+		FiredRules: firedRules,
+	}
+}
+
+// apply runs the rule on l, and returns whether the rule fired.
+func (r *simulatedRule) apply(l *simulatedLog) bool {
+	sourceField, _ := r.params["source_field"].(string)
+	destinationField, _ := r.params["destination_field"].(string)
+	switch r.ruleType {
+	case "parse", "extract":
+		source, ok := l.getString(sourceField)
+		if !ok {
+			return false
+		}
+		match := r.regex.FindStringSubmatch(source)
+		if match == nil {
+			return false
+		}
+		captures := make(map[string]interface{})
+		for i, name := range r.regex.SubexpNames() {
+			if name != "" && i < len(match) {
+				captures[name] = match[i]
+			}
+		}
+		if r.ruleType == "parse" {
+			l.set(destinationField, captures)
+		} else {
+			for name, value := range captures {
+				l.set("text."+name, value)
+			}
+		}
+		return true
+	case "json_extract":
+		value, ok := l.getString("text." + r.params["json_key"].(string))
+		if !ok {
+			return false
+		}
+		if destinationField == "Severity" {
+			if severity, ok := simulatedSeverity(value); ok {
+				l.severity = severity
+			}
+		} else {
+			l.metadata[destinationField] = value
+		}
+		return true
+	case "replace":
+		source, ok := l.getString(sourceField)
+		if !ok || !r.regex.MatchString(source) {
+			return false
+		}
+		l.set(destinationField, r.regex.ReplaceAllString(source, r.params["replacement_string"].(string)))
+		return true
+	case "block", "allow":
+		source, _ := l.getString(sourceField)
+		if r.regex.MatchString(source) != (r.ruleType == "block") {
+			return false
+		}
+		l.blocked = true
+		return true
+	case "extract_timestamp":
+		source, ok := l.getString(sourceField)
+		if !ok {
+			return false
+		}
+		timestamp, err := parseSimulatedTimestamp(r.params["field_format_standard"].(string), r.params["time_format"].(string), source)
+		if err != nil {
+			return false
+		}
+		l.timestamp = timestamp.UTC().Format(time.RFC3339Nano)
+		return true
+	case "remove_fields":
+		removed := false
+		for _, field := range r.params["excluded_fields"].([]interface{}) {
+			removed = l.delete("text."+field.(string)) || removed
+		}
+		return removed
+	case "json_stringify":
+		value, ok := l.get(sourceField)
+		if !ok {
+			return false
+		}
+		stringified, _ := json.Marshal(value)
+		if !r.params["keep_source_field"].(bool) {
+			l.delete(sourceField)
+		}
+		l.set(destinationField, string(stringified))
+		return true
+	case "parse_json_field":
+		source, ok := l.getString(sourceField)
+		if !ok {
+			return false
+		}
+		parsed, ok := parseSimulatedJson(source)
+		if !ok {
+			return false
+		}
+		if !r.params["keep_source_field"].(bool) {
+			l.delete(sourceField)
+		}
+		if existing, ok := l.get(destinationField); ok && r.params["keep_destination_field"].(bool) {
+			if existingObject, ok := existing.(map[string]interface{}); ok {
+				for k, v := range parsed {
+					existingObject[k] = v
+				}
+				parsed = existingObject
+			}
+		}
+		l.set(destinationField, parsed)
+		return true
+	}
+	return false
+}
+
+// simulatedLog is a log being processed by the simulation. Fields are addressed like in rules -
+// "text" is the whole log, and "text.a.b" is the field b of the field a of a JSON log.
+type simulatedLog struct {
+	text                             string
+	object                           map[string]interface{}
+	application, subsystem, severity string
+	timestamp                        string
+	metadata                         map[string]string
+	blocked                          bool
+}
+
+func newSimulatedLog(text, application, subsystem, severity string) *simulatedLog {
+	l := &simulatedLog{
+		application: application,
+		subsystem:   subsystem,
+		severity:    severity,
+		metadata:    make(map[string]string),
+	}
+	l.setText(text)
+	return l
+}
+
+func (l *simulatedLog) setText(text string) {
+	l.text = text
+	l.object, _ = parseSimulatedJson(text)
+}
+
+func (l *simulatedLog) get(field string) (interface{}, bool) {
+	if field == "text" {
+		if l.object != nil {
+			return l.object, true
+		}
+		return l.text, true
+	}
+	if l.object == nil || !strings.HasPrefix(field, "text.") {
+		return nil, false
+	}
+
+	var value interface{} = l.object
+	for _, key := range strings.Split(strings.TrimPrefix(field, "text."), ".") {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if value, ok = object[key]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// getString returns the field as a string - strings as is, and any other value as JSON.
+func (l *simulatedLog) getString(field string) (string, bool) {
+	if field == "text" {
+		return l.text, true
+	}
+	value, ok := l.get(field)
+	if !ok {
+		return "", false
+	}
+	if s, ok := value.(string); ok {
+		return s, true
+	}
+	b, _ := json.Marshal(value)
+	return string(b), true
+}
+
+// set sets the field to value. Setting a field of a log which isn't a JSON object turns it into
+// a JSON object, with its original text in the "text" field.
+func (l *simulatedLog) set(field string, value interface{}) {
+	if field == "text" {
+		if s, ok := value.(string); ok {
+			l.setText(s)
+		} else if object, ok := value.(map[string]interface{}); ok {
+			l.object = object
+			l.updateText()
+		}
+		return
+	}
+	if !strings.HasPrefix(field, "text.") {
+		return
+	}
+	if l.object == nil {
+		l.object = map[string]interface{}{"text": l.text}
+	}
+
+	keys := strings.Split(strings.TrimPrefix(field, "text."), ".")
+	object := l.object
+	for _, key := range keys[:len(keys)-1] {
+		next, ok := object[key].(map[string]interface{})
+		if !ok {
+			next = make(map[string]interface{})
+			object[key] = next
+		}
+		object = next
+	}
+	object[keys[len(keys)-1]] = value
+	l.updateText()
+}
+
+// delete deletes the field, and returns whether it existed.
+func (l *simulatedLog) delete(field string) bool {
+	if l.object == nil || !strings.HasPrefix(field, "text.") {
+		return false
+	}
+
+	keys := strings.Split(strings.TrimPrefix(field, "text."), ".")
+	object := l.object
+	for _, key := range keys[:len(keys)-1] {
+		next, ok := object[key].(map[string]interface{})
+		if !ok {
+			return false
+		}
+		object = next
+	}
+	if _, ok := object[keys[len(keys)-1]]; !ok {
+		return false
+	}
+	delete(object, keys[len(keys)-1])
+	l.updateText()
+	return true
+}
+
+func (l *simulatedLog) updateText() {
+	b, _ := json.Marshal(l.object)
+	l.text = string(b)
+}
+
+func parseSimulatedJson(s string) (map[string]interface{}, bool) {
+	var object map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader([]byte(s)))
+	decoder.UseNumber()
+	if err := decoder.Decode(&object); err != nil || object == nil || decoder.More() {
+		return nil, false
+	}
+	return object, true
+}
+
+func simulatedSeverity(value string) (string, bool) {
+	for _, severity := range rulesValidSeverities {
+		if strings.EqualFold(severity, value) {
+			return severity, true
+		}
+	}
+	return "", false
+}
+
+func parseSimulatedTimestamp(formatStandard, timeFormat, value string) (time.Time, error) {
+	switch formatStandard {
+	case "Strftime":
+		return time.Parse(strftimeLayoutToGoLayout(timeFormat), value)
+	case "JavaSDF":
+		return time.Parse(javaSDFLayoutToGoLayout(timeFormat), value)
+	case "Golang":
+		return time.Parse(timeFormat, value)
+	}
+
+	unit := map[string]time.Duration{"SecondTS": time.Second, "MilliTS": time.Millisecond, "MicroTS": time.Microsecond, "NanoTS": time.Nanosecond}[formatStandard]
+	if number, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(0, 0).Add(time.Duration(number) * unit), nil
+	}
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(0, 0).Add(time.Duration(math.Round(number * float64(unit)))), nil
+}
+
+func strftimeLayoutToGoLayout(format string) string {
+	var layout strings.Builder
+	for i := 0; i < len(format); i++ {
+		if format[i] == '%' && i+1 < len(format) {
+			if goLayout, ok := strftimeToGoLayout[format[i+1]]; ok {
+				layout.WriteString(goLayout)
+				i++
+				continue
+			}
+		}
+		layout.WriteByte(format[i])
+	}
+	return layout.String()
+}
+
+func javaSDFLayoutToGoLayout(format string) string {
+	var layout strings.Builder
+	for i := 0; i < len(format); {
+		c := format[i]
+		switch {
+		case c == '\'':
+			end := strings.IndexByte(format[i+1:], '\'')
+			if end == -1 {
+				layout.WriteString(format[i+1:])
+				return layout.String()
+			}
+			layout.WriteString(format[i+1 : i+1+end])
+			i += end + 2
+		case (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
+			j := i
+			for j < len(format) && format[j] == c {
+				j++
+			}
+			if goLayout, ok := javaSDFToGoLayout[format[i:j]]; ok {
+				layout.WriteString(goLayout)
+			} else {
+				layout.WriteString(format[i:j])
+			}
+			i = j
+		default:
+			layout.WriteByte(c)
+			i++
+		}
+	}
+	return layout.String()
+}
@@ -0,0 +1,271 @@
+package coralogix
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var rulesGroupSimulationDataSourceName = "data.coralogix_rules_group_simulation.test"
+
+func TestAccCoralogixDataSourceRulesGroupSimulation_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoralogixDataSourceRulesGroupSimulation(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rulesGroupSimulationDataSourceName, "result.#", "3"),
+					resource.TestCheckResourceAttr(rulesGroupSimulationDataSourceName, "result.0.blocked", "true"),
+					resource.TestCheckResourceAttr(rulesGroupSimulationDataSourceName, "result.0.fired_rules.0.name", "block healthchecks"),
+					resource.TestCheckResourceAttr(rulesGroupSimulationDataSourceName, "result.1.text", `{"ip":"10.0.0.1","method":"GET","path":"/index","status":"500"}`),
+					resource.TestCheckResourceAttr(rulesGroupSimulationDataSourceName, "result.1.fired_rules.#", "1"),
+					resource.TestCheckResourceAttr(rulesGroupSimulationDataSourceName, "result.1.fired_rules.0.type", "parse"),
+					resource.TestCheckResourceAttr(rulesGroupSimulationDataSourceName, "result.2.severity", "Error"),
+					resource.TestCheckResourceAttr(rulesGroupSimulationDataSourceName, "result.2.timestamp", "2023-05-01T08:00:00.123Z"),
+					resource.TestCheckResourceAttr(rulesGroupSimulationDataSourceName, "result.2.text", `{"card":"************5678","level":"error","time":"2023-05-01T10:00:00.123+0200"}`),
+					resource.TestCheckResourceAttr(rulesGroupSimulationDataSourceName, "result.2.fired_rules.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestRulesGroupSimulationDataSourceRead(t *testing.T) {
+	data, diags := readRulesGroupSimulation(t, `{
+  "rules_group": {
+    "name": "simulated rules group",
+    "applications": ["nginx"],
+    "rule_subgroups": [
+      {"rules": [
+        {"block": {"name": "block healthchecks", "source_field": "text", "regular_expression": "healthcheck"}},
+        {"parse": {"name": "parse access logs", "source_field": "text", "destination_field": "text",
+          "regular_expression": "^(?P<ip>\\S+) (?P<method>[A-Z]+) (?P<path>\\S+) (?P<status>\\d+)$"}}
+      ]},
+      {"rules": [
+        {"json_extract": {"name": "severity from level", "json_key": "level", "destination_field": "Severity"}}
+      ]},
+      {"active": false, "rules": [
+        {"replace": {"name": "inactive subgroup", "source_field": "text", "destination_field": "text",
+          "regular_expression": ".*", "replacement_string": "replaced"}}
+      ]},
+      {"rules": [
+        {"extract_timestamp": {"name": "inactive rule", "active": false, "source_field": "text.time",
+          "field_format_standard": "Strftime", "time_format": "%Y"}},
+        {"replace": {"name": "mask cards", "source_field": "text.card", "destination_field": "text.card",
+          "regular_expression": "\\d{12}(\\d{4})", "replacement_string": "************$1"}}
+      ]}
+    ]
+  },
+  "logs": [
+    {"text": "GET /healthcheck", "application_name": "nginx"},
+    {"text": "10.0.0.1 GET /index 500", "application_name": "nginx"},
+    {"text": "{\"card\":\"1234567812345678\",\"level\":\"error\"}", "application_name": "nginx"},
+    {"text": "GET /healthcheck", "application_name": "apache"}
+  ]
+}`)
+	if diags.HasError() {
+		t.Fatalf("read: %v", diags)
+	}
+
+	if len(data.Result) != 4 {
+		t.Fatalf("expected 4 results, got %d", len(data.Result))
+	}
+	if !data.Result[0].Blocked.ValueBool() || data.Result[0].FiredRules[0].Name.ValueString() != "block healthchecks" {
+		t.Errorf("expected the healthcheck to be blocked, got %+v", data.Result[0])
+	}
+	if got := data.Result[1].Text.ValueString(); got != `{"ip":"10.0.0.1","method":"GET","path":"/index","status":"500"}` {
+		t.Errorf("unexpected parsed log %s", got)
+	}
+	if got := data.Result[2].FiredRules; len(got) != 2 || got[0].SubgroupIndex.ValueInt64() != 1 || got[1].SubgroupIndex.ValueInt64() != 3 {
+		t.Errorf("expected the json_extract and replace rules of subgroups 1 and 3 to fire, got %+v", got)
+	}
+	if got := data.Result[2].Severity.ValueString(); got != "Error" {
+		t.Errorf("expected severity Error, got %s", got)
+	}
+	if got := data.Result[2].Text.ValueString(); got != `{"card":"************5678","level":"error"}` {
+		t.Errorf("unexpected masked log %s", got)
+	}
+	if data.Result[3].Matched.ValueBool() || len(data.Result[3].FiredRules) != 0 {
+		t.Errorf("expected a log of another application not to match, got %+v", data.Result[3])
+	}
+	if data.ID.ValueString() == "" {
+		t.Error("expected an id")
+	}
+}
+
+func TestRulesGroupSimulationDataSourceReadInvalidRules(t *testing.T) {
+	tests := []struct {
+		name          string
+		ruleSubgroups string
+		expectedError string
+	}{
+		{
+			name: "two rule types",
+			ruleSubgroups: `[{"rules": [{
+				"block": {"name": "block", "source_field": "text", "regular_expression": "x"},
+				"extract": {"name": "extract", "source_field": "text", "regular_expression": "(?P<x>x)"}
+			}]}]`,
+			expectedError: `rule_subgroups.0.rules.0: exactly one of`,
+		},
+		{
+			name:          "no rule type",
+			ruleSubgroups: `[{"rules": [{}]}]`,
+			expectedError: `rule_subgroups.0.rules.0: exactly one of`,
+		},
+		{
+			name: "inactive rule",
+			ruleSubgroups: `[{"rules": [
+				{"block": {"name": "lookahead", "active": false, "source_field": "text", "regular_expression": "x(?=y)"}}
+			]}]`,
+			expectedError: `rule "lookahead" regular_expression can't be simulated`,
+		},
+		{
+			name: "inactive subgroup",
+			ruleSubgroups: `[{"active": false, "rules": [
+				{"block": {"name": "lookahead", "source_field": "text", "regular_expression": "x(?=y)"}}
+			]}]`,
+			expectedError: `rule "lookahead" regular_expression can't be simulated`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, diags := readRulesGroupSimulation(t, `{
+  "rules_group": {"name": "invalid", "rule_subgroups": `+tt.ruleSubgroups+`},
+  "logs": [{"text": "x"}]
+}`)
+			if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), tt.expectedError) {
+				t.Errorf("expected an error containing %q, got %v", tt.expectedError, diags)
+			}
+		})
+	}
+}
+
+// readRulesGroupSimulation reads coralogix_rules_group_simulation with the given JSON config.
+func readRulesGroupSimulation(t *testing.T, config string) (RulesGroupSimulationDataSourceModel, diag.Diagnostics) {
+	t.Helper()
+
+	ctx := context.Background()
+	d := NewRulesGroupSimulationDataSource()
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("schema: %v", schemaResp.Diagnostics)
+	}
+	raw, err := tftypes.ValueFromJSON([]byte(config), schemaResp.Schema.Type().TerraformType(ctx))
+	if err != nil {
+		t.Fatalf("config: %s", err)
+	}
+
+	req := datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: raw}}
+	resp := datasource.ReadResponse{State: tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}}
+	d.Read(ctx, req, &resp)
+
+	var data RulesGroupSimulationDataSourceModel
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	}
+	return data, resp.Diagnostics
+}
+
+func testAccCoralogixDataSourceRulesGroupSimulation() string {
+	return `data "coralogix_rules_group_simulation" "test" {
+  rules_group = {
+    name         = "simulated rules group"
+    applications = ["nginx"]
+
+    rule_subgroups = [
+      {
+        rules = [
+          {
+            block = {
+              name               = "block healthchecks"
+              source_field       = "text"
+              regular_expression = "healthcheck"
+            }
+          },
+          {
+            parse = {
+              name               = "parse access logs"
+              source_field       = "text"
+              destination_field  = "text"
+              regular_expression = "^(?P<ip>\\S+) (?P<method>[A-Z]+) (?P<path>\\S+) (?P<status>\\d+)$"
+            }
+          }
+        ]
+      },
+      {
+        rules = [
+          {
+            json_extract = {
+              name              = "severity from level"
+              json_key          = "level"
+              destination_field = "Severity"
+            }
+          }
+        ]
+      },
+      {
+        rules = [
+          {
+            extract_timestamp = {
+              name                  = "timestamp from time"
+              source_field          = "text.time"
+              field_format_standard = "Strftime"
+              time_format           = "%Y-%m-%dT%H:%M:%S.%f%z"
+            }
+          },
+          {
+            replace = {
+              name               = "mask cards"
+              source_field       = "text.card"
+              destination_field  = "text.card"
+              regular_expression = "\\d{12}(\\d{4})"
+              replacement_string = "************$1"
+            }
+          }
+        ]
+      },
+      {
+        rules = [
+          {
+            replace = {
+              name               = "mask cards"
+              source_field       = "text.card"
+              destination_field  = "text.card"
+              regular_expression = "\\d{12}(\\d{4})"
+              replacement_string = "************$1"
+            }
+          }
+        ]
+      }
+    ]
+  }
+
+  logs = [
+    {
+      text             = "GET /healthcheck"
+      application_name = "nginx"
+    },
+    {
+      text             = "10.0.0.1 GET /index 500"
+      application_name = "nginx"
+    },
+    {
+      text             = jsonencode({ level = "error", time = "2023-05-01T10:00:00.123+0200", card = "1234567812345678" })
+      application_name = "nginx"
+    }
+  ]
+}
+`
+}
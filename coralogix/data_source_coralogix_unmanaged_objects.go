@@ -0,0 +1,188 @@
+package coralogix
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-coralogix/coralogix/clientset"
+)
+
+var _ datasource.DataSourceWithConfigure = &UnmanagedObjectsDataSource{}
+
+// unmanagedObjectListers lists the objects of every resource type that has a list API. Rules groups
+// can't be listed, so they aren't reported.
+var unmanagedObjectListers = map[string]func(ctx context.Context, client *clientset.ClientSet) ([]listedObject, error){
+	"coralogix_alert":                      listAlertObjects,
+	"coralogix_action":                     listActionObjects,
+	"coralogix_events2metric":              listEvents2MetricObjects,
+	"coralogix_tco_policy":                 listTCOPolicyObjects,
+	"coralogix_webhook":                    listWebhookObjects,
+	"coralogix_enrichment":                 listEnrichmentObjects,
+	"coralogix_dashboard":                  listDashboardObjects,
+	"coralogix_recording_rules_groups_set": listRecordingRulesGroupsSetObjects,
+}
+
+func unmanagedObjectResourceTypes() []string {
+	resourceTypes := make([]string, 0, len(unmanagedObjectListers))
+	for resourceType := range unmanagedObjectListers {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+	return resourceTypes
+}
+
+func NewUnmanagedObjectsDataSource() datasource.DataSource {
+	return &UnmanagedObjectsDataSource{}
+}
+
+type UnmanagedObjectsDataSource struct {
+	client *clientset.ClientSet
+}
+
+type UnmanagedObjectsDataSourceModel struct {
+	ID            types.String           `tfsdk:"id"`
+	ResourceTypes types.Set              `tfsdk:"resource_types"`
+	ManagedIDs    types.Map              `tfsdk:"managed_ids"`
+	Objects       []UnmanagedObjectModel `tfsdk:"objects"`
+}
+
+type UnmanagedObjectModel struct {
+	ResourceType types.String `tfsdk:"resource_type"`
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+}
+
+func (d *UnmanagedObjectsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_unmanaged_objects"
+}
+
+func (d *UnmanagedObjectsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clientSet, ok := req.ProviderData.(*clientset.ClientSet)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *clientset.ClientSet, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = clientSet
+}
+
+func (d *UnmanagedObjectsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resourceTypes := unmanagedObjectResourceTypes()
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the Coralogix objects that aren't managed by Terraform. Rules groups aren't reported, as they can't be listed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"resource_types": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(resourceTypes...)),
+				},
+				MarkdownDescription: fmt.Sprintf("The resource types to list. Can be any of %q. Defaults to all of them.", resourceTypes),
+			},
+			"managed_ids": schema.MapAttribute{
+				ElementType: types.SetType{ElemType: types.StringType},
+				Optional:    true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.OneOf(resourceTypes...)),
+				},
+				MarkdownDescription: "The ids of the managed objects, by resource type. e.g. `{coralogix_alert = [for alert in coralogix_alert.all : alert.id]}`.",
+			},
+			"objects": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The resource type that would manage the object.",
+						},
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The object's id, which can be used to import it.",
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+				MarkdownDescription: "The unmanaged objects, sorted by resource type.",
+			},
+		},
+	}
+}
+
+func (d *UnmanagedObjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UnmanagedObjectsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var resourceTypes []string
+	if data.ResourceTypes.IsNull() {
+		resourceTypes = unmanagedObjectResourceTypes()
+	} else {
+		resp.Diagnostics.Append(data.ResourceTypes.ElementsAs(ctx, &resourceTypes, false)...)
+	}
+	sort.Strings(resourceTypes)
+
+	managedIDs := make(map[string][]string)
+	if !data.ManagedIDs.IsNull() {
+		resp.Diagnostics.Append(data.ManagedIDs.ElementsAs(ctx, &managedIDs, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Objects = make([]UnmanagedObjectModel, 0)
+	for _, resourceType := range resourceTypes {
+		log.Printf("[INFO] Listing %s objects", resourceType)
+		objects, err := unmanagedObjectListers[resourceType](ctx, d.client)
+		if err != nil {
+			log.Printf("[ERROR] Received error: %#v", err)
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error listing %s objects", resourceType),
+				handleRpcErrorNewFramework(err, resourceType),
+			)
+			return
+		}
+
+		managed := make(map[string]bool, len(managedIDs[resourceType]))
+		for _, id := range managedIDs[resourceType] {
+			managed[id] = true
+		}
+		for _, object := range objects {
+			if managed[object.id] {
+				continue
+			}
+			data.Objects = append(data.Objects, UnmanagedObjectModel{
+				ResourceType: types.StringValue(resourceType),
+				ID:           types.StringValue(object.id),
+				Name:         types.StringValue(object.name),
+			})
+		}
+	}
+	log.Printf("[INFO] Found %d unmanaged objects", len(data.Objects))
+
+	data.ID = types.StringValue("unmanaged_objects")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,64 @@
+package coralogix
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var unmanagedObjectsDataSourceName = "data.coralogix_unmanaged_objects.test"
+
+func TestAccCoralogixDataSourceUnmanagedObjects(t *testing.T) {
+	action := actionTestParams{
+		name:         acctest.RandomWithPrefix("tf-acc-test"),
+		url:          "https://www.google.com/",
+		sourceType:   selectRandomlyFromSlice(actionValidSourceTypes),
+		applications: []string{acctest.RandomWithPrefix("tf-acc-test")},
+		subsystems:   []string{acctest.RandomWithPrefix("tf-acc-test")},
+		isPrivate:    true,
+		isHidden:     false,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckActionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoralogixResourceAction(action) +
+					testAccCoralogixUnmanagedObjects_read(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(unmanagedObjectsDataSourceName, "objects.#"),
+					testAccCheckActionIsNotUnmanaged(),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckActionIsNotUnmanaged() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		actionID := s.RootModule().Resources[actionResourceName].Primary.ID
+		attributes := s.RootModule().Resources[unmanagedObjectsDataSourceName].Primary.Attributes
+		for key, value := range attributes {
+			if strings.HasPrefix(key, "objects.") && strings.HasSuffix(key, ".id") && value == actionID {
+				return fmt.Errorf("managed action %s is reported as unmanaged", actionID)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCoralogixUnmanagedObjects_read() string {
+	return `data "coralogix_unmanaged_objects" "test" {
+  resource_types = ["coralogix_action"]
+  managed_ids = {
+    coralogix_action = [coralogix_action.test.id]
+  }
+}
+`
+}
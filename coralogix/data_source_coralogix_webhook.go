@@ -3,6 +3,7 @@ package coralogix
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"log"
 	"strconv"
 
@@ -14,8 +15,18 @@ import (
 func dataSourceCoralogixWebhook() *schema.Resource {
 	webhookSchema := datasourceSchemaFromResourceSchema(WebhookSchema())
 	webhookSchema["id"] = &schema.Schema{
-		Type:     schema.TypeString,
-		Required: true,
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"id", "name"},
+		Description:  "The ID of the webhook. Exactly one of id or name must be defined.",
+	}
+	webhookSchema["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"id", "name"},
+		Description:  "The name of the webhook. Exactly one of id or name must be defined. The name has to be unique.",
 	}
 
 	return &schema.Resource{
@@ -26,6 +37,10 @@ func dataSourceCoralogixWebhook() *schema.Resource {
 }
 
 func dataSourceCoralogixWebhookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if name, ok := d.GetOk("name"); ok {
+		return dataSourceCoralogixWebhookReadByName(ctx, d, meta, name.(string))
+	}
+
 	id := d.Get("id").(string)
 
 	log.Printf("[INFO] Reading webhook %s", id)
@@ -43,3 +58,48 @@ func dataSourceCoralogixWebhookRead(ctx context.Context, d *schema.ResourceData,
 	d.SetId(strconv.Itoa(int(m["id"].(float64))))
 	return setWebhook(d, m)
 }
+
+func dataSourceCoralogixWebhookReadByName(ctx context.Context, d *schema.ResourceData, meta interface{}, name string) diag.Diagnostics {
+	log.Printf("[INFO] Listing webhooks to find %q", name)
+	resp, err := meta.(*clientset.ClientSet).Webhooks().ListWebhooks(ctx)
+	if err != nil {
+		log.Printf("[ERROR] Received error: %#v", err)
+		return handleRpcError(err, "webhook")
+	}
+
+	var webhooks []map[string]interface{}
+	if err = json.Unmarshal([]byte(resp), &webhooks); err != nil {
+		return diag.FromErr(err)
+	}
+
+	m, err := findWebhookByName(webhooks, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Found webhook: %#v", m)
+
+	d.SetId(strconv.Itoa(int(m["id"].(float64))))
+	return setWebhook(d, m)
+}
+
+func findWebhookByName(webhooks []map[string]interface{}, name string) (map[string]interface{}, error) {
+	var found []map[string]interface{}
+	for _, webhook := range webhooks {
+		if webhook["alias"] == name {
+			found = append(found, webhook)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("no webhook with name %q found", name)
+	case 1:
+		return found[0], nil
+	default:
+		ids := make([]string, 0, len(found))
+		for _, webhook := range found {
+			ids = append(ids, strconv.Itoa(int(webhook["id"].(float64))))
+		}
+		return nil, fmt.Errorf("found %d webhooks with name %q (ids %q), use id instead", len(found), name, ids)
+	}
+}
@@ -20,6 +20,14 @@ func TestAccCoralogixDataSourceWebhook_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("data.coralogix_webhook.test", "slack.0.url", w.url),
 				),
 			},
+			{
+				Config: testAccCoralogixResourceSlackWebhook(w) +
+					testAccCoralogixDataSourceWebhook_readByName(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.coralogix_webhook.test", "id", "coralogix_webhook.test", "id"),
+					resource.TestCheckResourceAttr("data.coralogix_webhook.test", "slack.0.url", w.url),
+				),
+			},
 		},
 	})
 }
@@ -30,3 +38,10 @@ func testAccCoralogixDataSourceWebhook_read() string {
 }
 `
 }
+
+func testAccCoralogixDataSourceWebhook_readByName() string {
+	return `data "coralogix_webhook" "test" {
+	name = coralogix_webhook.test.name
+}
+`
+}
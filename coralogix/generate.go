@@ -0,0 +1,389 @@
+package coralogix
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+	"terraform-provider-coralogix/coralogix/clientset"
+)
+
+// generateResourceType describes how to enumerate the objects of one resource type.
+// list is nil for types without a list API; their objects can only be exported by id.
+// sdkv2 marks resources served by the SDK provider, whose state holds zero values instead of nulls.
+type generateResourceType struct {
+	list  func(ctx context.Context, client *clientset.ClientSet) ([]listedObject, error)
+	sdkv2 bool
+}
+
+var generateResourceTypes = map[string]generateResourceType{
+	"coralogix_alert":                      {list: listAlertObjects},
+	"coralogix_action":                     {list: listActionObjects},
+	"coralogix_events2metric":              {list: listEvents2MetricObjects},
+	"coralogix_rules_group":                {},
+	"coralogix_dashboard":                  {list: listDashboardObjects, sdkv2: true},
+	"coralogix_recording_rules_groups_set": {list: listRecordingRulesGroupsSetObjects, sdkv2: true},
+	"coralogix_tco_policy":                 {list: listTCOPolicyObjects, sdkv2: true},
+	"coralogix_webhook":                    {list: listWebhookObjects, sdkv2: true},
+}
+
+// stringListFlag collects the values of a flag that may be repeated.
+type stringListFlag []string
+
+func (s *stringListFlag) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *stringListFlag) Set(value string) error {
+	*s = append(*s, value)
+	return nil
+}
+
+// Generate implements the `generate` subcommand. It exports existing Coralogix objects as Terraform
+// configuration, writing an import block and a resource block per object into one <type>.tf file per
+// resource type. The provider is configured from the CORALOGIX_API_KEY and CORALOGIX_ENV (or
+// CORALOGIX_DOMAIN, or CORALOGIX_ENDPOINT) environment variables.
+func Generate(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("generate", flag.ContinueOnError)
+	typesFlag := flags.String("type", "", "Comma-separated resource types to export. Defaults to every type that can be listed.")
+	nameFlag := flags.String("name", "", "Export only objects whose name matches this regular expression.")
+	outFlag := flags.String("out", ".", "Directory the generated files are written to.")
+	var labelFlags, idFlags stringListFlag
+	flags.Var(&labelFlags, "label", "Export only objects carrying this key=value label. May be repeated.")
+	flags.Var(&idFlags, "id", "Export the object with this type=id, skipping enumeration. May be repeated.")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: terraform-provider-coralogix generate [flags]\n\nSupported resource types: %s\n\n", strings.Join(generateResourceTypeNames(), ", "))
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err == flag.ErrHelp {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	selectedTypes, err := parseGenerateTypes(*typesFlag)
+	if err != nil {
+		return err
+	}
+
+	var nameFilter *regexp.Regexp
+	if *nameFlag != "" {
+		if nameFilter, err = regexp.Compile(*nameFlag); err != nil {
+			return fmt.Errorf("invalid -name: %w", err)
+		}
+	}
+
+	labelFilter := make(map[string]string, len(labelFlags))
+	for _, label := range labelFlags {
+		key, value, ok := strings.Cut(label, "=")
+		if !ok {
+			return fmt.Errorf("invalid -label %q: expected key=value", label)
+		}
+		labelFilter[key] = value
+	}
+
+	targetUrl, apiKey, err := clientSetConfigFromEnv()
+	if err != nil {
+		return err
+	}
+	client := clientset.NewClientSet(targetUrl, apiKey, "")
+
+	targets := make(map[string][]listedObject)
+	if len(idFlags) > 0 {
+		for _, typeAndID := range idFlags {
+			resourceType, id, ok := strings.Cut(typeAndID, "=")
+			if !ok {
+				return fmt.Errorf("invalid -id %q: expected type=id", typeAndID)
+			}
+			if _, ok := generateResourceTypes[resourceType]; !ok {
+				return fmt.Errorf("invalid -id %q: unsupported resource type %q", typeAndID, resourceType)
+			}
+			targets[resourceType] = append(targets[resourceType], listedObject{id: id})
+		}
+	} else {
+		for _, resourceType := range selectedTypes {
+			list := generateResourceTypes[resourceType].list
+			if list == nil {
+				log.Printf("[WARN] %s cannot be listed, use -id %s=<id> to export its objects", resourceType, resourceType)
+				continue
+			}
+			listed, err := list(ctx, client)
+			if err != nil {
+				return fmt.Errorf("listing %s: %s", resourceType, handleRpcErrorNewFramework(err, resourceType))
+			}
+			for _, target := range listed {
+				if matchGenerateTarget(target, nameFilter, labelFilter) {
+					targets[resourceType] = append(targets[resourceType], target)
+				}
+			}
+		}
+	}
+
+	server, err := newMuxedProviderServer(ctx)
+	if err != nil {
+		return err
+	}
+	schemas, err := configureProviderServerFromEnv(ctx, server)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(*outFlag, 0o755); err != nil {
+		return err
+	}
+
+	exportedTypes := make([]string, 0, len(targets))
+	for resourceType := range targets {
+		exportedTypes = append(exportedTypes, resourceType)
+	}
+	sort.Strings(exportedTypes)
+
+	for _, resourceType := range exportedTypes {
+		resourceSchema, ok := schemas[resourceType]
+		if !ok {
+			return fmt.Errorf("the provider has no schema for %s", resourceType)
+		}
+
+		var blocks []string
+		labels := make(map[string]bool)
+		for _, target := range targets[resourceType] {
+			state, err := importResourceState(ctx, server, resourceType, resourceSchema, target.id)
+			if err != nil {
+				log.Printf("[WARN] skipping %s %q: %s", resourceType, target.id, err)
+				continue
+			}
+
+			label := uniqueResourceLabel(labels, generateResourceLabel(state, target))
+			block, err := renderResourceHCL(resourceType, label, target.id, resourceSchema.Block, state, generateResourceTypes[resourceType].sdkv2)
+			if err != nil {
+				log.Printf("[WARN] skipping %s %q: %s", resourceType, target.id, err)
+				continue
+			}
+			blocks = append(blocks, block)
+		}
+		if len(blocks) == 0 {
+			continue
+		}
+
+		fileName := filepath.Join(*outFlag, resourceType+".tf")
+		if err = os.WriteFile(fileName, formatHCL(strings.Join(blocks, "\n")), 0o644); err != nil {
+			return err
+		}
+		log.Printf("[INFO] Wrote %d %s resources to %s", len(blocks), resourceType, fileName)
+	}
+
+	return nil
+}
+
+func generateResourceTypeNames() []string {
+	names := make([]string, 0, len(generateResourceTypes))
+	for name := range generateResourceTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func parseGenerateTypes(typesFlag string) ([]string, error) {
+	if typesFlag == "" {
+		return generateResourceTypeNames(), nil
+	}
+
+	var selectedTypes []string
+	for _, resourceType := range strings.Split(typesFlag, ",") {
+		resourceType = strings.TrimSpace(resourceType)
+		if _, ok := generateResourceTypes[resourceType]; !ok {
+			return nil, fmt.Errorf("unsupported resource type %q, can be one of %q", resourceType, generateResourceTypeNames())
+		}
+		selectedTypes = append(selectedTypes, resourceType)
+	}
+	return selectedTypes, nil
+}
+
+func matchGenerateTarget(target listedObject, nameFilter *regexp.Regexp, labelFilter map[string]string) bool {
+	if nameFilter != nil && !nameFilter.MatchString(target.name) {
+		return false
+	}
+	for key, value := range labelFilter {
+		if actual, ok := target.labels[key]; !ok || actual != value {
+			return false
+		}
+	}
+	return true
+}
+
+// clientSetConfigFromEnv resolves the API endpoint and key the same way the provider does when its
+// configuration is empty.
+func clientSetConfigFromEnv() (string, string, error) {
+	var targetUrl string
+	if endpoint := os.Getenv("CORALOGIX_ENDPOINT"); endpoint != "" {
+		targetUrl = endpoint
+	} else if env := os.Getenv("CORALOGIX_ENV"); env != "" {
+		url, ok := envToGrpcUrl[env]
+		if !ok {
+			return "", "", fmt.Errorf("CORALOGIX_ENV can be one of %q", validEnvs)
+		}
+		targetUrl = url
+	} else if domain := os.Getenv("CORALOGIX_DOMAIN"); domain != "" {
+		targetUrl = fmt.Sprintf("ng-api-grpc.%s:443", domain)
+	} else {
+		return "", "", fmt.Errorf("one of the environment variables 'CORALOGIX_ENV', 'CORALOGIX_DOMAIN' or 'CORALOGIX_ENDPOINT' has to be defined")
+	}
+
+	apiKey := os.Getenv("CORALOGIX_API_KEY")
+	if apiKey == "" {
+		return "", "", fmt.Errorf("the environment variable 'CORALOGIX_API_KEY' has to be defined")
+	}
+
+	return targetUrl, apiKey, nil
+}
+
+// newMuxedProviderServer serves both the SDK and the framework providers over protocol 6.
+func newMuxedProviderServer(ctx context.Context) (tfprotov6.ProviderServer, error) {
+	oldProvider, err := tf5to6server.UpgradeServer(ctx, OldProvider().GRPCProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	providers := []func() tfprotov6.ProviderServer{
+		func() tfprotov6.ProviderServer { return oldProvider },
+		providerserver.NewProtocol6(NewCoralogixProvider()),
+	}
+
+	muxServer, err := tf6muxserver.NewMuxServer(ctx, providers...)
+	if err != nil {
+		return nil, err
+	}
+
+	return muxServer.ProviderServer(), nil
+}
+
+// configureProviderServerFromEnv configures the provider with an empty configuration, so it falls back
+// to the environment variables, and returns the resource schemas.
+func configureProviderServerFromEnv(ctx context.Context, server tfprotov6.ProviderServer) (map[string]*tfprotov6.Schema, error) {
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if err = diagnosticsError(schemaResp.Diagnostics); err != nil {
+		return nil, err
+	}
+
+	configType := schemaResp.Provider.ValueType().(tftypes.Object)
+	configValues := make(map[string]tftypes.Value, len(configType.AttributeTypes))
+	for name, attributeType := range configType.AttributeTypes {
+		configValues[name] = tftypes.NewValue(attributeType, nil)
+	}
+	config, err := tfprotov6.NewDynamicValue(configType, tftypes.NewValue(configType, configValues))
+	if err != nil {
+		return nil, err
+	}
+
+	configureResp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: &config})
+	if err != nil {
+		return nil, err
+	}
+	if err = diagnosticsError(configureResp.Diagnostics); err != nil {
+		return nil, err
+	}
+
+	return schemaResp.ResourceSchemas, nil
+}
+
+// importResourceState imports an object by id and reads it, so the state is built by the resource's
+// own flatten functions.
+func importResourceState(ctx context.Context, server tfprotov6.ProviderServer, resourceType string, resourceSchema *tfprotov6.Schema, id string) (tftypes.Value, error) {
+	importResp, err := server.ImportResourceState(ctx, &tfprotov6.ImportResourceStateRequest{TypeName: resourceType, ID: id})
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+	if err = diagnosticsError(importResp.Diagnostics); err != nil {
+		return tftypes.Value{}, err
+	}
+	if len(importResp.ImportedResources) != 1 {
+		return tftypes.Value{}, fmt.Errorf("expected one imported resource, got %d", len(importResp.ImportedResources))
+	}
+	imported := importResp.ImportedResources[0]
+
+	readResp, err := server.ReadResource(ctx, &tfprotov6.ReadResourceRequest{
+		TypeName:     resourceType,
+		CurrentState: imported.State,
+		Private:      imported.Private,
+	})
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+	if err = diagnosticsError(readResp.Diagnostics); err != nil {
+		return tftypes.Value{}, err
+	}
+	if readResp.NewState == nil {
+		return tftypes.Value{}, fmt.Errorf("object not found")
+	}
+
+	state, err := readResp.NewState.Unmarshal(resourceSchema.ValueType())
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+	if state.IsNull() {
+		return tftypes.Value{}, fmt.Errorf("object not found")
+	}
+	return state, nil
+}
+
+func diagnosticsError(diagnostics []*tfprotov6.Diagnostic) error {
+	var errs []string
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Severity == tfprotov6.DiagnosticSeverityError {
+			errs = append(errs, strings.TrimSpace(diagnostic.Summary+": "+diagnostic.Detail))
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s", strings.Join(errs, "; "))
+}
+
+var resourceLabelInvalidChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// generateResourceLabel derives a resource label from the object's name, falling back to its id.
+func generateResourceLabel(state tftypes.Value, target listedObject) string {
+	name := target.name
+	var attributes map[string]tftypes.Value
+	if err := state.As(&attributes); err == nil {
+		if nameValue, ok := attributes["name"]; ok && nameValue.Type().Is(tftypes.String) && nameValue.IsKnown() && !nameValue.IsNull() {
+			_ = nameValue.As(&name)
+		}
+	}
+	if name == "" {
+		name = target.id
+	}
+
+	label := strings.Trim(resourceLabelInvalidChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if label == "" {
+		label = "object"
+	}
+	if label[0] >= '0' && label[0] <= '9' {
+		label = "_" + label
+	}
+	return label
+}
+
+func uniqueResourceLabel(used map[string]bool, label string) string {
+	unique := label
+	for i := 2; used[unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", label, i)
+	}
+	used[unique] = true
+	return unique
+}
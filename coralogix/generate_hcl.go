@@ -0,0 +1,285 @@
+package coralogix
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// hclRenderer renders resource state as configuration. Computed-only attributes, nulls and the
+// top-level id and timeouts are left out. With skipZeroValues, empty strings and empty collections
+// of optional attributes are left out too, since the SDK stores unset values that way.
+type hclRenderer struct {
+	skipZeroValues bool
+}
+
+// renderResourceHCL renders an import block and a resource block for an object's state.
+func renderResourceHCL(resourceType, label, id string, block *tfprotov6.SchemaBlock, state tftypes.Value, sdkv2 bool) (string, error) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "import {\nto = %s.%s\nid = %s\n}\n\n", resourceType, label, renderHCLPrimitive(cty.StringVal(id)))
+	fmt.Fprintf(&b, "resource %q %q {\n", resourceType, label)
+	r := hclRenderer{skipZeroValues: sdkv2}
+	if err := r.writeBlockBody(&b, block, state, true); err != nil {
+		return "", err
+	}
+	b.WriteString("}\n")
+	return b.String(), nil
+}
+
+// formatHCL aligns and indents the rendered configuration like `terraform fmt`.
+func formatHCL(src string) []byte {
+	return hclwrite.Format([]byte(src))
+}
+
+func (r hclRenderer) writeBlockBody(b *strings.Builder, block *tfprotov6.SchemaBlock, value tftypes.Value, topLevel bool) error {
+	var attributes map[string]tftypes.Value
+	if err := value.As(&attributes); err != nil {
+		return err
+	}
+
+	for _, attribute := range block.Attributes {
+		if topLevel && attribute.Name == "id" {
+			continue
+		}
+		if err := r.writeAttribute(b, attribute, attributes[attribute.Name]); err != nil {
+			return err
+		}
+	}
+
+	for _, nestedBlock := range block.BlockTypes {
+		if topLevel && nestedBlock.TypeName == "timeouts" {
+			continue
+		}
+		if err := r.writeNestedBlock(b, nestedBlock, attributes[nestedBlock.TypeName]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r hclRenderer) writeNestedBlock(b *strings.Builder, nestedBlock *tfprotov6.SchemaNestedBlock, value tftypes.Value) error {
+	if value.IsNull() || !value.IsKnown() {
+		return nil
+	}
+
+	var elements []tftypes.Value
+	switch nestedBlock.Nesting {
+	case tfprotov6.SchemaNestedBlockNestingModeSingle, tfprotov6.SchemaNestedBlockNestingModeGroup:
+		elements = []tftypes.Value{value}
+	case tfprotov6.SchemaNestedBlockNestingModeList, tfprotov6.SchemaNestedBlockNestingModeSet:
+		if err := value.As(&elements); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unsupported nesting mode %s of block %q", nestedBlock.Nesting, nestedBlock.TypeName)
+	}
+
+	for _, element := range elements {
+		fmt.Fprintf(b, "%s {\n", nestedBlock.TypeName)
+		if err := r.writeBlockBody(b, nestedBlock.Block, element, false); err != nil {
+			return err
+		}
+		b.WriteString("}\n")
+	}
+	return nil
+}
+
+func (r hclRenderer) writeAttribute(b *strings.Builder, attribute *tfprotov6.SchemaAttribute, value tftypes.Value) error {
+	if !attribute.Required && !attribute.Optional {
+		return nil
+	}
+	if value.IsNull() || !value.IsKnown() {
+		return nil
+	}
+	if r.skipZeroValues && !attribute.Required && isEmptyHCLValue(value) {
+		return nil
+	}
+
+	var rendered string
+	var err error
+	if attribute.NestedType != nil {
+		rendered, err = r.renderNestedAttribute(attribute.NestedType, value)
+	} else {
+		rendered, err = renderHCLValue(value)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", attribute.Name, err)
+	}
+
+	fmt.Fprintf(b, "%s = %s\n", attribute.Name, rendered)
+	return nil
+}
+
+func (r hclRenderer) renderNestedAttribute(object *tfprotov6.SchemaObject, value tftypes.Value) (string, error) {
+	switch object.Nesting {
+	case tfprotov6.SchemaObjectNestingModeSingle:
+		return r.renderNestedObject(object, value)
+	case tfprotov6.SchemaObjectNestingModeList, tfprotov6.SchemaObjectNestingModeSet:
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return "", err
+		}
+		rendered := make([]string, 0, len(elements))
+		for _, element := range elements {
+			object, err := r.renderNestedObject(object, element)
+			if err != nil {
+				return "", err
+			}
+			rendered = append(rendered, object)
+		}
+		return renderHCLCollection("[", rendered, "]"), nil
+	case tfprotov6.SchemaObjectNestingModeMap:
+		var elements map[string]tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return "", err
+		}
+		rendered := make([]string, 0, len(elements))
+		for _, key := range sortedHCLKeys(elements) {
+			object, err := r.renderNestedObject(object, elements[key])
+			if err != nil {
+				return "", err
+			}
+			rendered = append(rendered, renderHCLKey(key)+" = "+object)
+		}
+		return renderHCLCollection("{", rendered, "}"), nil
+	default:
+		return "", fmt.Errorf("unsupported nesting mode %s", object.Nesting)
+	}
+}
+
+func (r hclRenderer) renderNestedObject(object *tfprotov6.SchemaObject, value tftypes.Value) (string, error) {
+	if value.IsNull() {
+		return "null", nil
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := value.As(&attributes); err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	b.WriteString("{\n")
+	for _, attribute := range object.Attributes {
+		if err := r.writeAttribute(&b, attribute, attributes[attribute.Name]); err != nil {
+			return "", err
+		}
+	}
+	b.WriteString("}")
+	return b.String(), nil
+}
+
+// renderHCLValue renders a value of an attribute without a nested schema.
+func renderHCLValue(value tftypes.Value) (string, error) {
+	if value.IsNull() {
+		return "null", nil
+	}
+
+	switch {
+	case value.Type().Is(tftypes.String):
+		var s string
+		if err := value.As(&s); err != nil {
+			return "", err
+		}
+		return renderHCLPrimitive(cty.StringVal(s)), nil
+	case value.Type().Is(tftypes.Number):
+		n := new(big.Float)
+		if err := value.As(&n); err != nil {
+			return "", err
+		}
+		return renderHCLPrimitive(cty.NumberVal(n)), nil
+	case value.Type().Is(tftypes.Bool):
+		var v bool
+		if err := value.As(&v); err != nil {
+			return "", err
+		}
+		return renderHCLPrimitive(cty.BoolVal(v)), nil
+	case value.Type().Is(tftypes.List{}), value.Type().Is(tftypes.Set{}), value.Type().Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return "", err
+		}
+		rendered := make([]string, 0, len(elements))
+		for _, element := range elements {
+			v, err := renderHCLValue(element)
+			if err != nil {
+				return "", err
+			}
+			rendered = append(rendered, v)
+		}
+		return renderHCLCollection("[", rendered, "]"), nil
+	case value.Type().Is(tftypes.Map{}), value.Type().Is(tftypes.Object{}):
+		var elements map[string]tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return "", err
+		}
+		rendered := make([]string, 0, len(elements))
+		for _, key := range sortedHCLKeys(elements) {
+			v, err := renderHCLValue(elements[key])
+			if err != nil {
+				return "", err
+			}
+			rendered = append(rendered, renderHCLKey(key)+" = "+v)
+		}
+		return renderHCLCollection("{", rendered, "}"), nil
+	default:
+		return "", fmt.Errorf("unsupported type %s", value.Type())
+	}
+}
+
+// renderHCLPrimitive relies on hclwrite for quoting, which also escapes template sequences.
+func renderHCLPrimitive(value cty.Value) string {
+	return string(hclwrite.TokensForValue(value).Bytes())
+}
+
+func renderHCLCollection(open string, elements []string, close string) string {
+	if len(elements) == 0 {
+		return open + close
+	}
+	if open == "[" {
+		if !strings.Contains(strings.Join(elements, ""), "\n") {
+			return open + strings.Join(elements, ", ") + close
+		}
+		return open + "\n" + strings.Join(elements, ",\n") + ",\n" + close
+	}
+	return open + "\n" + strings.Join(elements, "\n") + "\n" + close
+}
+
+func renderHCLKey(key string) string {
+	if hclsyntax.ValidIdentifier(key) {
+		return key
+	}
+	return renderHCLPrimitive(cty.StringVal(key))
+}
+
+func sortedHCLKeys(m map[string]tftypes.Value) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func isEmptyHCLValue(value tftypes.Value) bool {
+	switch {
+	case value.Type().Is(tftypes.String):
+		var s string
+		return value.As(&s) == nil && s == ""
+	case value.Type().Is(tftypes.List{}), value.Type().Is(tftypes.Set{}):
+		var elements []tftypes.Value
+		return value.As(&elements) == nil && len(elements) == 0
+	case value.Type().Is(tftypes.Map{}):
+		var elements map[string]tftypes.Value
+		return value.As(&elements) == nil && len(elements) == 0
+	default:
+		return false
+	}
+}
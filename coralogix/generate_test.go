@@ -0,0 +1,235 @@
+package coralogix
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRenderResourceHCL(t *testing.T) {
+	tests := []struct {
+		name         string
+		resourceType string
+		// state is the object's state in JSON, missing attributes are null.
+		state string
+		want  string
+	}{
+		{
+			name:         "framework",
+			resourceType: "coralogix_alert",
+			state: `{
+				"id": "4c1a7e",
+				"name": "errors \"${env}\"",
+				"enabled": true,
+				"severity": "Critical",
+				"description": "",
+				"meta_labels": {"team": "api", "cost center": "12"},
+				"notifications_group": [{
+					"group_by_fields": ["coralogix.metadata.applicationName"],
+					"notification": [{"retriggering_period_minutes": 10, "notify_on": "Triggered_only", "email_recipients": ["oncall@example.com"]}]
+				}],
+				"standard": {
+					"search_query": "level:error",
+					"severities": ["Error"],
+					"condition": {"more_than": true, "threshold": 5, "time_window": "5Min", "group_by": []}
+				}
+			}`,
+			// Attributes are rendered in the schema's order. Unlike SDKv2 states, empty values are kept, since
+			// only null values are unset.
+			want: `import {
+  to = coralogix_alert.errors
+  id = "4c1a7e"
+}
+
+resource "coralogix_alert" "errors" {
+  description = ""
+  enabled     = true
+  meta_labels = {
+    "cost center" = "12"
+    team          = "api"
+  }
+  name = "errors \"$${env}\""
+  notifications_group = [
+    {
+      group_by_fields = ["coralogix.metadata.applicationName"]
+      notification = [
+        {
+          email_recipients            = ["oncall@example.com"]
+          notify_on                   = "Triggered_only"
+          retriggering_period_minutes = 10
+        },
+      ]
+    },
+  ]
+  severity = "Critical"
+  standard = {
+    condition = {
+      group_by    = []
+      more_than   = true
+      threshold   = 5
+      time_window = "5Min"
+    }
+    search_query = "level:error"
+    severities   = ["Error"]
+  }
+}
+`,
+		},
+		{
+			name:         "sdkv2",
+			resourceType: "coralogix_webhook",
+			state: `{
+				"id": "1234",
+				"name": "alerts channel",
+				"slack": [{"url": "https://hooks.slack.com/services/a"}],
+				"custom": [],
+				"email_group": [],
+				"timeouts": {"create": null, "read": null, "update": null, "delete": null}
+			}`,
+			// The empty blocks and the timeouts are left out.
+			want: `import {
+  to = coralogix_webhook.errors
+  id = "1234"
+}
+
+resource "coralogix_webhook" "errors" {
+  name = "alerts channel"
+  slack {
+    url = "https://hooks.slack.com/services/a"
+  }
+}
+`,
+		},
+	}
+
+	schemas := generateTestSchemas(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resourceSchema := schemas[tt.resourceType]
+			state, err := tftypes.ValueFromJSON([]byte(tt.state), resourceSchema.ValueType())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rendered, err := renderResourceHCL(tt.resourceType, "errors", generateTestID(state), resourceSchema.Block, state, generateResourceTypes[tt.resourceType].sdkv2)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := string(formatHCL(rendered))
+			if got != tt.want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, tt.want)
+			}
+			if _, diags := hclsyntax.ParseConfig([]byte(got), "generated.tf", hcl.InitialPos); diags.HasErrors() {
+				t.Errorf("the generated configuration isn't valid HCL: %s", diags)
+			}
+		})
+	}
+}
+
+func generateTestSchemas(t *testing.T) map[string]*tfprotov6.Schema {
+	t.Helper()
+
+	ctx := context.Background()
+	server, err := newMuxedProviderServer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = diagnosticsError(resp.Diagnostics); err != nil {
+		t.Fatal(err)
+	}
+	return resp.ResourceSchemas
+}
+
+func generateTestID(state tftypes.Value) string {
+	var attributes map[string]tftypes.Value
+	var id string
+	if err := state.As(&attributes); err == nil {
+		_ = attributes["id"].As(&id)
+	}
+	return id
+}
+
+func TestMatchGenerateTarget(t *testing.T) {
+	target := listedObject{id: "1", name: "checkout errors", labels: map[string]string{"team": "api", "env": "prod"}}
+
+	tests := []struct {
+		name        string
+		nameFilter  string
+		labelFilter map[string]string
+		want        bool
+	}{
+		{name: "no filters", want: true},
+		{name: "matching name", nameFilter: "^checkout", want: true},
+		{name: "other name", nameFilter: "^errors", want: false},
+		{name: "matching labels", labelFilter: map[string]string{"team": "api", "env": "prod"}, want: true},
+		{name: "other label value", labelFilter: map[string]string{"team": "web"}, want: false},
+		{name: "missing label", labelFilter: map[string]string{"owner": "api"}, want: false},
+		{name: "matching name and other label", nameFilter: "errors", labelFilter: map[string]string{"env": "dev"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var nameFilter *regexp.Regexp
+			if tt.nameFilter != "" {
+				nameFilter = regexp.MustCompile(tt.nameFilter)
+			}
+			if got := matchGenerateTarget(target, nameFilter, tt.labelFilter); got != tt.want {
+				t.Errorf("got %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateResourceLabel(t *testing.T) {
+	stateType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String}}
+	stateWithName := func(name interface{}) tftypes.Value {
+		return tftypes.NewValue(stateType, map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, name)})
+	}
+
+	tests := []struct {
+		name   string
+		state  tftypes.Value
+		target listedObject
+		want   string
+	}{
+		{name: "state name", state: stateWithName("Checkout Errors (prod)"), target: listedObject{id: "1", name: "listed"}, want: "checkout_errors_prod"},
+		{name: "listed name", state: stateWithName(nil), target: listedObject{id: "1", name: "listed-name"}, want: "listed_name"},
+		{name: "state without name", state: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}), target: listedObject{id: "1", name: "listed"}, want: "listed"},
+		{name: "id", state: stateWithName(nil), target: listedObject{id: "a1-b2"}, want: "a1_b2"},
+		{name: "leading digit", state: stateWithName("5xx errors"), target: listedObject{id: "1"}, want: "_5xx_errors"},
+		{name: "no valid characters", state: stateWithName("!!!"), target: listedObject{id: "1"}, want: "object"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := generateResourceLabel(tt.state, tt.target); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUniqueResourceLabel(t *testing.T) {
+	used := make(map[string]bool)
+	var got []string
+	for _, label := range []string{"errors", "errors", "errors_2", "errors", "latency"} {
+		got = append(got, uniqueResourceLabel(used, label))
+	}
+
+	want := []string{"errors", "errors_2", "errors_2_2", "errors_3", "latency"}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("labels: got %q, want %q", got, want)
+			break
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package mockserver
+
+import (
+	"context"
+
+	actions "terraform-provider-coralogix/coralogix/clientset/grpc/actions/v2"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type actionsServer struct {
+	actions.UnimplementedActionsServiceServer
+	actions *store[*actions.Action]
+}
+
+func newActionsServer() *actionsServer {
+	return &actionsServer{actions: newProtoStore[*actions.Action]()}
+}
+
+func (s *actionsServer) CreateAction(_ context.Context, req *actions.CreateActionRequest) (*actions.CreateActionResponse, error) {
+	action := &actions.Action{}
+	if err := convertMessage(req, action); err != nil {
+		return nil, err
+	}
+	action.Id = wrapperspb.String(newID())
+	action.IsHidden = wrapperspb.Bool(false)
+	s.actions.put(action.GetId().GetValue(), action)
+	return &actions.CreateActionResponse{Action: action}, nil
+}
+
+func (s *actionsServer) GetAction(_ context.Context, req *actions.GetActionRequest) (*actions.GetActionResponse, error) {
+	id := req.GetId().GetValue()
+	action, ok := s.actions.get(id)
+	if !ok {
+		return nil, notFound("action", id)
+	}
+	return &actions.GetActionResponse{Action: action}, nil
+}
+
+func (s *actionsServer) ListActions(_ context.Context, _ *actions.ListActionsRequest) (*actions.ListActionsResponse, error) {
+	return &actions.ListActionsResponse{Actions: s.actions.list()}, nil
+}
+
+func (s *actionsServer) ReplaceAction(_ context.Context, req *actions.ReplaceActionRequest) (*actions.ReplaceActionResponse, error) {
+	action := req.GetAction()
+	id := action.GetId().GetValue()
+	if _, ok := s.actions.get(id); !ok {
+		return nil, notFound("action", id)
+	}
+	s.actions.put(id, action)
+	return &actions.ReplaceActionResponse{Action: action}, nil
+}
+
+func (s *actionsServer) DeleteAction(_ context.Context, req *actions.DeleteActionRequest) (*actions.DeleteActionResponse, error) {
+	id := req.GetId().GetValue()
+	if !s.actions.delete(id) {
+		return nil, notFound("action", id)
+	}
+	return &actions.DeleteActionResponse{}, nil
+}
@@ -0,0 +1,63 @@
+package mockserver
+
+import (
+	"context"
+
+	alerts "terraform-provider-coralogix/coralogix/clientset/grpc/alerts/v2"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// alertsServer keeps alerts by their unique identifier, which is the id the provider uses.
+type alertsServer struct {
+	alerts.UnimplementedAlertServiceServer
+	alerts *store[*alerts.Alert]
+}
+
+func newAlertsServer() *alertsServer {
+	return &alertsServer{alerts: newProtoStore[*alerts.Alert]()}
+}
+
+func (s *alertsServer) CreateAlert(_ context.Context, req *alerts.CreateAlertRequest) (*alerts.CreateAlertResponse, error) {
+	alert := &alerts.Alert{}
+	if err := convertMessage(req, alert); err != nil {
+		return nil, err
+	}
+	alert.Id = wrapperspb.String(newID())
+	alert.UniqueIdentifier = wrapperspb.String(newID())
+	s.alerts.put(alert.GetUniqueIdentifier().GetValue(), alert)
+	return &alerts.CreateAlertResponse{Alert: alert}, nil
+}
+
+func (s *alertsServer) GetAlertByUniqueId(_ context.Context, req *alerts.GetAlertByUniqueIdRequest) (*alerts.GetAlertByUniqueIdResponse, error) {
+	id := req.GetId().GetValue()
+	alert, ok := s.alerts.get(id)
+	if !ok {
+		return nil, notFound("alert", id)
+	}
+	return &alerts.GetAlertByUniqueIdResponse{Alert: alert}, nil
+}
+
+func (s *alertsServer) GetAlerts(_ context.Context, _ *alerts.GetAlertsRequest) (*alerts.GetAlertsResponse, error) {
+	return &alerts.GetAlertsResponse{Alerts: s.alerts.list()}, nil
+}
+
+func (s *alertsServer) UpdateAlertByUniqueId(_ context.Context, req *alerts.UpdateAlertByUniqueIdRequest) (*alerts.UpdateAlertByUniqueIdResponse, error) {
+	alert := req.GetAlert()
+	id := alert.GetUniqueIdentifier().GetValue()
+	existing, ok := s.alerts.get(id)
+	if !ok {
+		return nil, notFound("alert", id)
+	}
+	alert.Id = existing.GetId()
+	s.alerts.put(id, alert)
+	return &alerts.UpdateAlertByUniqueIdResponse{Alert: alert}, nil
+}
+
+func (s *alertsServer) DeleteAlertByUniqueId(_ context.Context, req *alerts.DeleteAlertByUniqueIdRequest) (*alerts.DeleteAlertByUniqueIdResponse, error) {
+	id := req.GetId().GetValue()
+	if !s.alerts.delete(id) {
+		return nil, notFound("alert", id)
+	}
+	return &alerts.DeleteAlertByUniqueIdResponse{}, nil
+}
@@ -0,0 +1,106 @@
+package mockserver
+
+import (
+	"context"
+	"sync"
+
+	dashboards "terraform-provider-coralogix/coralogix/clientset/grpc/coralogix-dashboards/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// dashboardsServer also serves the dashboard catalog. Dashboard ids are chosen by the client, and the create
+// response doesn't have the dashboard.
+type dashboardsServer struct {
+	dashboards.UnimplementedDashboardsServiceServer
+	dashboards.UnimplementedDashboardCatalogServiceServer
+	dashboards *store[*dashboards.Dashboard]
+
+	mu          sync.Mutex
+	createTimes map[string]*timestamppb.Timestamp
+	updateTimes map[string]*timestamppb.Timestamp
+}
+
+func newDashboardsServer() *dashboardsServer {
+	return &dashboardsServer{
+		dashboards:  newProtoStore[*dashboards.Dashboard](),
+		createTimes: make(map[string]*timestamppb.Timestamp),
+		updateTimes: make(map[string]*timestamppb.Timestamp),
+	}
+}
+
+func (s *dashboardsServer) touch(id string, created bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := timestamppb.Now()
+	if created {
+		s.createTimes[id] = now
+	}
+	s.updateTimes[id] = now
+}
+
+func (s *dashboardsServer) CreateDashboard(_ context.Context, req *dashboards.CreateDashboardRequest) (*dashboards.CreateDashboardResponse, error) {
+	dashboard := req.GetDashboard()
+	id := dashboard.GetId().GetValue()
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "dashboard id is required")
+	}
+	if _, ok := s.dashboards.get(id); ok {
+		return nil, status.Errorf(codes.AlreadyExists, "dashboard %s already exists", id)
+	}
+	s.dashboards.put(id, dashboard)
+	s.touch(id, true)
+	return &dashboards.CreateDashboardResponse{}, nil
+}
+
+func (s *dashboardsServer) GetDashboard(_ context.Context, req *dashboards.GetDashboardRequest) (*dashboards.GetDashboardResponse, error) {
+	id := req.GetDashboardId().GetValue()
+	dashboard, ok := s.dashboards.get(id)
+	if !ok {
+		return nil, notFound("dashboard", id)
+	}
+	return &dashboards.GetDashboardResponse{Dashboard: dashboard}, nil
+}
+
+func (s *dashboardsServer) ReplaceDashboard(_ context.Context, req *dashboards.ReplaceDashboardRequest) (*dashboards.ReplaceDashboardResponse, error) {
+	dashboard := req.GetDashboard()
+	id := dashboard.GetId().GetValue()
+	if _, ok := s.dashboards.get(id); !ok {
+		return nil, notFound("dashboard", id)
+	}
+	s.dashboards.put(id, dashboard)
+	s.touch(id, false)
+	return &dashboards.ReplaceDashboardResponse{}, nil
+}
+
+func (s *dashboardsServer) DeleteDashboard(_ context.Context, req *dashboards.DeleteDashboardRequest) (*dashboards.DeleteDashboardResponse, error) {
+	id := req.GetDashboardId().GetValue()
+	if !s.dashboards.delete(id) {
+		return nil, notFound("dashboard", id)
+	}
+	return &dashboards.DeleteDashboardResponse{}, nil
+}
+
+func (s *dashboardsServer) GetDashboardCatalog(_ context.Context, _ *dashboards.GetDashboardCatalogRequest) (*dashboards.GetDashboardCatalogResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var items []*dashboards.DashboardCatalogItem
+	for _, dashboard := range s.dashboards.list() {
+		id := dashboard.GetId().GetValue()
+		items = append(items, &dashboards.DashboardCatalogItem{
+			Id:          dashboard.GetId(),
+			Name:        dashboard.GetName(),
+			Description: dashboard.GetDescription(),
+			IsDefault:   wrapperspb.Bool(false),
+			IsPinned:    wrapperspb.Bool(false),
+			CreateTime:  s.createTimes[id],
+			UpdateTime:  s.updateTimes[id],
+		})
+	}
+	return &dashboards.GetDashboardCatalogResponse{Items: items}, nil
+}
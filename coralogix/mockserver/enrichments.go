@@ -0,0 +1,104 @@
+package mockserver
+
+import (
+	"context"
+	"strconv"
+	"sync/atomic"
+
+	enrichment "terraform-provider-coralogix/coralogix/clientset/grpc/enrichment/v1"
+)
+
+type enrichmentsServer struct {
+	enrichment.UnimplementedEnrichmentServiceServer
+	enrichments *store[*enrichment.Enrichment]
+	lastID      atomic.Uint32
+}
+
+func newEnrichmentsServer() *enrichmentsServer {
+	return &enrichmentsServer{enrichments: newProtoStore[*enrichment.Enrichment]()}
+}
+
+func (s *enrichmentsServer) AddEnrichments(_ context.Context, req *enrichment.AddEnrichmentsRequest) (*enrichment.AddEnrichmentsResponse, error) {
+	for _, requestEnrichment := range req.GetRequestEnrichments() {
+		id := s.lastID.Add(1)
+		s.enrichments.put(strconv.Itoa(int(id)), &enrichment.Enrichment{
+			Id:             id,
+			FieldName:      requestEnrichment.GetFieldName().GetValue(),
+			EnrichmentType: requestEnrichment.GetEnrichmentType(),
+		})
+	}
+	return &enrichment.AddEnrichmentsResponse{Enrichments: s.enrichments.list()}, nil
+}
+
+func (s *enrichmentsServer) GetEnrichments(_ context.Context, _ *enrichment.GetEnrichmentsRequest) (*enrichment.GetEnrichmentsResponse, error) {
+	return &enrichment.GetEnrichmentsResponse{Enrichments: s.enrichments.list()}, nil
+}
+
+func (s *enrichmentsServer) RemoveEnrichments(_ context.Context, req *enrichment.RemoveEnrichmentsRequest) (*enrichment.RemoveEnrichmentsResponse, error) {
+	for _, id := range req.GetEnrichmentIds() {
+		idStr := strconv.Itoa(int(id.GetValue()))
+		if _, ok := s.enrichments.get(idStr); !ok {
+			return nil, notFound("enrichment", idStr)
+		}
+	}
+	for _, id := range req.GetEnrichmentIds() {
+		s.enrichments.delete(strconv.Itoa(int(id.GetValue())))
+	}
+	return &enrichment.RemoveEnrichmentsResponse{RemainingEnrichments: s.enrichments.list()}, nil
+}
+
+// customEnrichmentsServer keeps only the custom enrichments' metadata, as their files are never read back.
+type customEnrichmentsServer struct {
+	enrichment.UnimplementedCustomEnrichmentServiceServer
+	customEnrichments *store[*enrichment.CustomEnrichment]
+	lastID            atomic.Uint32
+}
+
+func newCustomEnrichmentsServer() *customEnrichmentsServer {
+	return &customEnrichmentsServer{customEnrichments: newProtoStore[*enrichment.CustomEnrichment]()}
+}
+
+func (s *customEnrichmentsServer) CreateCustomEnrichment(_ context.Context, req *enrichment.CreateCustomEnrichmentRequest) (*enrichment.CreateCustomEnrichmentResponse, error) {
+	customEnrichment := &enrichment.CustomEnrichment{
+		Id:          s.lastID.Add(1),
+		Name:        req.GetName().GetValue(),
+		Description: req.GetDescription().GetValue(),
+		Version:     1,
+	}
+	s.customEnrichments.put(strconv.Itoa(int(customEnrichment.GetId())), customEnrichment)
+	return &enrichment.CreateCustomEnrichmentResponse{CustomEnrichment: customEnrichment}, nil
+}
+
+func (s *customEnrichmentsServer) GetCustomEnrichment(_ context.Context, req *enrichment.GetCustomEnrichmentRequest) (*enrichment.GetCustomEnrichmentResponse, error) {
+	id := strconv.Itoa(int(req.GetId().GetValue()))
+	customEnrichment, ok := s.customEnrichments.get(id)
+	if !ok {
+		return nil, notFound("custom enrichment", id)
+	}
+	return &enrichment.GetCustomEnrichmentResponse{CustomEnrichment: customEnrichment}, nil
+}
+
+func (s *customEnrichmentsServer) GetCustomEnrichments(_ context.Context, _ *enrichment.GetCustomEnrichmentsRequest) (*enrichment.GetCustomEnrichmentsResponse, error) {
+	return &enrichment.GetCustomEnrichmentsResponse{CustomEnrichments: s.customEnrichments.list()}, nil
+}
+
+func (s *customEnrichmentsServer) UpdateCustomEnrichment(_ context.Context, req *enrichment.UpdateCustomEnrichmentRequest) (*enrichment.UpdateCustomEnrichmentResponse, error) {
+	id := strconv.Itoa(int(req.GetCustomEnrichmentId().GetValue()))
+	customEnrichment, ok := s.customEnrichments.get(id)
+	if !ok {
+		return nil, notFound("custom enrichment", id)
+	}
+	customEnrichment.Name = req.GetName().GetValue()
+	customEnrichment.Description = req.GetDescription().GetValue()
+	customEnrichment.Version++
+	s.customEnrichments.put(id, customEnrichment)
+	return &enrichment.UpdateCustomEnrichmentResponse{CustomEnrichment: customEnrichment}, nil
+}
+
+func (s *customEnrichmentsServer) DeleteCustomEnrichment(_ context.Context, req *enrichment.DeleteCustomEnrichmentRequest) (*enrichment.DeleteCustomEnrichmentResponse, error) {
+	id := strconv.Itoa(int(req.GetCustomEnrichmentId().GetValue()))
+	if !s.customEnrichments.delete(id) {
+		return nil, notFound("custom enrichment", id)
+	}
+	return &enrichment.DeleteCustomEnrichmentResponse{CustomEnrichmentId: req.GetCustomEnrichmentId().GetValue()}, nil
+}
@@ -0,0 +1,67 @@
+package mockserver
+
+import (
+	"context"
+	"time"
+
+	e2m "terraform-provider-coralogix/coralogix/clientset/grpc/events2metrics/v2"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type events2MetricsServer struct {
+	e2m.UnimplementedEvents2MetricServiceServer
+	events2Metrics *store[*e2m.E2M]
+}
+
+func newEvents2MetricsServer() *events2MetricsServer {
+	return &events2MetricsServer{events2Metrics: newProtoStore[*e2m.E2M]()}
+}
+
+func (s *events2MetricsServer) CreateE2M(_ context.Context, req *e2m.CreateE2MRequest) (*e2m.CreateE2MResponse, error) {
+	events2Metric := &e2m.E2M{}
+	if err := convertMessage(req.GetE2M(), events2Metric); err != nil {
+		return nil, err
+	}
+	now := wrapperspb.String(time.Now().UTC().Format(time.RFC3339))
+	events2Metric.Id = wrapperspb.String(newID())
+	events2Metric.CreateTime = now
+	events2Metric.UpdateTime = now
+	events2Metric.Permutations = &e2m.E2MPermutations{Limit: req.GetE2M().GetPermutationsLimit().GetValue()}
+	s.events2Metrics.put(events2Metric.GetId().GetValue(), events2Metric)
+	return &e2m.CreateE2MResponse{E2M: events2Metric}, nil
+}
+
+func (s *events2MetricsServer) GetE2M(_ context.Context, req *e2m.GetE2MRequest) (*e2m.GetE2MResponse, error) {
+	id := req.GetId().GetValue()
+	events2Metric, ok := s.events2Metrics.get(id)
+	if !ok {
+		return nil, notFound("events2metric", id)
+	}
+	return &e2m.GetE2MResponse{E2M: events2Metric}, nil
+}
+
+func (s *events2MetricsServer) ListE2M(_ context.Context, _ *e2m.ListE2MRequest) (*e2m.ListE2MResponse, error) {
+	return &e2m.ListE2MResponse{E2M: s.events2Metrics.list()}, nil
+}
+
+func (s *events2MetricsServer) ReplaceE2M(_ context.Context, req *e2m.ReplaceE2MRequest) (*e2m.ReplaceE2MResponse, error) {
+	events2Metric := req.GetE2M()
+	id := events2Metric.GetId().GetValue()
+	existing, ok := s.events2Metrics.get(id)
+	if !ok {
+		return nil, notFound("events2metric", id)
+	}
+	events2Metric.CreateTime = existing.GetCreateTime()
+	events2Metric.UpdateTime = wrapperspb.String(time.Now().UTC().Format(time.RFC3339))
+	s.events2Metrics.put(id, events2Metric)
+	return &e2m.ReplaceE2MResponse{E2M: events2Metric}, nil
+}
+
+func (s *events2MetricsServer) DeleteE2M(_ context.Context, req *e2m.DeleteE2MRequest) (*e2m.DeleteE2MResponse, error) {
+	id := req.GetId().GetValue()
+	if !s.events2Metrics.delete(id) {
+		return nil, notFound("events2metric", id)
+	}
+	return &e2m.DeleteE2MResponse{Id: req.GetId()}, nil
+}
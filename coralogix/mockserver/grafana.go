@@ -0,0 +1,112 @@
+package mockserver
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+	"sync/atomic"
+)
+
+var grafanaSlugInvalidChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// grafanaHandler serves the hosted Grafana dashboards. Saved dashboards get Grafana's uid, id and version
+// fields, and are returned with their meta.
+type grafanaHandler struct {
+	dashboards *store[map[string]interface{}]
+	lastID     atomic.Int64
+}
+
+func newGrafanaHandler() *grafanaHandler {
+	return &grafanaHandler{dashboards: newJSONStore()}
+}
+
+func (h *grafanaHandler) register(mux *http.ServeMux, prefix string) {
+	mux.HandleFunc(prefix+"/db", h.saveDashboard)
+	mux.HandleFunc(prefix+"/uid/", func(w http.ResponseWriter, r *http.Request) {
+		path := restPath(r, prefix+"/uid")
+		if len(path) != 1 {
+			writeMethodNotAllowed(w, r)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			dashboard, ok := h.dashboards.get(path[0])
+			if !ok {
+				writeNotFound(w, "dashboard", path[0])
+				return
+			}
+			writeJSON(w, dashboard)
+		case http.MethodDelete:
+			dashboard, ok := h.dashboards.get(path[0])
+			if !ok || !h.dashboards.delete(path[0]) {
+				writeNotFound(w, "dashboard", path[0])
+				return
+			}
+			model, _ := dashboard["dashboard"].(map[string]interface{})
+			writeJSON(w, map[string]interface{}{"title": model["title"], "message": "Dashboard deleted"})
+		default:
+			writeMethodNotAllowed(w, r)
+		}
+	})
+}
+
+func (h *grafanaHandler) saveDashboard(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeMethodNotAllowed(w, r)
+		return
+	}
+
+	var req struct {
+		Model     map[string]interface{} `json:"dashboard"`
+		FolderID  int64                  `json:"folderId"`
+		FolderUID string                 `json:"folderUid"`
+		Overwrite bool                   `json:"overwrite"`
+	}
+	if err := readJSON(r, &req); err != nil || req.Model == nil {
+		writeError(w, http.StatusBadRequest, "invalid dashboard: %v", err)
+		return
+	}
+
+	model := req.Model
+	uid, _ := model["uid"].(string)
+	if uid == "" {
+		uid = newID()
+	}
+	id := h.lastID.Add(1)
+	version := float64(1)
+	if existing, ok := h.dashboards.get(uid); ok {
+		if !req.Overwrite {
+			writeError(w, http.StatusPreconditionFailed, "a dashboard with uid %s already exists", uid)
+			return
+		}
+		existingModel, _ := existing["dashboard"].(map[string]interface{})
+		existingID, _ := existingModel["id"].(float64)
+		id = int64(existingID)
+		existingVersion, _ := existingModel["version"].(float64)
+		version = existingVersion + 1
+	}
+
+	title, _ := model["title"].(string)
+	slug := strings.Trim(grafanaSlugInvalidChars.ReplaceAllString(strings.ToLower(title), "-"), "-")
+	model["uid"] = uid
+	model["id"] = id
+	model["version"] = version
+	h.dashboards.put(uid, map[string]interface{}{
+		"dashboard": model,
+		"meta": map[string]interface{}{
+			"slug":      slug,
+			"url":       "/grafana/d/" + uid + "/" + slug,
+			"folderId":  req.FolderID,
+			"folderUid": req.FolderUID,
+			"isStarred": false,
+		},
+	})
+
+	writeJSON(w, map[string]interface{}{
+		"uid":     uid,
+		"id":      id,
+		"version": version,
+		"slug":    slug,
+		"status":  "success",
+	})
+}
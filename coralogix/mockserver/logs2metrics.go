@@ -0,0 +1,64 @@
+package mockserver
+
+import (
+	"context"
+	"time"
+
+	l2m "terraform-provider-coralogix/coralogix/clientset/grpc/logs2metrics/v2"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type logs2MetricsServer struct {
+	l2m.UnimplementedLogs2MetricServiceServer
+	logs2Metrics *store[*l2m.L2M]
+}
+
+func newLogs2MetricsServer() *logs2MetricsServer {
+	return &logs2MetricsServer{logs2Metrics: newProtoStore[*l2m.L2M]()}
+}
+
+func (s *logs2MetricsServer) CreateL2M(_ context.Context, req *l2m.CreateL2MRequest) (*l2m.L2M, error) {
+	logs2Metric := req.GetL2M()
+	now := wrapperspb.String(time.Now().UTC().Format(time.RFC3339))
+	logs2Metric.Id = wrapperspb.String(newID())
+	logs2Metric.CreateTime = now
+	logs2Metric.UpdateTime = now
+	s.logs2Metrics.put(logs2Metric.GetId().GetValue(), logs2Metric)
+	return logs2Metric, nil
+}
+
+func (s *logs2MetricsServer) GetL2M(_ context.Context, req *l2m.GetL2MRequest) (*l2m.L2M, error) {
+	id := req.GetId().GetValue()
+	logs2Metric, ok := s.logs2Metrics.get(id)
+	if !ok {
+		return nil, notFound("logs2metric", id)
+	}
+	return logs2Metric, nil
+}
+
+func (s *logs2MetricsServer) ListL2M(_ context.Context, _ *l2m.ListL2MRequest) (*l2m.ListL2MResponse, error) {
+	return &l2m.ListL2MResponse{L2M: s.logs2Metrics.list()}, nil
+}
+
+func (s *logs2MetricsServer) ReplaceL2M(_ context.Context, req *l2m.ReplaceL2MRequest) (*l2m.L2M, error) {
+	logs2Metric := req.GetL2M()
+	id := logs2Metric.GetId().GetValue()
+	existing, ok := s.logs2Metrics.get(id)
+	if !ok {
+		return nil, notFound("logs2metric", id)
+	}
+	logs2Metric.CreateTime = existing.GetCreateTime()
+	logs2Metric.UpdateTime = wrapperspb.String(time.Now().UTC().Format(time.RFC3339))
+	s.logs2Metrics.put(id, logs2Metric)
+	return logs2Metric, nil
+}
+
+func (s *logs2MetricsServer) DeleteL2M(_ context.Context, req *l2m.DeleteL2MRequest) (*emptypb.Empty, error) {
+	id := req.GetId().GetValue()
+	if !s.logs2Metrics.delete(id) {
+		return nil, notFound("logs2metric", id)
+	}
+	return &emptypb.Empty{}, nil
+}
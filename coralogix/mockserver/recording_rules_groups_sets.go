@@ -0,0 +1,71 @@
+package mockserver
+
+import (
+	"context"
+
+	rrg "terraform-provider-coralogix/coralogix/clientset/grpc/recording-rules-groups-sets/v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type recordingRuleGroupSetsServer struct {
+	rrg.UnimplementedRuleGroupSetsServer
+	sets *store[*rrg.OutRuleGroupSet]
+}
+
+func newRecordingRuleGroupSetsServer() *recordingRuleGroupSetsServer {
+	return &recordingRuleGroupSetsServer{sets: newProtoStore[*rrg.OutRuleGroupSet]()}
+}
+
+func newOutRuleGroupSet(id string, name *string, groups []*rrg.InRuleGroup) (*rrg.OutRuleGroupSet, error) {
+	set := &rrg.OutRuleGroupSet{Id: id, Name: name}
+	for _, group := range groups {
+		outGroup := &rrg.OutRuleGroup{}
+		if err := convertMessage(group, outGroup); err != nil {
+			return nil, err
+		}
+		set.Groups = append(set.Groups, outGroup)
+	}
+	return set, nil
+}
+
+func (s *recordingRuleGroupSetsServer) Create(_ context.Context, req *rrg.CreateRuleGroupSet) (*rrg.CreateRuleGroupSetResult, error) {
+	set, err := newOutRuleGroupSet(newID(), req.Name, req.GetGroups())
+	if err != nil {
+		return nil, err
+	}
+	s.sets.put(set.GetId(), set)
+	return &rrg.CreateRuleGroupSetResult{Id: set.GetId()}, nil
+}
+
+func (s *recordingRuleGroupSetsServer) Fetch(_ context.Context, req *rrg.FetchRuleGroupSet) (*rrg.OutRuleGroupSet, error) {
+	set, ok := s.sets.get(req.GetId())
+	if !ok {
+		return nil, notFound("recording rule group set", req.GetId())
+	}
+	return set, nil
+}
+
+func (s *recordingRuleGroupSetsServer) List(_ context.Context, _ *emptypb.Empty) (*rrg.RuleGroupSetListing, error) {
+	return &rrg.RuleGroupSetListing{Sets: s.sets.list()}, nil
+}
+
+func (s *recordingRuleGroupSetsServer) Update(_ context.Context, req *rrg.UpdateRuleGroupSet) (*emptypb.Empty, error) {
+	existing, ok := s.sets.get(req.GetId())
+	if !ok {
+		return nil, notFound("recording rule group set", req.GetId())
+	}
+	set, err := newOutRuleGroupSet(req.GetId(), existing.Name, req.GetGroups())
+	if err != nil {
+		return nil, err
+	}
+	s.sets.put(set.GetId(), set)
+	return &emptypb.Empty{}, nil
+}
+
+func (s *recordingRuleGroupSetsServer) Delete(_ context.Context, req *rrg.DeleteRuleGroupSet) (*emptypb.Empty, error) {
+	if !s.sets.delete(req.GetId()) {
+		return nil, notFound("recording rule group set", req.GetId())
+	}
+	return &emptypb.Empty{}, nil
+}
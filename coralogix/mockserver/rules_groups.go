@@ -0,0 +1,84 @@
+package mockserver
+
+import (
+	"context"
+
+	rulesgroups "terraform-provider-coralogix/coralogix/clientset/grpc/rules-groups/v1"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type ruleGroupsServer struct {
+	rulesgroups.UnimplementedRuleGroupsServiceServer
+	ruleGroups *store[*rulesgroups.RuleGroup]
+}
+
+func newRuleGroupsServer() *ruleGroupsServer {
+	return &ruleGroupsServer{ruleGroups: newProtoStore[*rulesgroups.RuleGroup]()}
+}
+
+// newRuleGroup assigns ids to the group, its subgroups and their rules. Like the backend, every update
+// assigns new ids to the subgroups and rules.
+func (s *ruleGroupsServer) newRuleGroup(id string, req *rulesgroups.CreateRuleGroupRequest) (*rulesgroups.RuleGroup, error) {
+	ruleGroup := &rulesgroups.RuleGroup{}
+	if err := convertMessage(req, ruleGroup); err != nil {
+		return nil, err
+	}
+	ruleGroup.Id = wrapperspb.String(id)
+	for _, subgroup := range ruleGroup.GetRuleSubgroups() {
+		subgroup.Id = wrapperspb.String(newID())
+		for _, rule := range subgroup.GetRules() {
+			rule.Id = wrapperspb.String(newID())
+		}
+	}
+	return ruleGroup, nil
+}
+
+// moveRuleGroup moves the group to the given order, shifting the groups in between by one, and sets its
+// order to its position. Groups keep their position when no order is given, and groups which are created
+// without one are appended.
+func (s *ruleGroupsServer) moveRuleGroup(ruleGroup *rulesgroups.RuleGroup, order *wrapperspb.UInt32Value) *rulesgroups.RuleGroup {
+	id := ruleGroup.GetId().GetValue()
+	if order != nil {
+		s.ruleGroups.move(id, int(order.GetValue())-1)
+	}
+	ruleGroup.Order = wrapperspb.UInt32(uint32(s.ruleGroups.index(id) + 1))
+	return ruleGroup
+}
+
+func (s *ruleGroupsServer) CreateRuleGroup(_ context.Context, req *rulesgroups.CreateRuleGroupRequest) (*rulesgroups.CreateRuleGroupResponse, error) {
+	ruleGroup, err := s.newRuleGroup(newID(), req)
+	if err != nil {
+		return nil, err
+	}
+	s.ruleGroups.put(ruleGroup.GetId().GetValue(), ruleGroup)
+	return &rulesgroups.CreateRuleGroupResponse{RuleGroup: s.moveRuleGroup(ruleGroup, req.GetOrder())}, nil
+}
+
+func (s *ruleGroupsServer) GetRuleGroup(_ context.Context, req *rulesgroups.GetRuleGroupRequest) (*rulesgroups.GetRuleGroupResponse, error) {
+	ruleGroup, ok := s.ruleGroups.get(req.GetGroupId())
+	if !ok {
+		return nil, notFound("rule group", req.GetGroupId())
+	}
+	return &rulesgroups.GetRuleGroupResponse{RuleGroup: s.moveRuleGroup(ruleGroup, nil)}, nil
+}
+
+func (s *ruleGroupsServer) UpdateRuleGroup(_ context.Context, req *rulesgroups.UpdateRuleGroupRequest) (*rulesgroups.UpdateRuleGroupResponse, error) {
+	id := req.GetGroupId().GetValue()
+	if _, ok := s.ruleGroups.get(id); !ok {
+		return nil, notFound("rule group", id)
+	}
+	ruleGroup, err := s.newRuleGroup(id, req.GetRuleGroup())
+	if err != nil {
+		return nil, err
+	}
+	s.ruleGroups.put(id, ruleGroup)
+	return &rulesgroups.UpdateRuleGroupResponse{RuleGroup: s.moveRuleGroup(ruleGroup, req.GetRuleGroup().GetOrder())}, nil
+}
+
+func (s *ruleGroupsServer) DeleteRuleGroup(_ context.Context, req *rulesgroups.DeleteRuleGroupRequest) (*rulesgroups.DeleteRuleGroupResponse, error) {
+	if !s.ruleGroups.delete(req.GetGroupId()) {
+		return nil, notFound("rule group", req.GetGroupId())
+	}
+	return &rulesgroups.DeleteRuleGroupResponse{}, nil
+}
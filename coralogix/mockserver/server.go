@@ -0,0 +1,86 @@
+// Package mockserver implements an in-process fake of the Coralogix APIs used by the provider, keeping all
+// objects in memory. It serves the gRPC services and the REST endpoints on a single plaintext address, which
+// can be set as the provider's endpoint (or the CORALOGIX_ENDPOINT environment variable) to run the
+// acceptance tests offline.
+package mockserver
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	actions "terraform-provider-coralogix/coralogix/clientset/grpc/actions/v2"
+	alerts "terraform-provider-coralogix/coralogix/clientset/grpc/alerts/v2"
+	dashboards "terraform-provider-coralogix/coralogix/clientset/grpc/coralogix-dashboards/v1"
+	enrichment "terraform-provider-coralogix/coralogix/clientset/grpc/enrichment/v1"
+	e2m "terraform-provider-coralogix/coralogix/clientset/grpc/events2metrics/v2"
+	l2m "terraform-provider-coralogix/coralogix/clientset/grpc/logs2metrics/v2"
+	rrg "terraform-provider-coralogix/coralogix/clientset/grpc/recording-rules-groups-sets/v1"
+	rulesgroups "terraform-provider-coralogix/coralogix/clientset/grpc/rules-groups/v1"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc"
+)
+
+// Server is a running mock of the Coralogix APIs.
+type Server struct {
+	listener   net.Listener
+	grpcServer *grpc.Server
+	httpServer *http.Server
+}
+
+// New starts a mock server on a random local port. Close it when done.
+func New() (*Server, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	grpcServer := grpc.NewServer()
+	alerts.RegisterAlertServiceServer(grpcServer, newAlertsServer())
+	actions.RegisterActionsServiceServer(grpcServer, newActionsServer())
+	rulesgroups.RegisterRuleGroupsServiceServer(grpcServer, newRuleGroupsServer())
+	e2m.RegisterEvents2MetricServiceServer(grpcServer, newEvents2MetricsServer())
+	l2m.RegisterLogs2MetricServiceServer(grpcServer, newLogs2MetricsServer())
+	dashboardsServer := newDashboardsServer()
+	dashboards.RegisterDashboardsServiceServer(grpcServer, dashboardsServer)
+	dashboards.RegisterDashboardCatalogServiceServer(grpcServer, dashboardsServer)
+	enrichment.RegisterEnrichmentServiceServer(grpcServer, newEnrichmentsServer())
+	enrichment.RegisterCustomEnrichmentServiceServer(grpcServer, newCustomEnrichmentsServer())
+	rrg.RegisterRuleGroupSetsServer(grpcServer, newRecordingRuleGroupSetsServer())
+
+	restMux := http.NewServeMux()
+	newTCOPoliciesHandler().register(restMux, "/api/v1/external/tco")
+	newWebhooksHandler().register(restMux, "/api/v1/external/integrations")
+	newGrafanaHandler().register(restMux, "/grafana/api/dashboards")
+
+	// gRPC requests arrive over cleartext HTTP/2, while the REST client uses HTTP/1.1.
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ProtoMajor == 2 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+			grpcServer.ServeHTTP(w, r)
+			return
+		}
+		restMux.ServeHTTP(w, r)
+	})
+	httpServer := &http.Server{Handler: h2c.NewHandler(handler, &http2.Server{})}
+
+	go httpServer.Serve(listener)
+
+	return &Server{
+		listener:   listener,
+		grpcServer: grpcServer,
+		httpServer: httpServer,
+	}, nil
+}
+
+// URL returns the endpoint the provider should be configured with.
+func (s *Server) URL() string {
+	return "http://" + s.listener.Addr().String()
+}
+
+// Close stops the server and drops all of its objects.
+func (s *Server) Close() error {
+	s.grpcServer.Stop()
+	return s.httpServer.Close()
+}
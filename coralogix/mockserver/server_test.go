@@ -0,0 +1,755 @@
+package mockserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"terraform-provider-coralogix/coralogix/clientset"
+	actions "terraform-provider-coralogix/coralogix/clientset/grpc/actions/v2"
+	alerts "terraform-provider-coralogix/coralogix/clientset/grpc/alerts/v2"
+	dashboards "terraform-provider-coralogix/coralogix/clientset/grpc/coralogix-dashboards/v1"
+	enrichment "terraform-provider-coralogix/coralogix/clientset/grpc/enrichment/v1"
+	e2m "terraform-provider-coralogix/coralogix/clientset/grpc/events2metrics/v2"
+	l2m "terraform-provider-coralogix/coralogix/clientset/grpc/logs2metrics/v2"
+	rrg "terraform-provider-coralogix/coralogix/clientset/grpc/recording-rules-groups-sets/v1"
+	rulesgroups "terraform-provider-coralogix/coralogix/clientset/grpc/rules-groups/v1"
+
+	"github.com/google/go-cmp/cmp"
+	gapi "github.com/grafana/grafana-api-golang-client"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// newTestClientSet starts a mock server for the test and returns the provider's client set for it.
+func newTestClientSet(t *testing.T) (*Server, *clientset.ClientSet) {
+	t.Helper()
+	server, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { server.Close() })
+	return server, clientset.NewClientSet(server.URL(), "mock-api-key", "")
+}
+
+func assertCode(t *testing.T, err error, code codes.Code) {
+	t.Helper()
+	if status.Code(err) != code {
+		t.Errorf("expected a %s error, got %v", code, err)
+	}
+}
+
+func assertProtoEqual(t *testing.T, want, got proto.Message) {
+	t.Helper()
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected message (-want +got):\n%s", diff)
+	}
+}
+
+// unmarshalJSON decodes a REST response, failing the test if it isn't a JSON object.
+func unmarshalJSON(t *testing.T, body string) map[string]interface{} {
+	t.Helper()
+	var object map[string]interface{}
+	if err := json.Unmarshal([]byte(body), &object); err != nil {
+		t.Fatalf("invalid response %q: %s", body, err)
+	}
+	return object
+}
+
+func TestAlerts(t *testing.T) {
+	_, client := newTestClientSet(t)
+	ctx := context.Background()
+
+	createResp, err := client.Alerts().CreateAlert(ctx, &alerts.CreateAlertRequest{
+		Name:     wrapperspb.String("errors"),
+		IsActive: wrapperspb.Bool(true),
+		Severity: alerts.AlertSeverity_ALERT_SEVERITY_CRITICAL,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	alert := createResp.GetAlert()
+	id := alert.GetUniqueIdentifier()
+	if alert.GetId().GetValue() == "" || id.GetValue() == "" {
+		t.Fatalf("expected the alert to get an id and a unique identifier, got %v", alert)
+	}
+	if alert.GetName().GetValue() != "errors" || alert.GetSeverity() != alerts.AlertSeverity_ALERT_SEVERITY_CRITICAL {
+		t.Errorf("expected the alert to keep the request's fields, got %v", alert)
+	}
+
+	getResp, err := client.Alerts().GetAlert(ctx, &alerts.GetAlertByUniqueIdRequest{Id: id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertProtoEqual(t, alert, getResp.GetAlert())
+
+	updated := proto.Clone(alert).(*alerts.Alert)
+	updated.Id = nil
+	updated.Name = wrapperspb.String("more errors")
+	if _, err = client.Alerts().UpdateAlert(ctx, &alerts.UpdateAlertByUniqueIdRequest{Alert: updated}); err != nil {
+		t.Fatal(err)
+	}
+	getResp, err = client.Alerts().GetAlert(ctx, &alerts.GetAlertByUniqueIdRequest{Id: id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	updated.Id = alert.GetId()
+	assertProtoEqual(t, updated, getResp.GetAlert())
+
+	if _, err = client.Alerts().DeleteAlert(ctx, &alerts.DeleteAlertByUniqueIdRequest{Id: id}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.Alerts().GetAlert(ctx, &alerts.GetAlertByUniqueIdRequest{Id: id})
+	assertCode(t, err, codes.NotFound)
+	_, err = client.Alerts().DeleteAlert(ctx, &alerts.DeleteAlertByUniqueIdRequest{Id: id})
+	assertCode(t, err, codes.NotFound)
+}
+
+func testRuleGroupRequest(name string, order uint32) *rulesgroups.CreateRuleGroupRequest {
+	req := &rulesgroups.CreateRuleGroupRequest{
+		Name: wrapperspb.String(name),
+		RuleSubgroups: []*rulesgroups.CreateRuleGroupRequest_CreateRuleSubgroup{{
+			Rules: []*rulesgroups.CreateRuleGroupRequest_CreateRuleSubgroup_CreateRule{{
+				Name:        wrapperspb.String("block errors"),
+				SourceField: wrapperspb.String("text"),
+				Parameters: &rulesgroups.RuleParameters{RuleParameters: &rulesgroups.RuleParameters_BlockParameters{
+					BlockParameters: &rulesgroups.BlockParameters{Rule: wrapperspb.String("error")},
+				}},
+			}},
+		}},
+	}
+	if order > 0 {
+		req.Order = wrapperspb.UInt32(order)
+	}
+	return req
+}
+
+func TestRuleGroups(t *testing.T) {
+	_, client := newTestClientSet(t)
+	ctx := context.Background()
+
+	ids := make(map[string]string)
+	create := func(name string, order uint32) *rulesgroups.RuleGroup {
+		t.Helper()
+		resp, err := client.RuleGroups().CreateRuleGroup(ctx, testRuleGroupRequest(name, order))
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids[name] = resp.GetRuleGroup().GetId().GetValue()
+		return resp.GetRuleGroup()
+	}
+	get := func(name string) *rulesgroups.RuleGroup {
+		t.Helper()
+		resp, err := client.RuleGroups().GetRuleGroup(ctx, &rulesgroups.GetRuleGroupRequest{GroupId: ids[name]})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.GetRuleGroup()
+	}
+	assertOrder := func(expected string) {
+		t.Helper()
+		actual := make([]string, len(expected))
+		for _, name := range expected {
+			order := int(get(string(name)).GetOrder().GetValue())
+			if order < 1 || order > len(actual) {
+				t.Fatalf("rule-group %c has order %d, expected one of 1-%d", name, order, len(actual))
+			}
+			actual[order-1] = string(name)
+		}
+		if got := strings.Join(actual, ""); got != expected {
+			t.Errorf("expected the rule-groups in order %q, got %q", expected, got)
+		}
+	}
+
+	for _, name := range []string{"a", "b", "c"} {
+		if order := create(name, 0).GetOrder().GetValue(); order != uint32(len(ids)) {
+			t.Errorf("expected rule-group %s to be appended with order %d, got %d", name, len(ids), order)
+		}
+	}
+	created := create("d", 2)
+	if order := created.GetOrder().GetValue(); order != 2 {
+		t.Errorf("expected rule-group d to be created with order 2, got %d", order)
+	}
+	assertOrder("adbc")
+	assertProtoEqual(t, created, get("d"))
+
+	subgroup := created.GetRuleSubgroups()[0]
+	if subgroup.GetId().GetValue() == "" || subgroup.GetRules()[0].GetId().GetValue() == "" {
+		t.Fatalf("expected the subgroups and rules to get ids, got %v", created)
+	}
+
+	updateResp, err := client.RuleGroups().UpdateRuleGroup(ctx, &rulesgroups.UpdateRuleGroupRequest{
+		GroupId:   wrapperspb.String(ids["d"]),
+		RuleGroup: testRuleGroupRequest("d", 4),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	updated := updateResp.GetRuleGroup()
+	if updated.GetId().GetValue() != ids["d"] {
+		t.Errorf("expected the rule-group to keep its id %s, got %s", ids["d"], updated.GetId().GetValue())
+	}
+	updatedSubgroup := updated.GetRuleSubgroups()[0]
+	if updatedSubgroup.GetId().GetValue() == subgroup.GetId().GetValue() ||
+		updatedSubgroup.GetRules()[0].GetId().GetValue() == subgroup.GetRules()[0].GetId().GetValue() {
+		t.Errorf("expected the subgroups and rules to get new ids on update, got %v", updated)
+	}
+	assertOrder("abcd")
+
+	// Updates without an order keep the group's position.
+	if _, err = client.RuleGroups().UpdateRuleGroup(ctx, &rulesgroups.UpdateRuleGroupRequest{
+		GroupId:   wrapperspb.String(ids["b"]),
+		RuleGroup: testRuleGroupRequest("b", 0),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	assertOrder("abcd")
+
+	if _, err = client.RuleGroups().DeleteRuleGroup(ctx, &rulesgroups.DeleteRuleGroupRequest{GroupId: ids["a"]}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.RuleGroups().GetRuleGroup(ctx, &rulesgroups.GetRuleGroupRequest{GroupId: ids["a"]})
+	assertCode(t, err, codes.NotFound)
+	_, err = client.RuleGroups().UpdateRuleGroup(ctx, &rulesgroups.UpdateRuleGroupRequest{
+		GroupId:   wrapperspb.String(ids["a"]),
+		RuleGroup: testRuleGroupRequest("a", 0),
+	})
+	assertCode(t, err, codes.NotFound)
+	delete(ids, "a")
+	assertOrder("bcd")
+}
+
+func TestEnrichments(t *testing.T) {
+	_, client := newTestClientSet(t)
+	ctx := context.Background()
+
+	geoIP := &enrichment.EnrichmentType{Type: &enrichment.EnrichmentType_GeoIp{GeoIp: &enrichment.GeoIpType{}}}
+	suspiciousIP := &enrichment.EnrichmentType{Type: &enrichment.EnrichmentType_SuspiciousIp{SuspiciousIp: &enrichment.SuspiciousIpType{}}}
+	created, err := client.Enrichments().CreateEnrichments(ctx, []*enrichment.EnrichmentRequestModel{
+		{FieldName: wrapperspb.String("ip"), EnrichmentType: geoIP},
+		{FieldName: wrapperspb.String("client_ip"), EnrichmentType: geoIP},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(created) != 2 || created[0].GetFieldName() != "ip" || created[1].GetFieldName() != "client_ip" {
+		t.Fatalf("expected the created enrichments in request order, got %v", created)
+	}
+
+	suspicious, err := client.Enrichments().CreateEnrichments(ctx, []*enrichment.EnrichmentRequestModel{
+		{FieldName: wrapperspb.String("ip"), EnrichmentType: suspiciousIP},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	geoIPEnrichments, err := client.Enrichments().GetEnrichmentsByType(ctx, "geo_ip")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(created, geoIPEnrichments, protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected geo_ip enrichments (-want +got):\n%s", diff)
+	}
+
+	updated, err := client.Enrichments().UpdateEnrichments(ctx, []uint32{created[0].GetId(), created[1].GetId()}, []*enrichment.EnrichmentRequestModel{
+		{FieldName: wrapperspb.String("source_ip"), EnrichmentType: geoIP},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(updated) != 1 || updated[0].GetFieldName() != "source_ip" || updated[0].GetId() == created[0].GetId() || updated[0].GetId() == created[1].GetId() {
+		t.Errorf("expected the updated enrichment to get a new id, got %v", updated)
+	}
+	all, err := client.Enrichments().GetEnrichments(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(append(suspicious, updated...), all, protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected enrichments (-want +got):\n%s", diff)
+	}
+
+	if err = client.Enrichments().DeleteEnrichmentsByType(ctx, "suspicious_ip"); err != nil {
+		t.Fatal(err)
+	}
+	err = client.Enrichments().DeleteEnrichments(ctx, []uint32{suspicious[0].GetId()})
+	assertCode(t, err, codes.NotFound)
+}
+
+func TestCustomEnrichments(t *testing.T) {
+	_, client := newTestClientSet(t)
+	ctx := context.Background()
+
+	createResp, err := client.DataSet().CreatDataSet(ctx, &enrichment.CreateCustomEnrichmentRequest{
+		Name:        wrapperspb.String("hosts"),
+		Description: wrapperspb.String("host owners"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dataSet := createResp.GetCustomEnrichment()
+	id := wrapperspb.UInt32(dataSet.GetId())
+	if dataSet.GetId() == 0 || dataSet.GetVersion() != 1 {
+		t.Fatalf("expected the data set to get an id and version 1, got %v", dataSet)
+	}
+
+	getResp, err := client.DataSet().GetDataSet(ctx, &enrichment.GetCustomEnrichmentRequest{Id: id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertProtoEqual(t, dataSet, getResp.GetCustomEnrichment())
+
+	if _, err = client.DataSet().UpdateDataSet(ctx, &enrichment.UpdateCustomEnrichmentRequest{
+		CustomEnrichmentId: id,
+		Name:               wrapperspb.String("hosts"),
+		Description:        wrapperspb.String("host owners and teams"),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	getResp, err = client.DataSet().GetDataSet(ctx, &enrichment.GetCustomEnrichmentRequest{Id: id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertProtoEqual(t, &enrichment.CustomEnrichment{
+		Id:          dataSet.GetId(),
+		Name:        "hosts",
+		Description: "host owners and teams",
+		Version:     2,
+	}, getResp.GetCustomEnrichment())
+
+	if _, err = client.DataSet().DeleteDataSet(ctx, &enrichment.DeleteCustomEnrichmentRequest{CustomEnrichmentId: id}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.DataSet().GetDataSet(ctx, &enrichment.GetCustomEnrichmentRequest{Id: id})
+	assertCode(t, err, codes.NotFound)
+}
+
+func TestDashboards(t *testing.T) {
+	_, client := newTestClientSet(t)
+	ctx := context.Background()
+
+	_, err := client.Dashboards().CreateDashboard(ctx, &dashboards.CreateDashboardRequest{
+		Dashboard: &dashboards.Dashboard{Name: wrapperspb.String("no id")},
+	})
+	assertCode(t, err, codes.InvalidArgument)
+
+	dashboard := &dashboards.Dashboard{
+		Id:   wrapperspb.String("Kx8eTnNc0gHDaXGwGiPQw"),
+		Name: wrapperspb.String("service"),
+	}
+	if _, err = client.Dashboards().CreateDashboard(ctx, &dashboards.CreateDashboardRequest{Dashboard: dashboard}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.Dashboards().CreateDashboard(ctx, &dashboards.CreateDashboardRequest{Dashboard: dashboard})
+	assertCode(t, err, codes.AlreadyExists)
+
+	getResp, err := client.Dashboards().GetDashboard(ctx, &dashboards.GetDashboardRequest{DashboardId: dashboard.GetId()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertProtoEqual(t, dashboard, getResp.GetDashboard())
+
+	dashboard.Description = wrapperspb.String("service overview")
+	if _, err = client.Dashboards().UpdateDashboard(ctx, &dashboards.ReplaceDashboardRequest{Dashboard: dashboard}); err != nil {
+		t.Fatal(err)
+	}
+	getResp, err = client.Dashboards().GetDashboard(ctx, &dashboards.GetDashboardRequest{DashboardId: dashboard.GetId()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertProtoEqual(t, dashboard, getResp.GetDashboard())
+
+	catalog, err := client.Dashboards().GetDashboardCatalog(ctx, &dashboards.GetDashboardCatalogRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if items := catalog.GetItems(); len(items) != 1 || items[0].GetId().GetValue() != dashboard.GetId().GetValue() ||
+		items[0].GetDescription().GetValue() != "service overview" || items[0].GetCreateTime() == nil {
+		t.Errorf("unexpected catalog %v", items)
+	}
+
+	if _, err = client.Dashboards().DeleteDashboard(ctx, &dashboards.DeleteDashboardRequest{DashboardId: dashboard.GetId()}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.Dashboards().GetDashboard(ctx, &dashboards.GetDashboardRequest{DashboardId: dashboard.GetId()})
+	assertCode(t, err, codes.NotFound)
+	_, err = client.Dashboards().UpdateDashboard(ctx, &dashboards.ReplaceDashboardRequest{Dashboard: dashboard})
+	assertCode(t, err, codes.NotFound)
+}
+
+func TestActions(t *testing.T) {
+	_, client := newTestClientSet(t)
+	ctx := context.Background()
+
+	createResp, err := client.Actions().CreateAction(ctx, &actions.CreateActionRequest{
+		Name:       wrapperspb.String("google"),
+		Url:        wrapperspb.String("https://www.google.com/search?q={{$p.selected_value}}"),
+		IsPrivate:  wrapperspb.Bool(false),
+		SourceType: actions.SourceType_SOURCE_TYPE_LOG,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	action := createResp.GetAction()
+	if action.GetId().GetValue() == "" || action.GetName().GetValue() != "google" {
+		t.Fatalf("expected the action to get an id and keep its fields, got %v", action)
+	}
+
+	getResp, err := client.Actions().GetAction(ctx, &actions.GetActionRequest{Id: action.GetId()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertProtoEqual(t, action, getResp.GetAction())
+
+	action.Name = wrapperspb.String("bing")
+	if _, err = client.Actions().UpdateAction(ctx, &actions.ReplaceActionRequest{Action: action}); err != nil {
+		t.Fatal(err)
+	}
+	getResp, err = client.Actions().GetAction(ctx, &actions.GetActionRequest{Id: action.GetId()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertProtoEqual(t, action, getResp.GetAction())
+
+	if _, err = client.Actions().DeleteAction(ctx, &actions.DeleteActionRequest{Id: action.GetId()}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.Actions().GetAction(ctx, &actions.GetActionRequest{Id: action.GetId()})
+	assertCode(t, err, codes.NotFound)
+}
+
+func TestRecordingRuleGroupSets(t *testing.T) {
+	_, client := newTestClientSet(t)
+	ctx := context.Background()
+
+	name := "recording rules"
+	interval := uint32(180)
+	createResp, err := client.RecordingRuleGroupsSets().CreateRecordingRuleGroupsSet(ctx, &rrg.CreateRuleGroupSet{
+		Name: &name,
+		Groups: []*rrg.InRuleGroup{{
+			Name:     "errors",
+			Interval: &interval,
+			Rules:    []*rrg.InRule{{Record: "errors:rate5m", Expr: "rate(errors[5m])"}},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := createResp.GetId()
+
+	set, err := client.RecordingRuleGroupsSets().GetRecordingRuleGroupsSet(ctx, &rrg.FetchRuleGroupSet{Id: id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertProtoEqual(t, &rrg.OutRuleGroupSet{
+		Id:   id,
+		Name: &name,
+		Groups: []*rrg.OutRuleGroup{{
+			Name:     "errors",
+			Interval: &interval,
+			Rules:    []*rrg.OutRule{{Record: "errors:rate5m", Expr: "rate(errors[5m])"}},
+		}},
+	}, set)
+
+	if _, err = client.RecordingRuleGroupsSets().UpdateRecordingRuleGroupsSet(ctx, &rrg.UpdateRuleGroupSet{
+		Id:     id,
+		Groups: []*rrg.InRuleGroup{{Name: "warnings", Rules: []*rrg.InRule{{Record: "warnings:rate5m", Expr: "rate(warnings[5m])"}}}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	set, err = client.RecordingRuleGroupsSets().GetRecordingRuleGroupsSet(ctx, &rrg.FetchRuleGroupSet{Id: id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertProtoEqual(t, &rrg.OutRuleGroupSet{
+		Id:     id,
+		Name:   &name,
+		Groups: []*rrg.OutRuleGroup{{Name: "warnings", Rules: []*rrg.OutRule{{Record: "warnings:rate5m", Expr: "rate(warnings[5m])"}}}},
+	}, set)
+
+	if _, err = client.RecordingRuleGroupsSets().DeleteRecordingRuleGroupsSet(ctx, &rrg.DeleteRuleGroupSet{Id: id}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.RecordingRuleGroupsSets().GetRecordingRuleGroupsSet(ctx, &rrg.FetchRuleGroupSet{Id: id})
+	assertCode(t, err, codes.NotFound)
+}
+
+func TestEvents2Metrics(t *testing.T) {
+	_, client := newTestClientSet(t)
+	ctx := context.Background()
+
+	createResp, err := client.Events2Metrics().CreateEvents2Metric(ctx, &e2m.CreateE2MRequest{E2M: &e2m.E2MCreateParams{
+		Name:              wrapperspb.String("errors"),
+		PermutationsLimit: wrapperspb.Int32(30000),
+		Type:              e2m.E2MType_E2M_TYPE_LOGS2METRICS,
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	events2Metric := createResp.GetE2M()
+	if events2Metric.GetId().GetValue() == "" || events2Metric.GetCreateTime().GetValue() == "" ||
+		events2Metric.GetPermutations().GetLimit() != 30000 {
+		t.Fatalf("expected the events2metric to get an id, a create time and the permutations limit, got %v", events2Metric)
+	}
+
+	getResp, err := client.Events2Metrics().GetEvents2Metric(ctx, &e2m.GetE2MRequest{Id: events2Metric.GetId()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertProtoEqual(t, events2Metric, getResp.GetE2M())
+
+	createTime := events2Metric.GetCreateTime().GetValue()
+	events2Metric.Description = wrapperspb.String("error count")
+	events2Metric.CreateTime = nil
+	replaceResp, err := client.Events2Metrics().UpdateEvents2Metric(ctx, &e2m.ReplaceE2MRequest{E2M: events2Metric})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replaceResp.GetE2M().GetCreateTime().GetValue() != createTime {
+		t.Errorf("expected the events2metric to keep its create time, got %v", replaceResp.GetE2M())
+	}
+	getResp, err = client.Events2Metrics().GetEvents2Metric(ctx, &e2m.GetE2MRequest{Id: events2Metric.GetId()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertProtoEqual(t, replaceResp.GetE2M(), getResp.GetE2M())
+
+	if _, err = client.Events2Metrics().DeleteEvents2Metric(ctx, &e2m.DeleteE2MRequest{Id: events2Metric.GetId()}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.Events2Metrics().GetEvents2Metric(ctx, &e2m.GetE2MRequest{Id: events2Metric.GetId()})
+	assertCode(t, err, codes.NotFound)
+}
+
+// TestLogs2Metrics uses the generated client, as the client set has none for the logs2metrics service.
+func TestLogs2Metrics(t *testing.T) {
+	server, _ := newTestClientSet(t)
+	ctx := context.Background()
+
+	conn, err := grpc.Dial(strings.TrimPrefix(server.URL(), "http://"), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := l2m.NewLogs2MetricServiceClient(conn)
+
+	logs2Metric, err := client.CreateL2M(ctx, &l2m.CreateL2MRequest{L2M: &l2m.L2M{Name: wrapperspb.String("errors")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if logs2Metric.GetId().GetValue() == "" || logs2Metric.GetCreateTime().GetValue() == "" {
+		t.Fatalf("expected the logs2metric to get an id and a create time, got %v", logs2Metric)
+	}
+
+	got, err := client.GetL2M(ctx, &l2m.GetL2MRequest{Id: logs2Metric.GetId()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertProtoEqual(t, logs2Metric, got)
+
+	logs2Metric.Name = wrapperspb.String("warnings")
+	replaced, err := client.ReplaceL2M(ctx, &l2m.ReplaceL2MRequest{L2M: logs2Metric})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err = client.GetL2M(ctx, &l2m.GetL2MRequest{Id: logs2Metric.GetId()}); err != nil {
+		t.Fatal(err)
+	}
+	assertProtoEqual(t, replaced, got)
+	if got.GetName().GetValue() != "warnings" {
+		t.Errorf("expected the logs2metric to be renamed, got %v", got)
+	}
+
+	if _, err = client.DeleteL2M(ctx, &l2m.DeleteL2MRequest{Id: logs2Metric.GetId()}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.GetL2M(ctx, &l2m.GetL2MRequest{Id: logs2Metric.GetId()})
+	assertCode(t, err, codes.NotFound)
+}
+
+func TestWebhooks(t *testing.T) {
+	_, client := newTestClientSet(t)
+	ctx := context.Background()
+
+	resp, err := client.Webhooks().CreateWebhook(ctx, `{"alias": "hook", "integration_type_id": 1, "integration_type_fields": "[{\"name\":\"url\",\"value\":\"https://example.com\"}]"}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	webhook := unmarshalJSON(t, resp)
+	if webhook["id"] != float64(1) {
+		t.Errorf("expected the first webhook to get the id 1, got %v", webhook["id"])
+	}
+	fields := webhook["integration_type_fields"].(string)
+	if !strings.Contains(fields, `"name":"uuid"`) {
+		t.Errorf("expected the custom webhook to get a uuid, got %s", fields)
+	}
+
+	resp, err = client.Webhooks().GetWebhook(ctx, "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(webhook, unmarshalJSON(t, resp)); diff != "" {
+		t.Errorf("unexpected webhook (-want +got):\n%s", diff)
+	}
+
+	if _, err = client.Webhooks().UpdateWebhook(ctx, `{"id": 1, "alias": "renamed hook", "integration_type_id": 1, "integration_type_fields": "[{\"name\":\"url\",\"value\":\"https://example.com\"}]"}`); err != nil {
+		t.Fatal(err)
+	}
+	resp, err = client.Webhooks().GetWebhook(ctx, "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	updated := unmarshalJSON(t, resp)
+	if updated["alias"] != "renamed hook" || updated["integration_type_fields"] != fields {
+		t.Errorf("expected the webhook to be renamed and keep its uuid, got %v", updated)
+	}
+
+	_, err = client.Webhooks().UpdateWebhook(ctx, `{"id": 2, "alias": "missing hook", "integration_type_id": 0}`)
+	assertCode(t, err, codes.NotFound)
+
+	if _, err = client.Webhooks().DeleteWebhook(ctx, "1"); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.Webhooks().GetWebhook(ctx, "1")
+	assertCode(t, err, codes.NotFound)
+}
+
+func TestTCOPolicies(t *testing.T) {
+	_, client := newTestClientSet(t)
+	ctx := context.Background()
+
+	ids := make(map[string]string)
+	for _, name := range []string{"a", "b", "c"} {
+		resp, err := client.TCOPolicies().CreateTCOPolicy(ctx, fmt.Sprintf(`{"name": %q, "priority": "medium"}`, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		policy := unmarshalJSON(t, resp)
+		if policy["order"] != float64(len(ids)+1) {
+			t.Errorf("expected policy %s to be appended with order %d, got %v", name, len(ids)+1, policy["order"])
+		}
+		ids[name] = policy["id"].(string)
+	}
+	assertOrder := func(expected string) {
+		t.Helper()
+		for i, name := range expected {
+			resp, err := client.TCOPolicies().GetTCOPolicy(ctx, ids[string(name)])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if order := unmarshalJSON(t, resp)["order"]; order != float64(i+1) {
+				t.Errorf("expected policy %c to have order %d, got %v", name, i+1, order)
+			}
+		}
+	}
+
+	reorder, _ := json.Marshal([]string{ids["c"], ids["a"]})
+	if _, err := client.TCOPolicies().ReorderTCOPolicies(ctx, string(reorder)); err != nil {
+		t.Fatal(err)
+	}
+	assertOrder("cab")
+
+	resp, err := client.TCOPolicies().UpdateTCOPolicy(ctx, ids["a"], `{"name": "a", "priority": "high", "enabled": true}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if policy := unmarshalJSON(t, resp); policy["priority"] != "high" || policy["id"] != ids["a"] || policy["order"] != float64(2) {
+		t.Errorf("expected the policy to be updated in place, got %v", policy)
+	}
+	assertOrder("cab")
+
+	if err = client.TCOPolicies().DeleteTCOPolicy(ctx, ids["c"]); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.TCOPolicies().GetTCOPolicy(ctx, ids["c"])
+	assertCode(t, err, codes.NotFound)
+	assertOrder("ab")
+}
+
+func TestTCOPolicyOverrides(t *testing.T) {
+	_, client := newTestClientSet(t)
+	ctx := context.Background()
+
+	resp, err := client.TCOPoliciesOverrides().CreateTCOPolicyOverride(ctx, `{"name": "override", "priority": "low", "severity": 1, "applicationName": "prod"}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	override := unmarshalJSON(t, resp)
+	id, _ := override["id"].(string)
+	if id == "" || override["subsystemName"] != "" {
+		t.Fatalf("expected the override to get an id and an empty subsystem name, got %v", override)
+	}
+
+	resp, err = client.TCOPoliciesOverrides().GetTCOPolicyOverride(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(override, unmarshalJSON(t, resp)); diff != "" {
+		t.Errorf("unexpected override (-want +got):\n%s", diff)
+	}
+
+	if _, err = client.TCOPoliciesOverrides().UpdateTCOPolicyOverride(ctx, id, `{"name": "override", "priority": "high", "severity": 1, "applicationName": "prod"}`); err != nil {
+		t.Fatal(err)
+	}
+	resp, err = client.TCOPoliciesOverrides().GetTCOPolicyOverride(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated := unmarshalJSON(t, resp); updated["priority"] != "high" || updated["id"] != id {
+		t.Errorf("expected the override to be updated in place, got %v", updated)
+	}
+
+	if err = client.TCOPoliciesOverrides().DeleteTCOPolicyOverride(ctx, id); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.TCOPoliciesOverrides().GetTCOPolicyOverride(ctx, id)
+	assertCode(t, err, codes.NotFound)
+}
+
+func TestGrafanaDashboards(t *testing.T) {
+	_, client := newTestClientSet(t)
+	ctx := context.Background()
+
+	created, err := client.GrafanaDashboards().CreateGrafanaDashboard(ctx, gapi.Dashboard{
+		Model: map[string]interface{}{"title": "Service Overview"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.UID == "" || created.ID != 1 || created.Version != 1 || created.Slug != "service-overview" {
+		t.Fatalf("unexpected save response %+v", created)
+	}
+
+	dashboard, err := client.GrafanaDashboards().GetGrafanaDashboard(ctx, created.UID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dashboard.Model["uid"] != created.UID || dashboard.Model["title"] != "Service Overview" || dashboard.Meta.Slug != "service-overview" {
+		t.Errorf("unexpected dashboard %+v", dashboard)
+	}
+
+	_, err = client.GrafanaDashboards().CreateGrafanaDashboard(ctx, gapi.Dashboard{Model: dashboard.Model})
+	if err == nil {
+		t.Error("expected saving a dashboard with an existing uid to fail without overwrite")
+	}
+
+	dashboard.Model["title"] = "Service Details"
+	updated, err := client.GrafanaDashboards().UpdateGrafanaDashboard(ctx, gapi.Dashboard{Model: dashboard.Model})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.UID != created.UID || updated.ID != created.ID || updated.Version != 2 || updated.Slug != "service-details" {
+		t.Errorf("expected the dashboard to keep its uid and id and get a new version, got %+v", updated)
+	}
+
+	if err = client.GrafanaDashboards().DeleteGrafanaDashboard(ctx, created.UID); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.GrafanaDashboards().GetGrafanaDashboard(ctx, created.UID)
+	assertCode(t, err, codes.NotFound)
+}
@@ -0,0 +1,182 @@
+package mockserver
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// store keeps objects by id in insertion order. Objects are copied on the way in and out, so callers can't
+// change the stored state by accident.
+type store[T any] struct {
+	mu      sync.Mutex
+	ids     []string
+	objects map[string]T
+	clone   func(T) T
+}
+
+func newProtoStore[T proto.Message]() *store[T] {
+	return &store[T]{
+		objects: make(map[string]T),
+		clone: func(object T) T {
+			return proto.Clone(object).(T)
+		},
+	}
+}
+
+func newJSONStore() *store[map[string]interface{}] {
+	return &store[map[string]interface{}]{
+		objects: make(map[string]map[string]interface{}),
+		clone: func(object map[string]interface{}) map[string]interface{} {
+			b, _ := json.Marshal(object)
+			var clone map[string]interface{}
+			_ = json.Unmarshal(b, &clone)
+			return clone
+		},
+	}
+}
+
+func (s *store[T]) put(id string, object T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.objects[id]; !ok {
+		s.ids = append(s.ids, id)
+	}
+	s.objects[id] = s.clone(object)
+}
+
+func (s *store[T]) get(id string) (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	object, ok := s.objects[id]
+	if !ok {
+		return object, false
+	}
+	return s.clone(object), true
+}
+
+func (s *store[T]) delete(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.objects[id]; !ok {
+		return false
+	}
+	delete(s.objects, id)
+	for i, storedID := range s.ids {
+		if storedID == id {
+			s.ids = append(s.ids[:i], s.ids[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+func (s *store[T]) list() []T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	objects := make([]T, 0, len(s.ids))
+	for _, id := range s.ids {
+		objects = append(objects, s.clone(s.objects[id]))
+	}
+	return objects
+}
+
+func (s *store[T]) len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.ids)
+}
+
+// index returns the position of an object, or -1 if it doesn't exist.
+func (s *store[T]) index(id string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, storedID := range s.ids {
+		if storedID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// reorder moves the given ids to the front, in the given order.
+func (s *store[T]) reorder(ids []string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ordered := make([]string, 0, len(s.ids))
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if _, ok := s.objects[id]; !ok || seen[id] {
+			return false
+		}
+		seen[id] = true
+		ordered = append(ordered, id)
+	}
+	for _, id := range s.ids {
+		if !seen[id] {
+			ordered = append(ordered, id)
+		}
+	}
+	s.ids = ordered
+	return true
+}
+
+// move puts an object at the given position, shifting the objects between its old and new positions by one.
+// Positions past the end move the object to the end.
+func (s *store[T]) move(id string, index int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	from := -1
+	for i, storedID := range s.ids {
+		if storedID == id {
+			from = i
+			break
+		}
+	}
+	if from == -1 {
+		return false
+	}
+	ids := append(s.ids[:from:from], s.ids[from+1:]...)
+	if index < 0 {
+		index = 0
+	}
+	if index > len(ids) {
+		index = len(ids)
+	}
+	s.ids = append(ids[:index:index], append([]string{id}, ids[index:]...)...)
+	return true
+}
+
+func newID() string {
+	return uuid.NewString()
+}
+
+// convertMessage copies the fields of src into dst by their JSON names, which is how the create requests
+// relate to the stored objects. Fields dst doesn't have are dropped.
+func convertMessage(src, dst proto.Message) error {
+	b, err := protojson.Marshal(src)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err = (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(b, dst); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
+
+func notFound(kind, id string) error {
+	return status.Errorf(codes.NotFound, "%s %s not found", kind, id)
+}
@@ -0,0 +1,163 @@
+package mockserver
+
+import (
+	"net/http"
+)
+
+// tcoPoliciesHandler serves the TCO policies and their overrides. A policy's order is its position, which
+// can be changed by reordering.
+type tcoPoliciesHandler struct {
+	policies  *store[map[string]interface{}]
+	overrides *store[map[string]interface{}]
+}
+
+func newTCOPoliciesHandler() *tcoPoliciesHandler {
+	return &tcoPoliciesHandler{
+		policies:  newJSONStore(),
+		overrides: newJSONStore(),
+	}
+}
+
+func (h *tcoPoliciesHandler) register(mux *http.ServeMux, prefix string) {
+	mux.HandleFunc(prefix+"/policies", func(w http.ResponseWriter, r *http.Request) {
+		h.servePolicies(w, r, nil)
+	})
+	mux.HandleFunc(prefix+"/policies/", func(w http.ResponseWriter, r *http.Request) {
+		h.servePolicies(w, r, restPath(r, prefix+"/policies"))
+	})
+	mux.HandleFunc(prefix+"/overrides", func(w http.ResponseWriter, r *http.Request) {
+		h.serveOverrides(w, r, nil)
+	})
+	mux.HandleFunc(prefix+"/overrides/", func(w http.ResponseWriter, r *http.Request) {
+		h.serveOverrides(w, r, restPath(r, prefix+"/overrides"))
+	})
+}
+
+func (h *tcoPoliciesHandler) servePolicies(w http.ResponseWriter, r *http.Request, path []string) {
+	switch {
+	case len(path) == 0 && r.Method == http.MethodGet:
+		policies := h.policies.list()
+		for i, policy := range policies {
+			policy["order"] = i + 1
+		}
+		writeJSON(w, policies)
+	case len(path) == 0 && r.Method == http.MethodPost:
+		var policy map[string]interface{}
+		if err := readJSON(r, &policy); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid policy: %s", err)
+			return
+		}
+		policy["id"] = newID()
+		h.putPolicy(policy)
+		h.writePolicy(w, policy["id"].(string))
+	case len(path) == 1 && path[0] == "reorder" && r.Method == http.MethodPut:
+		var ids []string
+		if err := readJSON(r, &ids); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid order: %s", err)
+			return
+		}
+		if !h.policies.reorder(ids) {
+			writeError(w, http.StatusBadRequest, "invalid order %q", ids)
+			return
+		}
+		writeJSON(w, ids)
+	case len(path) == 1 && r.Method == http.MethodGet:
+		h.writePolicy(w, path[0])
+	case len(path) == 1 && r.Method == http.MethodPut:
+		if _, ok := h.policies.get(path[0]); !ok {
+			writeNotFound(w, "policy", path[0])
+			return
+		}
+		var policy map[string]interface{}
+		if err := readJSON(r, &policy); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid policy: %s", err)
+			return
+		}
+		policy["id"] = path[0]
+		h.putPolicy(policy)
+		h.writePolicy(w, path[0])
+	case len(path) == 1 && r.Method == http.MethodDelete:
+		if !h.policies.delete(path[0]) {
+			writeNotFound(w, "policy", path[0])
+			return
+		}
+		writeJSON(w, map[string]interface{}{"id": path[0]})
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+// putPolicy stores a policy, filling in the fields the API omits when they are empty.
+func (h *tcoPoliciesHandler) putPolicy(policy map[string]interface{}) {
+	delete(policy, "order")
+	if _, ok := policy["enabled"]; !ok {
+		policy["enabled"] = false
+	}
+	if _, ok := policy["severities"]; !ok {
+		policy["severities"] = []interface{}{}
+	}
+	h.policies.put(policy["id"].(string), policy)
+}
+
+func (h *tcoPoliciesHandler) writePolicy(w http.ResponseWriter, id string) {
+	policy, ok := h.policies.get(id)
+	if !ok {
+		writeNotFound(w, "policy", id)
+		return
+	}
+	policy["order"] = h.policies.index(id) + 1
+	writeJSON(w, policy)
+}
+
+func (h *tcoPoliciesHandler) serveOverrides(w http.ResponseWriter, r *http.Request, path []string) {
+	switch {
+	case len(path) == 0 && r.Method == http.MethodGet:
+		writeJSON(w, h.overrides.list())
+	case len(path) == 0 && r.Method == http.MethodPost:
+		var override map[string]interface{}
+		if err := readJSON(r, &override); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid override: %s", err)
+			return
+		}
+		override["id"] = newID()
+		h.putOverride(override)
+		writeJSON(w, override)
+	case len(path) == 1 && r.Method == http.MethodGet:
+		override, ok := h.overrides.get(path[0])
+		if !ok {
+			writeNotFound(w, "override", path[0])
+			return
+		}
+		writeJSON(w, override)
+	case len(path) == 1 && r.Method == http.MethodPut:
+		if _, ok := h.overrides.get(path[0]); !ok {
+			writeNotFound(w, "override", path[0])
+			return
+		}
+		var override map[string]interface{}
+		if err := readJSON(r, &override); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid override: %s", err)
+			return
+		}
+		override["id"] = path[0]
+		h.putOverride(override)
+		writeJSON(w, override)
+	case len(path) == 1 && r.Method == http.MethodDelete:
+		if !h.overrides.delete(path[0]) {
+			writeNotFound(w, "override", path[0])
+			return
+		}
+		writeJSON(w, map[string]interface{}{"id": path[0]})
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+func (h *tcoPoliciesHandler) putOverride(override map[string]interface{}) {
+	for _, field := range []string{"applicationName", "subsystemName"} {
+		if _, ok := override[field]; !ok {
+			override[field] = ""
+		}
+	}
+	h.overrides.put(override["id"].(string), override)
+}
@@ -0,0 +1,105 @@
+package mockserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+)
+
+// customWebhookTypeID is the integration type of custom webhooks, which get a uuid field.
+const customWebhookTypeID = 1
+
+// webhooksHandler serves the outgoing webhooks (integrations). They have numeric ids, and an update is a
+// POST whose body has the webhook's id.
+type webhooksHandler struct {
+	webhooks *store[map[string]interface{}]
+	lastID   atomic.Int64
+}
+
+func newWebhooksHandler() *webhooksHandler {
+	return &webhooksHandler{webhooks: newJSONStore()}
+}
+
+func (h *webhooksHandler) register(mux *http.ServeMux, prefix string) {
+	mux.HandleFunc(prefix, func(w http.ResponseWriter, r *http.Request) {
+		h.serve(w, r, nil)
+	})
+	mux.HandleFunc(prefix+"/", func(w http.ResponseWriter, r *http.Request) {
+		h.serve(w, r, restPath(r, prefix))
+	})
+}
+
+func (h *webhooksHandler) serve(w http.ResponseWriter, r *http.Request, path []string) {
+	switch {
+	case len(path) == 0 && r.Method == http.MethodGet:
+		writeJSON(w, h.webhooks.list())
+	case len(path) == 0 && r.Method == http.MethodPost:
+		var webhook map[string]interface{}
+		if err := readJSON(r, &webhook); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid webhook: %s", err)
+			return
+		}
+		var id string
+		var existing map[string]interface{}
+		if existingID, ok := webhook["id"].(float64); ok {
+			id = strconv.Itoa(int(existingID))
+			if existing, ok = h.webhooks.get(id); !ok {
+				writeNotFound(w, "webhook", id)
+				return
+			}
+		} else {
+			id = strconv.FormatInt(h.lastID.Add(1), 10)
+		}
+		webhook["id"], _ = strconv.Atoi(id)
+		if typeID, _ := webhook["integration_type_id"].(float64); typeID == customWebhookTypeID {
+			webhook["integration_type_fields"] = withWebhookUUID(webhook["integration_type_fields"], existing["integration_type_fields"])
+		}
+		h.webhooks.put(id, webhook)
+		writeJSON(w, webhook)
+	case len(path) == 1 && r.Method == http.MethodGet:
+		webhook, ok := h.webhooks.get(path[0])
+		if !ok {
+			writeNotFound(w, "webhook", path[0])
+			return
+		}
+		writeJSON(w, webhook)
+	case len(path) == 1 && r.Method == http.MethodDelete:
+		if !h.webhooks.delete(path[0]) {
+			writeNotFound(w, "webhook", path[0])
+			return
+		}
+		writeJSON(w, map[string]interface{}{"id": path[0]})
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+// withWebhookUUID adds a uuid field to a custom webhook's fields, keeping the uuid of the existing webhook on
+// updates. The fields are a JSON array of {name, value} objects, encoded as a string.
+func withWebhookUUID(fields, existingFields interface{}) interface{} {
+	var parsed []map[string]interface{}
+	if s, ok := fields.(string); !ok || json.Unmarshal([]byte(s), &parsed) != nil {
+		return fields
+	}
+
+	webhookUUID := newID()
+	if s, ok := existingFields.(string); ok {
+		var existing []map[string]interface{}
+		_ = json.Unmarshal([]byte(s), &existing)
+		for _, field := range existing {
+			if field["name"] == "uuid" {
+				webhookUUID, _ = field["value"].(string)
+			}
+		}
+	}
+
+	for _, field := range parsed {
+		if field["name"] == "uuid" {
+			return fields
+		}
+	}
+	parsed = append(parsed, map[string]interface{}{"name": "uuid", "value": webhookUUID})
+	b, _ := json.Marshal(parsed)
+	return string(b)
+}
@@ -0,0 +1,170 @@
+package coralogix
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"terraform-provider-coralogix/coralogix/clientset"
+	actions "terraform-provider-coralogix/coralogix/clientset/grpc/actions/v2"
+	alerts "terraform-provider-coralogix/coralogix/clientset/grpc/alerts/v2"
+	dashboards "terraform-provider-coralogix/coralogix/clientset/grpc/coralogix-dashboards/v1"
+	enrichment "terraform-provider-coralogix/coralogix/clientset/grpc/enrichment/v1"
+	e2m "terraform-provider-coralogix/coralogix/clientset/grpc/events2metrics/v2"
+)
+
+// listedObject is a Coralogix object as returned by a list API, identified by its resource's import id.
+type listedObject struct {
+	id     string
+	name   string
+	labels map[string]string
+}
+
+func listAlertObjects(ctx context.Context, client *clientset.ClientSet) ([]listedObject, error) {
+	resp, err := client.Alerts().GetAlerts(ctx, &alerts.GetAlertsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make([]listedObject, 0, len(resp.GetAlerts()))
+	for _, alert := range resp.GetAlerts() {
+		labels := make(map[string]string, len(alert.GetMetaLabels()))
+		for _, label := range alert.GetMetaLabels() {
+			labels[label.GetKey().GetValue()] = label.GetValue().GetValue()
+		}
+		targets = append(targets, listedObject{
+			id:     alert.GetUniqueIdentifier().GetValue(),
+			name:   alert.GetName().GetValue(),
+			labels: labels,
+		})
+	}
+	return targets, nil
+}
+
+func listActionObjects(ctx context.Context, client *clientset.ClientSet) ([]listedObject, error) {
+	resp, err := client.Actions().ListActions(ctx, &actions.ListActionsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make([]listedObject, 0, len(resp.GetActions()))
+	for _, action := range resp.GetActions() {
+		targets = append(targets, listedObject{id: action.GetId().GetValue(), name: action.GetName().GetValue()})
+	}
+	return targets, nil
+}
+
+func listEvents2MetricObjects(ctx context.Context, client *clientset.ClientSet) ([]listedObject, error) {
+	resp, err := client.Events2Metrics().ListEvents2Metrics(ctx, &e2m.ListE2MRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make([]listedObject, 0, len(resp.GetE2M()))
+	for _, e2m := range resp.GetE2M() {
+		targets = append(targets, listedObject{id: e2m.GetId().GetValue(), name: e2m.GetName().GetValue()})
+	}
+	return targets, nil
+}
+
+func listDashboardObjects(ctx context.Context, client *clientset.ClientSet) ([]listedObject, error) {
+	resp, err := client.Dashboards().GetDashboardCatalog(ctx, &dashboards.GetDashboardCatalogRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make([]listedObject, 0, len(resp.GetItems()))
+	for _, item := range resp.GetItems() {
+		targets = append(targets, listedObject{id: item.GetId().GetValue(), name: item.GetName().GetValue()})
+	}
+	return targets, nil
+}
+
+func listRecordingRulesGroupsSetObjects(ctx context.Context, client *clientset.ClientSet) ([]listedObject, error) {
+	resp, err := client.RecordingRuleGroupsSets().ListRecordingRuleGroupsSets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make([]listedObject, 0, len(resp.GetSets()))
+	for _, set := range resp.GetSets() {
+		targets = append(targets, listedObject{id: set.GetId(), name: set.GetName()})
+	}
+	return targets, nil
+}
+
+func listTCOPolicyObjects(ctx context.Context, client *clientset.ClientSet) ([]listedObject, error) {
+	resp, err := client.TCOPolicies().GetTCOPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var policies []map[string]interface{}
+	if err = json.Unmarshal([]byte(resp), &policies); err != nil {
+		return nil, err
+	}
+
+	targets := make([]listedObject, 0, len(policies))
+	for _, policy := range policies {
+		id, _ := policy["id"].(string)
+		name, _ := policy["name"].(string)
+		targets = append(targets, listedObject{id: id, name: name})
+	}
+	return targets, nil
+}
+
+func listWebhookObjects(ctx context.Context, client *clientset.ClientSet) ([]listedObject, error) {
+	resp, err := client.Webhooks().ListWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var webhooks []map[string]interface{}
+	if err = json.Unmarshal([]byte(resp), &webhooks); err != nil {
+		return nil, err
+	}
+
+	targets := make([]listedObject, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		id, _ := webhook["id"].(float64)
+		name, _ := webhook["alias"].(string)
+		targets = append(targets, listedObject{id: strconv.Itoa(int(id)), name: name})
+	}
+	return targets, nil
+}
+
+// listEnrichmentObjects groups the enrichment fields the way coralogix_enrichment manages them: one object
+// per built-in enrichment type, and one per custom enrichment.
+func listEnrichmentObjects(ctx context.Context, client *clientset.ClientSet) ([]listedObject, error) {
+	enrichments, err := client.Enrichments().GetEnrichments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var objects []listedObject
+	seen := make(map[string]bool)
+	for _, e := range enrichments {
+		id := enrichmentObjectID(e.GetEnrichmentType())
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		objects = append(objects, listedObject{id: id, name: id})
+	}
+	return objects, nil
+}
+
+func enrichmentObjectID(enrichmentType *enrichment.EnrichmentType) string {
+	switch {
+	case enrichmentType.GetGeoIp() != nil:
+		return "geo_ip"
+	case enrichmentType.GetSuspiciousIp() != nil:
+		return "suspicious_ip"
+	case enrichmentType.GetAws() != nil:
+		return "aws"
+	case enrichmentType.GetCustomEnrichment() != nil:
+		return strconv.Itoa(int(enrichmentType.GetCustomEnrichment().GetId().GetValue()))
+	default:
+		return ""
+	}
+}
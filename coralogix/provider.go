@@ -58,24 +58,32 @@ func OldProvider() *oldSchema.Provider {
 				//ValidateFunc: validation.IsUUID,
 				Description: "A key for using coralogix APIs (Auto Generated), appropriate for the defined environment. environment variable 'CORALOGIX_API_KEY' can be defined instead.",
 			},
+			"endpoint": {
+				Type:        oldSchema.TypeString,
+				Optional:    true,
+				Description: "A custom Coralogix API endpoint (e.g. http://127.0.0.1:8080 for a local mock server), serving both the gRPC and the REST APIs. Takes precedence over 'env' and 'domain'. environment variable 'CORALOGIX_ENDPOINT' can be defined instead.",
+			},
 		},
 
 		DataSourcesMap: map[string]*oldSchema.Resource{
-			"coralogix_rules_group":                dataSourceCoralogixRulesGroup(),
-			"coralogix_alert":                      dataSourceCoralogixAlert(),
-			"coralogix_enrichment":                 dataSourceCoralogixEnrichment(),
-			"coralogix_data_set":                   dataSourceCoralogixDataSet(),
-			"coralogix_dashboard":                  dataSourceCoralogixDashboard(),
-			"coralogix_hosted_dashboard":           dataSourceCoralogixHostedDashboard(),
-			"coralogix_recording_rules_groups_set": dataSourceCoralogixRecordingRulesGroupsSet(),
-			"coralogix_tco_policy":                 dataSourceCoralogixTCOPolicy(),
-			"coralogix_tco_policy_override":        dataSourceCoralogixTCOPolicyOverride(),
-			"coralogix_webhook":                    dataSourceCoralogixWebhook(),
+			"coralogix_grok_pattern":                 dataSourceCoralogixGrokPattern(),
+			"coralogix_enrichment":                   dataSourceCoralogixEnrichment(),
+			"coralogix_data_set":                     dataSourceCoralogixDataSet(),
+			"coralogix_dashboard":                    dataSourceCoralogixDashboard(),
+			"coralogix_dashboard_widget":             dataSourceCoralogixDashboardWidget(),
+			"coralogix_dashboard_section":            dataSourceCoralogixDashboardSection(),
+			"coralogix_dashboard_document":           dataSourceCoralogixDashboardDocument(),
+			"coralogix_grafana_dashboard_conversion": dataSourceCoralogixGrafanaDashboardConversion(),
+			"coralogix_prometheus_alerting_rules":    dataSourceCoralogixPrometheusAlertingRules(),
+			"coralogix_alert_notification_preview":   dataSourceCoralogixAlertNotificationPreview(),
+			"coralogix_hosted_dashboard":             dataSourceCoralogixHostedDashboard(),
+			"coralogix_recording_rules_groups_set":   dataSourceCoralogixRecordingRulesGroupsSet(),
+			"coralogix_tco_policy":                   dataSourceCoralogixTCOPolicy(),
+			"coralogix_tco_policy_override":          dataSourceCoralogixTCOPolicyOverride(),
+			"coralogix_webhook":                      dataSourceCoralogixWebhook(),
 		},
 
 		ResourcesMap: map[string]*oldSchema.Resource{
-			"coralogix_rules_group":                resourceCoralogixRulesGroup(),
-			"coralogix_alert":                      resourceCoralogixAlert(),
 			"coralogix_enrichment":                 resourceCoralogixEnrichment(),
 			"coralogix_data_set":                   resourceCoralogixDataSet(),
 			"coralogix_dashboard":                  resourceCoralogixDashboard(),
@@ -88,7 +96,11 @@ func OldProvider() *oldSchema.Provider {
 
 		ConfigureContextFunc: func(context context.Context, d *oldSchema.ResourceData) (interface{}, diag.Diagnostics) {
 			var targetUrl string
-			if env, ok := d.GetOk("env"); ok && env.(string) != "" {
+			if endpoint, ok := d.GetOk("endpoint"); ok && endpoint.(string) != "" {
+				targetUrl = endpoint.(string)
+			} else if endpoint = os.Getenv("CORALOGIX_ENDPOINT"); endpoint != "" {
+				targetUrl = endpoint.(string)
+			} else if env, ok := d.GetOk("env"); ok && env.(string) != "" {
 				targetUrl = envToGrpcUrl[env.(string)]
 			} else if domain, ok := d.GetOk("domain"); ok && domain.(string) != "" {
 				targetUrl = fmt.Sprintf("ng-api-grpc.%s:443", domain)
@@ -97,7 +109,7 @@ func OldProvider() *oldSchema.Provider {
 			} else if domain = os.Getenv("CORALOGIX_DOMAIN"); domain != "" {
 				targetUrl = fmt.Sprintf("ng-api-grpc.%s:443", domain)
 			} else {
-				return nil, diag.Errorf("At least one of the fields 'env' or 'domain', or one of the environment variables 'CORALOGIX_ENV' or 'CORALOGIX_DOMAIN' have to be define")
+				return nil, diag.Errorf("At least one of the fields 'env', 'domain' or 'endpoint', or one of the environment variables 'CORALOGIX_ENV', 'CORALOGIX_DOMAIN' or 'CORALOGIX_ENDPOINT' have to be define")
 			}
 
 			apiKey := os.Getenv("CORALOGIX_API_KEY")
@@ -114,9 +126,10 @@ func OldProvider() *oldSchema.Provider {
 }
 
 type coralogixProviderModel struct {
-	Env    types.String `tfsdk:"env"`
-	Domain types.String `tfsdk:"domain"`
-	ApiKey types.String `tfsdk:"api_key"`
+	Env      types.String `tfsdk:"env"`
+	Domain   types.String `tfsdk:"domain"`
+	ApiKey   types.String `tfsdk:"api_key"`
+	Endpoint types.String `tfsdk:"endpoint"`
 }
 
 var (
@@ -149,6 +162,10 @@ func (p *coralogixProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Sensitive:   true,
 				Description: "A key for using coralogix APIs (Auto Generated), appropriate for the defined environment. environment variable 'CORALOGIX_API_KEY' can be defined instead.",
 			},
+			"endpoint": schema.StringAttribute{
+				Optional:    true,
+				Description: "A custom Coralogix API endpoint (e.g. http://127.0.0.1:8080 for a local mock server), serving both the gRPC and the REST APIs. Takes precedence over 'env' and 'domain'. environment variable 'CORALOGIX_ENDPOINT' can be defined instead.",
+			},
 		},
 	}
 }
@@ -182,6 +199,15 @@ func (p *coralogixProvider) Configure(ctx context.Context, req provider.Configur
 		)
 	}
 
+	if config.Endpoint.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("endpoint"),
+			"Unknown Coralogix Endpoint",
+			"The provider cannot create the Coralogix API client as there is an unknown configuration value for the Coralogix endpoint. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the CORALOGIX_ENDPOINT environment variable.",
+		)
+	}
+
 	if config.ApiKey.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("api_key"),
@@ -201,6 +227,7 @@ func (p *coralogixProvider) Configure(ctx context.Context, req provider.Configur
 	domain := os.Getenv("CORALOGIX_DOMAIN")
 	env := os.Getenv("CORALOGIX_ENV")
 	apiKey := os.Getenv("CORALOGIX_API_KEY")
+	endpoint := os.Getenv("CORALOGIX_ENDPOINT")
 
 	if !config.Domain.IsNull() {
 		domain = config.Domain.ValueString()
@@ -214,10 +241,14 @@ func (p *coralogixProvider) Configure(ctx context.Context, req provider.Configur
 		apiKey = config.ApiKey.ValueString()
 	}
 
+	if !config.Endpoint.IsNull() {
+		endpoint = config.Endpoint.ValueString()
+	}
+
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
-	if domain == "" && env == "" {
+	if endpoint == "" && domain == "" && env == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("domain"),
 			"Missing Coralogix domain",
@@ -236,7 +267,7 @@ func (p *coralogixProvider) Configure(ctx context.Context, req provider.Configur
 		)
 	}
 
-	if domain != "" && env != "" {
+	if endpoint == "" && domain != "" && env != "" {
 		resp.Diagnostics.AddError("Conflicting attributes \"env\" and \"domain\"",
 			"Only one of \"env\" need to be set."+
 				"ensure CORALOGIX_ENV and CORALOGIX_DOMAIN are not set together as well.",
@@ -258,7 +289,9 @@ func (p *coralogixProvider) Configure(ctx context.Context, req provider.Configur
 	}
 
 	var targetUrl string
-	if env != "" {
+	if endpoint != "" {
+		targetUrl = endpoint
+	} else if env != "" {
 		targetUrl = envToGrpcUrl[env]
 	} else {
 		targetUrl = fmt.Sprintf("ng-api-grpc.%s:443", domain)
@@ -282,6 +315,10 @@ func (p *coralogixProvider) DataSources(context.Context) []func() datasource.Dat
 	return []func() datasource.DataSource{
 		NewEvents2MetricDataSource,
 		NewActionDataSource,
+		NewAlertDataSource,
+		NewRulesGroupDataSource,
+		NewRulesGroupSimulationDataSource,
+		NewUnmanagedObjectsDataSource,
 	}
 }
 
@@ -289,5 +326,8 @@ func (p *coralogixProvider) Resources(context.Context) []func() resource.Resourc
 	return []func() resource.Resource{
 		NewEvents2MetricResource,
 		NewActionResource,
+		NewAlertResource,
+		NewRulesGroupResource,
+		NewRulesGroupsOrderResource,
 	}
 }
@@ -1,18 +1,25 @@
 package coralogix
 
 import (
+	"context"
+	"fmt"
 	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"terraform-provider-coralogix/coralogix/mockserver"
 )
 
 var testAccProvider *schema.Provider
 var testAccProviderFactories map[string]func() (*schema.Provider, error)
 var testAccProtoV6ProviderFactories map[string]func() (tfprotov6.ProviderServer, error)
 
+// testAccMuxedProviderFactories serves both the SDK and the framework providers, like main does,
+// for configurations that mix their resources.
+var testAccMuxedProviderFactories map[string]func() (tfprotov6.ProviderServer, error)
+
 func init() {
 	testAccProvider = OldProvider()
 	testAccProviderFactories = map[string]func() (*schema.Provider, error){
@@ -23,6 +30,33 @@ func init() {
 	testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 		"coralogix": providerserver.NewProtocol6WithError(NewCoralogixProvider()),
 	}
+	testAccMuxedProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+		"coralogix": func() (tfprotov6.ProviderServer, error) {
+			return newMuxedProviderServer(context.Background())
+		},
+	}
+}
+
+// TestMain points the acceptance tests at a local mock of the Coralogix APIs when TF_ACC_MOCK is set, so
+// they can run offline.
+func TestMain(m *testing.M) {
+	if os.Getenv("TF_ACC_MOCK") == "" {
+		os.Exit(m.Run())
+	}
+
+	server, err := mockserver.New()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to start the mock server: %s\n", err)
+		os.Exit(1)
+	}
+	os.Setenv("CORALOGIX_ENDPOINT", server.URL())
+	if os.Getenv("CORALOGIX_API_KEY") == "" {
+		os.Setenv("CORALOGIX_API_KEY", "mock-api-key")
+	}
+
+	code := m.Run()
+	server.Close()
+	os.Exit(code)
 }
 
 func TestProvider(t *testing.T) {
@@ -43,8 +77,8 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatalf("CORALOGIX_API_KEY must be set for acceptance tests")
 	}
 
-	if os.Getenv("CORALOGIX_ENV") == "" {
-		t.Fatalf("CORALOGIX_ENV must be set for acceptance tests")
+	if os.Getenv("CORALOGIX_ENV") == "" && os.Getenv("CORALOGIX_ENDPOINT") == "" {
+		t.Fatalf("CORALOGIX_ENV or CORALOGIX_ENDPOINT must be set for acceptance tests")
 	}
 
 	//diags := testAccProvider.Configure(ctx, terraform.NewResourceConfigRaw(nil))
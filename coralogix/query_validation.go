@@ -0,0 +1,839 @@
+package coralogix
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	promqlAggregators = map[string]bool{
+		"sum": true, "avg": true, "count": true, "min": true, "max": true, "group": true, "stddev": true, "stdvar": true,
+		"topk": true, "bottomk": true, "count_values": true, "quantile": true, "limitk": true, "limit_ratio": true,
+	}
+	promqlFunctions = map[string]bool{
+		"abs": true, "absent": true, "absent_over_time": true, "acos": true, "acosh": true, "asin": true, "asinh": true,
+		"atan": true, "atanh": true, "avg_over_time": true, "ceil": true, "changes": true, "clamp": true, "clamp_max": true,
+		"clamp_min": true, "cos": true, "cosh": true, "count_over_time": true, "days_in_month": true, "day_of_month": true,
+		"day_of_week": true, "day_of_year": true, "deg": true, "delta": true, "deriv": true, "double_exponential_smoothing": true,
+		"exp": true, "floor": true, "histogram_avg": true, "histogram_count": true, "histogram_fraction": true,
+		"histogram_quantile": true, "histogram_stddev": true, "histogram_stdvar": true, "histogram_sum": true,
+		"holt_winters": true, "hour": true, "idelta": true, "info": true, "increase": true, "irate": true, "label_join": true,
+		"label_replace": true, "last_over_time": true, "ln": true, "log10": true, "log2": true, "mad_over_time": true,
+		"max_over_time": true, "min_over_time": true, "minute": true, "month": true, "pi": true, "predict_linear": true,
+		"present_over_time": true, "quantile_over_time": true, "rad": true, "rate": true, "resets": true, "round": true,
+		"scalar": true, "sgn": true, "sin": true, "sinh": true, "sort": true, "sort_by_label": true,
+		"sort_by_label_desc": true, "sort_desc": true, "sqrt": true, "stddev_over_time": true, "stdvar_over_time": true,
+		"sum_over_time": true, "tan": true, "tanh": true, "time": true, "timestamp": true, "vector": true, "year": true,
+	}
+	promqlBinaryOperatorsPrecedence = map[string]int{
+		"or":     1,
+		"and":    2,
+		"unless": 2,
+		"==":     3,
+		"!=":     3,
+		"<=":     3,
+		"<":      3,
+		">=":     3,
+		">":      3,
+		"+":      4,
+		"-":      4,
+		"*":      5,
+		"/":      5,
+		"%":      5,
+		"atan2":  5,
+		"^":      6,
+	}
+	promqlDurationRegex       = regexp.MustCompile(`^(?:\d+(?:ms|[smhdwy]))+$`)
+	promqlNumberRegex         = regexp.MustCompile(`^(?:0[xX][0-9a-fA-F]+|(?:\d+\.?\d*|\.\d+)(?:[eE][+-]?\d+)?)$`)
+	dashboardVariableRegex    = regexp.MustCompile(`^(?:\$\{[A-Za-z_][A-Za-z0-9_]*\}|\$[A-Za-z_][A-Za-z0-9_]*|\{\{\s*[A-Za-z_][A-Za-z0-9_]*\s*\}\})`)
+	luceneRangeSeparatorRegex = regexp.MustCompile(`\s+TO\s+`)
+	luceneReservedOperators   = map[string]bool{"AND": true, "OR": true, "NOT": true, "&&": true, "||": true}
+)
+
+// querySyntaxError is a syntax error in a query, at a byte offset of the query.
+type querySyntaxError struct {
+	language string
+	query    string
+	offset   int
+	message  string
+}
+
+func (e *querySyntaxError) Error() string {
+	return fmt.Sprintf("%s syntax error at %s: %s", e.language, e.location(), e.message)
+}
+
+// location returns the line and column of the error, both counted from 1.
+func (e *querySyntaxError) location() string {
+	line, column := 1, 1
+	for _, r := range e.query[:e.offset] {
+		if r == '\n' {
+			line++
+			column = 1
+		} else {
+			column++
+		}
+	}
+	return fmt.Sprintf("line %d, column %d", line, column)
+}
+
+func validatePromqlQuery(v interface{}, k string) ([]string, []error) {
+	return promqlValidationResult(k, v.(string), false)
+}
+
+// validateDashboardPromqlQuery validates PromQL which may refer to dashboard variables ($var, ${var} or {{var}}).
+func validateDashboardPromqlQuery(v interface{}, k string) ([]string, []error) {
+	return promqlValidationResult(k, v.(string), true)
+}
+
+func promqlValidationResult(k, query string, allowVariables bool) ([]string, []error) {
+	warnings, err := parsePromql(query, allowVariables)
+	for i, warning := range warnings {
+		warnings[i] = fmt.Sprintf("%q: %s", k, warning)
+	}
+	if err != nil {
+		return warnings, []error{fmt.Errorf("%q: %s", k, err)}
+	}
+	return warnings, nil
+}
+
+func validateLuceneQuery(v interface{}, k string) ([]string, []error) {
+	if err := parseLucene(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%q: %s", k, err)}
+	}
+	return nil, nil
+}
+
+// luceneQueryValidator is the plugin-framework equivalent of validateLuceneQuery.
+type luceneQueryValidator struct{}
+
+func (v luceneQueryValidator) Description(_ context.Context) string {
+	return "value must be a valid Lucene query"
+}
+
+func (v luceneQueryValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v luceneQueryValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if err := parseLucene(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Lucene query", err.Error())
+	}
+}
+
+// promqlQueryValidator is the plugin-framework equivalent of validatePromqlQuery.
+type promqlQueryValidator struct{}
+
+func (v promqlQueryValidator) Description(_ context.Context) string {
+	return "value must be a valid PromQL query"
+}
+
+func (v promqlQueryValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v promqlQueryValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	warnings, err := parsePromql(req.ConfigValue.ValueString(), false)
+	for _, warning := range warnings {
+		resp.Diagnostics.AddAttributeWarning(req.Path, "Unknown PromQL function", warning)
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid PromQL query", err.Error())
+	}
+}
+
+type promqlTokenKind int
+
+const (
+	promqlEOF promqlTokenKind = iota
+	promqlIdentifier
+	promqlNumber
+	promqlDuration
+	promqlString
+	promqlOperator
+	promqlPunctuation
+	promqlVariable
+)
+
+type promqlToken struct {
+	kind   promqlTokenKind
+	text   string
+	offset int
+}
+
+type promqlParser struct {
+	query          string
+	tokens         []promqlToken
+	pos            int
+	allowVariables bool
+	warnings       []string
+}
+
+// parsePromql checks the syntax of a PromQL expression, including label matcher regexes.
+// It doesn't check expression types (e.g. whether a function gets a range vector).
+// Functions that aren't in promqlFunctions may have been added to PromQL since, so they are returned as warnings
+// and left for Coralogix to validate.
+func parsePromql(query string, allowVariables bool) ([]string, error) {
+	tokens, err := lexPromql(query, allowVariables)
+	if err != nil {
+		return nil, err
+	}
+	p := &promqlParser{query: query, tokens: tokens, allowVariables: allowVariables}
+	if p.peek().kind == promqlEOF {
+		return nil, p.errorf(p.peek(), "empty query")
+	}
+	if err = p.parseExpr(0); err != nil {
+		return p.warnings, err
+	}
+	if t := p.peek(); t.kind != promqlEOF {
+		return p.warnings, p.errorf(t, "unexpected %q", t.text)
+	}
+	return p.warnings, nil
+}
+
+func lexPromql(query string, allowVariables bool) ([]promqlToken, error) {
+	var tokens []promqlToken
+	for i := 0; i < len(query); {
+		c := query[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '#':
+			for i < len(query) && query[i] != '\n' {
+				i++
+			}
+		case allowVariables && dashboardVariableRegex.MatchString(query[i:]):
+			variable := dashboardVariableRegex.FindString(query[i:])
+			tokens = append(tokens, promqlToken{kind: promqlVariable, text: variable, offset: i})
+			i += len(variable)
+		case c == '"' || c == '\'' || c == '`':
+			end := i + 1
+			for ; end < len(query) && query[end] != c; end++ {
+				if query[end] == '\\' && c != '`' {
+					end++
+				}
+			}
+			if end >= len(query) {
+				return nil, &querySyntaxError{language: "PromQL", query: query, offset: i, message: "unterminated string"}
+			}
+			tokens = append(tokens, promqlToken{kind: promqlString, text: query[i : end+1], offset: i})
+			i = end + 1
+		case isDigit(c) || (c == '.' && i+1 < len(query) && isDigit(query[i+1])):
+			end := i
+			for end < len(query) && (isIdentifierChar(query[end]) || query[end] == '.' ||
+				((query[end] == '+' || query[end] == '-') && (query[end-1] == 'e' || query[end-1] == 'E') && !strings.HasPrefix(strings.ToLower(query[i:]), "0x"))) {
+				end++
+			}
+			text := query[i:end]
+			switch {
+			case promqlNumberRegex.MatchString(text):
+				tokens = append(tokens, promqlToken{kind: promqlNumber, text: text, offset: i})
+			case promqlDurationRegex.MatchString(text):
+				tokens = append(tokens, promqlToken{kind: promqlDuration, text: text, offset: i})
+			default:
+				return nil, &querySyntaxError{language: "PromQL", query: query, offset: i, message: fmt.Sprintf("bad number or duration %q", text)}
+			}
+			i = end
+		case isIdentifierStart(c):
+			end := i
+			for end < len(query) && (isIdentifierChar(query[end]) || query[end] == ':') {
+				end++
+			}
+			tokens = append(tokens, promqlToken{kind: promqlIdentifier, text: query[i:end], offset: i})
+			i = end
+		case strings.ContainsRune("(){}[],@:", rune(c)):
+			tokens = append(tokens, promqlToken{kind: promqlPunctuation, text: string(c), offset: i})
+			i++
+		default:
+			operator := ""
+			for _, op := range []string{"==", "!=", "=~", "!~", "<=", ">=", "+", "-", "*", "/", "%", "^", "<", ">", "="} {
+				if strings.HasPrefix(query[i:], op) {
+					operator = op
+					break
+				}
+			}
+			if operator == "" {
+				r, _ := utf8.DecodeRuneInString(query[i:])
+				return nil, &querySyntaxError{language: "PromQL", query: query, offset: i, message: fmt.Sprintf("unexpected character %q", r)}
+			}
+			tokens = append(tokens, promqlToken{kind: promqlOperator, text: operator, offset: i})
+			i += len(operator)
+		}
+	}
+	return append(tokens, promqlToken{kind: promqlEOF, text: "end of query", offset: len(query)}), nil
+}
+
+func (p *promqlParser) peek() promqlToken {
+	return p.tokens[p.pos]
+}
+
+func (p *promqlParser) next() promqlToken {
+	t := p.tokens[p.pos]
+	if t.kind != promqlEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *promqlParser) is(kind promqlTokenKind, text string) bool {
+	t := p.peek()
+	return t.kind == kind && t.text == text
+}
+
+func (p *promqlParser) expect(kind promqlTokenKind, text string) error {
+	if t := p.next(); t.kind != kind || t.text != text {
+		return p.errorf(t, "expected %q, got %q", text, t.text)
+	}
+	return nil
+}
+
+func (p *promqlParser) errorf(t promqlToken, format string, args ...interface{}) error {
+	return &querySyntaxError{language: "PromQL", query: p.query, offset: t.offset, message: fmt.Sprintf(format, args...)}
+}
+
+func (p *promqlParser) warnf(t promqlToken, format string, args ...interface{}) {
+	location := (&querySyntaxError{query: p.query, offset: t.offset}).location()
+	p.warnings = append(p.warnings, fmt.Sprintf("PromQL at %s: %s", location, fmt.Sprintf(format, args...)))
+}
+
+func (p *promqlParser) binaryOperator() (string, int, bool) {
+	t := p.peek()
+	if t.kind != promqlOperator && t.kind != promqlIdentifier {
+		return "", 0, false
+	}
+	op := t.text
+	if t.kind == promqlIdentifier {
+		op = strings.ToLower(op)
+		if op != "and" && op != "or" && op != "unless" && op != "atan2" {
+			return "", 0, false
+		}
+	}
+	precedence, ok := promqlBinaryOperatorsPrecedence[op]
+	return op, precedence, ok
+}
+
+func (p *promqlParser) parseExpr(minPrecedence int) error {
+	if err := p.parseUnary(); err != nil {
+		return err
+	}
+	for {
+		op, precedence, ok := p.binaryOperator()
+		if !ok || precedence < minPrecedence {
+			return nil
+		}
+		p.next()
+		if precedence == 3 && p.is(promqlIdentifier, "bool") {
+			p.next()
+		}
+		if p.is(promqlIdentifier, "on") || p.is(promqlIdentifier, "ignoring") {
+			p.next()
+			if err := p.parseLabelList(); err != nil {
+				return err
+			}
+			if p.is(promqlIdentifier, "group_left") || p.is(promqlIdentifier, "group_right") {
+				p.next()
+				if p.is(promqlPunctuation, "(") {
+					if err := p.parseLabelList(); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		nextPrecedence := precedence + 1
+		if op == "^" {
+			nextPrecedence = precedence
+		}
+		if err := p.parseExpr(nextPrecedence); err != nil {
+			return err
+		}
+	}
+}
+
+func (p *promqlParser) parseUnary() error {
+	if p.is(promqlOperator, "-") || p.is(promqlOperator, "+") {
+		p.next()
+		return p.parseUnary()
+	}
+	isSelector, err := p.parsePrimary()
+	if err != nil {
+		return err
+	}
+	return p.parsePostfix(isSelector)
+}
+
+func (p *promqlParser) parsePrimary() (bool, error) {
+	t := p.next()
+	switch t.kind {
+	case promqlNumber, promqlString, promqlVariable:
+		return false, nil
+	case promqlPunctuation:
+		switch t.text {
+		case "(":
+			if err := p.parseExpr(0); err != nil {
+				return false, err
+			}
+			return false, p.expect(promqlPunctuation, ")")
+		case "{":
+			p.pos--
+			return true, p.parseMatchers()
+		}
+	case promqlIdentifier:
+		name := strings.ToLower(t.text)
+		switch {
+		case name == "inf" || name == "nan":
+			return false, nil
+		case promqlAggregators[name] && (p.is(promqlPunctuation, "(") || p.is(promqlIdentifier, "by") || p.is(promqlIdentifier, "without")):
+			return false, p.parseAggregation()
+		case p.is(promqlPunctuation, "("):
+			if !promqlFunctions[t.text] {
+				p.warnf(t, "unknown function %q, it will be validated by Coralogix", t.text)
+			}
+			return false, p.parseArguments()
+		}
+		if p.is(promqlPunctuation, "{") {
+			return true, p.parseMatchers()
+		}
+		return true, nil
+	}
+	return false, p.errorf(t, "unexpected %q", t.text)
+}
+
+func (p *promqlParser) parseAggregation() error {
+	grouped := false
+	if p.is(promqlIdentifier, "by") || p.is(promqlIdentifier, "without") {
+		p.next()
+		if err := p.parseLabelList(); err != nil {
+			return err
+		}
+		grouped = true
+	}
+	if !p.is(promqlPunctuation, "(") {
+		return p.errorf(p.peek(), "expected \"(\" after aggregation, got %q", p.peek().text)
+	}
+	if p.is(promqlPunctuation, "(") && p.tokens[p.pos+1].kind == promqlPunctuation && p.tokens[p.pos+1].text == ")" {
+		return p.errorf(p.tokens[p.pos+1], "aggregation without an expression")
+	}
+	if err := p.parseArguments(); err != nil {
+		return err
+	}
+	if !grouped && (p.is(promqlIdentifier, "by") || p.is(promqlIdentifier, "without")) {
+		p.next()
+		return p.parseLabelList()
+	}
+	return nil
+}
+
+func (p *promqlParser) parseArguments() error {
+	if err := p.expect(promqlPunctuation, "("); err != nil {
+		return err
+	}
+	if p.is(promqlPunctuation, ")") {
+		p.next()
+		return nil
+	}
+	for {
+		if err := p.parseExpr(0); err != nil {
+			return err
+		}
+		if p.is(promqlPunctuation, ",") {
+			p.next()
+			continue
+		}
+		return p.expect(promqlPunctuation, ")")
+	}
+}
+
+func (p *promqlParser) parseLabelList() error {
+	if err := p.expect(promqlPunctuation, "("); err != nil {
+		return err
+	}
+	for !p.is(promqlPunctuation, ")") {
+		if t := p.next(); t.kind != promqlIdentifier && t.kind != promqlString && t.kind != promqlVariable {
+			return p.errorf(t, "expected label name, got %q", t.text)
+		}
+		if !p.is(promqlPunctuation, ",") {
+			break
+		}
+		p.next()
+	}
+	return p.expect(promqlPunctuation, ")")
+}
+
+func (p *promqlParser) parseMatchers() error {
+	if err := p.expect(promqlPunctuation, "{"); err != nil {
+		return err
+	}
+	for !p.is(promqlPunctuation, "}") {
+		if t := p.next(); t.kind != promqlIdentifier && t.kind != promqlString && t.kind != promqlVariable {
+			return p.errorf(t, "expected label name, got %q", t.text)
+		}
+		operator := p.next()
+		if operator.kind != promqlOperator || (operator.text != "=" && operator.text != "!=" && operator.text != "=~" && operator.text != "!~") {
+			return p.errorf(operator, "expected label matching operator, got %q", operator.text)
+		}
+		value := p.next()
+		if value.kind != promqlString && value.kind != promqlVariable {
+			return p.errorf(value, "expected label value string, got %q", value.text)
+		}
+		if value.kind == promqlString && (operator.text == "=~" || operator.text == "!~") {
+			if err := p.checkRegex(value); err != nil {
+				return err
+			}
+		}
+		if !p.is(promqlPunctuation, ",") {
+			break
+		}
+		p.next()
+	}
+	return p.expect(promqlPunctuation, "}")
+}
+
+func (p *promqlParser) checkRegex(t promqlToken) error {
+	value, err := unquotePromqlString(t.text)
+	if err != nil {
+		return p.errorf(t, "invalid string %s - %s", t.text, err)
+	}
+	if p.allowVariables && (strings.Contains(value, "$") || strings.Contains(value, "{{")) {
+		return nil
+	}
+	if _, err = regexp.Compile("^(?:" + value + ")$"); err != nil {
+		return p.errorf(t, "invalid regular expression %s - %s", t.text, err)
+	}
+	return nil
+}
+
+func (p *promqlParser) parsePostfix(isSelector bool) error {
+	for {
+		switch {
+		case p.is(promqlPunctuation, "["):
+			open := p.next()
+			if err := p.expectDuration(); err != nil {
+				return err
+			}
+			if p.is(promqlPunctuation, ":") {
+				p.next()
+				if p.peek().kind == promqlDuration || p.peek().kind == promqlVariable {
+					p.next()
+				}
+			} else if !isSelector {
+				return p.errorf(open, "ranges are only allowed for vector selectors, use a subquery ([range:resolution]) instead")
+			}
+			if err := p.expect(promqlPunctuation, "]"); err != nil {
+				return err
+			}
+			isSelector = false
+		case p.is(promqlIdentifier, "offset"):
+			p.next()
+			if p.is(promqlOperator, "-") {
+				p.next()
+			}
+			if err := p.expectDuration(); err != nil {
+				return err
+			}
+		case p.is(promqlPunctuation, "@"):
+			p.next()
+			t := p.next()
+			if t.kind == promqlIdentifier && (t.text == "start" || t.text == "end") {
+				if err := p.expect(promqlPunctuation, "("); err != nil {
+					return err
+				}
+				if err := p.expect(promqlPunctuation, ")"); err != nil {
+					return err
+				}
+			} else if t.kind != promqlNumber && t.kind != promqlVariable {
+				return p.errorf(t, "expected timestamp, start() or end() after @, got %q", t.text)
+			}
+		default:
+			return nil
+		}
+	}
+}
+
+func (p *promqlParser) expectDuration() error {
+	t := p.next()
+	if t.kind == promqlDuration || t.kind == promqlVariable {
+		return nil
+	}
+	return p.errorf(t, "expected duration, got %q", t.text)
+}
+
+func unquotePromqlString(s string) (string, error) {
+	switch s[0] {
+	case '`':
+		return s[1 : len(s)-1], nil
+	case '\'':
+		body := strings.ReplaceAll(s[1:len(s)-1], `\'`, `'`)
+		body = strings.ReplaceAll(body, `"`, `\"`)
+		return strconv.Unquote(`"` + body + `"`)
+	}
+	return strconv.Unquote(s)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentifierStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentifierChar(c byte) bool {
+	return isIdentifierStart(c) || isDigit(c)
+}
+
+type luceneParser struct {
+	query string
+	pos   int
+}
+
+// parseLucene checks the syntax of a Lucene query: balanced groups, terminated phrases, ranges and regexes,
+// boolean operators with operands on both sides and fields with values. An empty query is valid.
+func parseLucene(query string) error {
+	p := &luceneParser{query: query}
+	if err := p.parseClauses(false); err != nil {
+		return err
+	}
+	if p.pos < len(p.query) {
+		return p.errorf(p.pos, "unexpected %q", p.query[p.pos:p.pos+1])
+	}
+	return nil
+}
+
+func (p *luceneParser) errorf(offset int, format string, args ...interface{}) error {
+	return &querySyntaxError{language: "Lucene", query: p.query, offset: offset, message: fmt.Sprintf(format, args...)}
+}
+
+func (p *luceneParser) skipSpaces() {
+	for p.pos < len(p.query) && strings.ContainsRune(" \t\r\n", rune(p.query[p.pos])) {
+		p.pos++
+	}
+}
+
+// operator returns the boolean operator at the current position, if any.
+func (p *luceneParser) operator() string {
+	for _, op := range []string{"&&", "||"} {
+		if strings.HasPrefix(p.query[p.pos:], op) {
+			return op
+		}
+	}
+	for _, op := range []string{"AND", "OR", "NOT"} {
+		end := p.pos + len(op)
+		if strings.HasPrefix(p.query[p.pos:], op) && (end == len(p.query) || !isLuceneTermChar(p.query[end])) {
+			return op
+		}
+	}
+	return ""
+}
+
+func (p *luceneParser) parseClauses(nested bool) error {
+	clauses := 0
+	pendingOperator, pendingOffset := "", 0
+	for {
+		p.skipSpaces()
+		if p.pos >= len(p.query) || (nested && p.query[p.pos] == ')') {
+			break
+		}
+		if op := p.operator(); op == "AND" || op == "OR" || op == "&&" || op == "||" {
+			if clauses == 0 || pendingOperator != "" {
+				return p.errorf(p.pos, "%s is missing a left operand", op)
+			}
+			pendingOperator, pendingOffset = op, p.pos
+			p.pos += len(op)
+			continue
+		}
+		if err := p.parseClause(); err != nil {
+			return err
+		}
+		clauses++
+		pendingOperator = ""
+	}
+	if pendingOperator != "" {
+		return p.errorf(pendingOffset, "%s is missing a right operand", pendingOperator)
+	}
+	if nested && clauses == 0 {
+		return p.errorf(p.pos, "empty group")
+	}
+	return nil
+}
+
+func (p *luceneParser) parseClause() error {
+	p.skipSpaces()
+	if op := p.operator(); op == "NOT" {
+		start := p.pos
+		p.pos += len(op)
+		p.skipSpaces()
+		if p.pos >= len(p.query) || p.query[p.pos] == ')' || luceneReservedOperators[p.operator()] && p.operator() != "NOT" {
+			return p.errorf(start, "NOT is missing an operand")
+		}
+		return p.parseClause()
+	}
+	if c := p.query[p.pos]; c == '+' || c == '-' || c == '!' {
+		start := p.pos
+		p.pos++
+		if p.pos >= len(p.query) || strings.ContainsRune(" \t\r\n)", rune(p.query[p.pos])) {
+			return p.errorf(start, "%q is missing an operand", c)
+		}
+		return p.parseClause()
+	}
+
+	if p.query[p.pos] != '(' && p.query[p.pos] != '"' && p.query[p.pos] != '[' && p.query[p.pos] != '{' && p.query[p.pos] != '/' {
+		termStart := p.pos
+		term, err := p.parseTerm()
+		if err != nil {
+			return err
+		}
+		if p.pos < len(p.query) && p.query[p.pos] == ':' {
+			if term == "" {
+				return p.errorf(termStart, "missing field name")
+			}
+			colon := p.pos
+			p.pos++
+			p.skipSpaces()
+			if p.pos >= len(p.query) || p.query[p.pos] == ')' || luceneReservedOperators[p.operator()] {
+				return p.errorf(colon, "field %q is missing a value", term)
+			}
+			for _, op := range []string{">=", "<=", ">", "<"} {
+				if strings.HasPrefix(p.query[p.pos:], op) {
+					p.pos += len(op)
+					if _, err = p.parseTerm(); err != nil {
+						return err
+					}
+					return p.parseModifiers()
+				}
+			}
+			return p.parseValue()
+		}
+		if term == "" {
+			return p.errorf(termStart, "unexpected %q", p.query[termStart:termStart+1])
+		}
+		return p.parseModifiers()
+	}
+	return p.parseValue()
+}
+
+// parseValue parses a group, a phrase, a range, a regex or a term, with its boost and fuzziness modifiers.
+func (p *luceneParser) parseValue() error {
+	start := p.pos
+	switch p.query[p.pos] {
+	case '(':
+		p.pos++
+		if err := p.parseClauses(true); err != nil {
+			return err
+		}
+		if p.pos >= len(p.query) {
+			return p.errorf(start, "unbalanced parenthesis")
+		}
+		p.pos++
+	case '"', '/':
+		if err := p.parseDelimited(p.query[p.pos]); err != nil {
+			return err
+		}
+	case '[', '{':
+		if err := p.parseRange(); err != nil {
+			return err
+		}
+	default:
+		term, err := p.parseTerm()
+		if err != nil {
+			return err
+		}
+		if term == "" {
+			return p.errorf(start, "unexpected %q", p.query[start:start+1])
+		}
+	}
+	return p.parseModifiers()
+}
+
+func (p *luceneParser) parseTerm() (string, error) {
+	start := p.pos
+	for p.pos < len(p.query) && isLuceneTermChar(p.query[p.pos]) {
+		if p.query[p.pos] == '\\' {
+			if p.pos+1 >= len(p.query) {
+				return "", p.errorf(p.pos, "escape character at the end of the query")
+			}
+			p.pos++
+		}
+		p.pos++
+	}
+	return p.query[start:p.pos], nil
+}
+
+func (p *luceneParser) parseDelimited(delimiter byte) error {
+	start := p.pos
+	kind := "phrase"
+	if delimiter == '/' {
+		kind = "regular expression"
+	}
+	for p.pos++; p.pos < len(p.query); p.pos++ {
+		switch p.query[p.pos] {
+		case '\\':
+			p.pos++
+		case delimiter:
+			p.pos++
+			if delimiter == '/' {
+				if _, err := regexp.Compile(p.query[start+1 : p.pos-1]); err != nil {
+					return p.errorf(start, "invalid regular expression - %s", err)
+				}
+			}
+			return nil
+		}
+	}
+	return p.errorf(start, "unterminated %s", kind)
+}
+
+func (p *luceneParser) parseRange() error {
+	start := p.pos
+	end := start + 1
+	for ; end < len(p.query) && p.query[end] != ']' && p.query[end] != '}'; end++ {
+		if p.query[end] == '\\' {
+			end++
+		}
+	}
+	if end >= len(p.query) {
+		return p.errorf(start, "unterminated range")
+	}
+	bounds := luceneRangeSeparatorRegex.Split(strings.TrimSpace(p.query[start+1:end]), -1)
+	if len(bounds) != 2 || bounds[0] == "" || bounds[1] == "" {
+		return p.errorf(start, "range must have the form [lower TO upper]")
+	}
+	p.pos = end + 1
+	return nil
+}
+
+func (p *luceneParser) parseModifiers() error {
+	for p.pos < len(p.query) && (p.query[p.pos] == '^' || p.query[p.pos] == '~') {
+		modifier := p.query[p.pos]
+		start := p.pos
+		p.pos++
+		numberStart := p.pos
+		for p.pos < len(p.query) && (isDigit(p.query[p.pos]) || p.query[p.pos] == '.') {
+			p.pos++
+		}
+		number := p.query[numberStart:p.pos]
+		if number == "" {
+			if modifier == '^' {
+				return p.errorf(start, "boost is missing a number")
+			}
+			continue
+		}
+		if _, err := strconv.ParseFloat(number, 64); err != nil {
+			return p.errorf(numberStart, "invalid number %q", number)
+		}
+	}
+	return nil
+}
+
+func isLuceneTermChar(c byte) bool {
+	return !strings.ContainsRune(" \t\r\n()[]{}\":^~", rune(c))
+}
@@ -0,0 +1,177 @@
+package coralogix
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParsePromql(t *testing.T) {
+	valid := []string{
+		`up`,
+		`http_requests_total{job="apiserver", handler="/api/comments"}`,
+		`http_requests_total{job=~".*server", status!~"4.."}`,
+		`http_requests_total{environment=~"staging|testing|development",method!="GET"}`,
+		`{__name__=~"job:.*"}`,
+		`http_requests_total{job="prometheus"}[5m]`,
+		`http_requests_total offset 5m`,
+		`sum(http_requests_total{method="GET"} offset 5m)`,
+		`rate(http_requests_total[5m] offset 1w)`,
+		`http_requests_total @ 1609746000`,
+		`rate(http_requests_total[5m] @ end())`,
+		`rate(http_requests_total[5m])[30m:1m]`,
+		`max_over_time(deriv(rate(distance_covered_total[5s])[30s:5s])[10m:])`,
+		`sum by (job) (rate(http_requests_total[5m]))`,
+		`sum(rate(http_requests_total[5m])) by (job)`,
+		`sum without (instance) (http_requests_total)`,
+		`topk(3, sum by (app, proc) (rate(instance_cpu_time_ns[5m])))`,
+		`count_values("version", build_version)`,
+		`quantile(0.9, http_request_duration_seconds)`,
+		`histogram_quantile(0.9, sum by (le) (rate(http_request_duration_seconds_bucket[10m])))`,
+		`(instance_memory_limit_bytes - instance_memory_usage_bytes) / 1024 / 1024`,
+		`method_code:http_errors:rate5m{code="500"} / ignoring(code) method:http_requests:rate5m`,
+		`method_code:http_errors:rate5m / ignoring(code) group_left method:http_requests:rate5m`,
+		`a * on(instance) group_right(job) b`,
+		`up == bool 1`,
+		`foo and bar or baz unless qux`,
+		`-rate(x[1m]) ^ 2`,
+		`2 ^ 3 ^ 2`,
+		`1e3 + 0x1F - .5`,
+		`label_replace(up{job="api-server",service="a:c"}, "foo", "$1", "service", "(.*):.*")`,
+		`vector(1)`,
+		`time() - process_start_time_seconds > 3600`,
+		`absent(nonexistent{job="myjob"})`,
+		`sum(rate(http_requests_total[1h30m]))`,
+		"sum(rate(x[5m])) # a comment",
+		"{job=`raw string`}",
+	}
+	for _, query := range valid {
+		t.Run(query, func(t *testing.T) {
+			if warnings, err := parsePromql(query, false); err != nil || len(warnings) != 0 {
+				t.Errorf("got %s and warnings %q, want no error", err, warnings)
+			}
+		})
+	}
+
+	invalid := []struct {
+		query string
+		err   string
+	}{
+		{query: ``, err: "empty query"},
+		{query: `sum(rate(x[5m])`, err: "column 16"},
+		{query: `rate(x[5m]))`, err: `unexpected ")"`},
+		{query: `foo{job="api"`, err: "column 14"},
+		{query: `foo{job="api}`, err: "unterminated string"},
+		{query: `foo{job=~"("}`, err: "column 10"},
+		{query: `foo[5]`, err: "column 5"},
+		{query: `rate(x[5x])`, err: "bad number or duration"},
+		{query: `foo +`, err: "column 6"},
+		{query: `* foo`, err: "column 1"},
+		{query: `sum by job (x)`, err: "column 8"},
+		{query: `topk(3 x)`, err: "column 8"},
+		{query: `foo ! bar`, err: "unexpected character"},
+		{query: `foo{job="a",,}`, err: "column 13"},
+		{query: "sum(x)\n  by (job) (y)", err: "line 2"},
+		{query: `up{job=$job}`, err: "unexpected character"},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.query, func(t *testing.T) {
+			_, err := parsePromql(tt.query, false)
+			if err == nil {
+				t.Fatalf("got no error, want %q", tt.err)
+			}
+			if !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("got %q, want it to contain %q", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestParsePromqlUnknownFunction(t *testing.T) {
+	// Functions added to PromQL after promqlFunctions was written are left for Coralogix to validate.
+	warnings, err := parsePromql(`sum(sumz(rate(x[5m])))`, false)
+	if err != nil {
+		t.Fatalf("got %s, want no error", err)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], `unknown function "sumz"`) || !strings.Contains(warnings[0], "column 5") {
+		t.Errorf("got warnings %q, want one for sumz at column 5", warnings)
+	}
+
+	if _, err = parsePromql(`sumz(rate(x[5m])`, false); err == nil {
+		t.Errorf("got no error, want the syntax error of an unknown function to be reported")
+	}
+}
+
+func TestParseDashboardPromql(t *testing.T) {
+	valid := []string{
+		`up{job="$job"}`,
+		`up{job=$job}`,
+		`sum by (${group}) (rate(x[$__interval]))`,
+		`rate(x{namespace={{namespace}}}[5m])`,
+	}
+	for _, query := range valid {
+		t.Run(query, func(t *testing.T) {
+			if _, err := parsePromql(query, true); err != nil {
+				t.Errorf("got %s, want no error", err)
+			}
+		})
+	}
+
+	if _, err := parsePromql(`up{job=$}`, true); err == nil {
+		t.Errorf("%s: got no error, want an unexpected character", `up{job=$}`)
+	}
+}
+
+func TestParseLucene(t *testing.T) {
+	valid := []string{
+		``,
+		`error`,
+		`"connection refused"`,
+		`status:500`,
+		`status:[500 TO 599]`,
+		`duration:{100 TO *}`,
+		`level:error AND NOT service:checkout`,
+		`level:(error OR critical) && env:prod`,
+		`message:/time(d)?out/`,
+		`path:\/api\/v1*`,
+		`user:jo?n~2 title:"quick fox"~5 boost^2`,
+		`+required -excluded optional`,
+		`coralogix.metadata.applicationName:"nginx"`,
+		`_exists_:trace_id`,
+		`kubernetes.labels.app\:name:api`,
+	}
+	for _, query := range valid {
+		t.Run(query, func(t *testing.T) {
+			if err := parseLucene(query); err != nil {
+				t.Errorf("got %s, want no error", err)
+			}
+		})
+	}
+
+	invalid := []struct {
+		query string
+		err   string
+	}{
+		{query: `level:(error OR critical`, err: "column 7"},
+		{query: `level:error)`, err: "column 12"},
+		{query: `"connection refused`, err: "column 1"},
+		{query: `status:[500 TO 599`, err: "column 8"},
+		{query: `status:[500 599]`, err: "column 8"},
+		{query: `message:/time(d)?out`, err: "column 9"},
+		{query: `AND level:error`, err: "column 1"},
+		{query: `level:error OR`, err: "OR is missing a right operand"},
+		{query: `level:error AND OR env:prod`, err: "column 17"},
+		{query: `level:`, err: `field "level" is missing a value`},
+		{query: `boost^x`, err: "column 6"},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.query, func(t *testing.T) {
+			err := parseLucene(tt.query)
+			if err == nil {
+				t.Fatalf("got no error, want %q", tt.err)
+			}
+			if !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("got %q, want it to contain %q", err, tt.err)
+			}
+		})
+	}
+}
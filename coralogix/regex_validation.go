@@ -0,0 +1,727 @@
+package coralogix
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+var (
+	rulesRegexGroupNameRegex      = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]{0,31}$`)
+	rulesRegexCountedRepeatRegex  = regexp.MustCompile(`^\{(\d+)(?:(,)(\d*))?\}`)
+	rulesRegexReplacementRefRegex = regexp.MustCompile(`\$(?:(\$)|(\d+)|\{(\w+)\})`)
+	rulesRegexPosixClasses        = map[string]bool{
+		"alnum": true, "alpha": true, "ascii": true, "blank": true, "cntrl": true, "digit": true, "graph": true,
+		"lower": true, "print": true, "punct": true, "space": true, "upper": true, "word": true, "xdigit": true,
+	}
+	// rulesRegexEscapes holds the letters which may follow a backslash, and what RE2 does differently with them
+	// (empty when RE2 handles them the same way).
+	rulesRegexEscapes = map[byte]string{
+		'a': "", 'A': "", 'b': "", 'B': "", 'd': "", 'D': "", 'f': "", 'n': "", 'r': "", 's': "", 'S': "", 't': "",
+		'w': "", 'W': "", 'z': "", 'E': "", 'Q': "", 'x': "", 'p': "", 'P': "",
+		'c': `the \c control character escape, which RE2 doesn't support`,
+		'C': `the \C single code unit escape, which RE2 doesn't support`,
+		'e': `the \e escape character escape, which RE2 doesn't support`,
+		'G': `the \G anchor, which RE2 doesn't support`,
+		'h': `the \h horizontal whitespace class, which RE2 doesn't support`,
+		'H': `the \H class, which RE2 doesn't support`,
+		'K': `the \K match reset, which RE2 doesn't support`,
+		'N': `the \N class, which RE2 doesn't support`,
+		'R': `the \R newline sequence, which RE2 doesn't support`,
+		'v': `the \v escape, which is vertical whitespace for Coralogix but only a vertical tab for RE2`,
+		'V': `the \V class, which RE2 doesn't support`,
+		'X': `the \X extended grapheme cluster, which RE2 doesn't support`,
+		'Z': `the \Z anchor, which RE2 doesn't support`,
+		'g': "backreferences or subroutine calls, which RE2 doesn't support",
+		'k': "backreferences, which RE2 doesn't support",
+	}
+	// rulesRegexFlags holds the inline flags the rules engine accepts, and what RE2 does differently with them.
+	rulesRegexFlags = map[byte]string{
+		'i': "", 'm': "", 's': "", 'U': "",
+		'x': "the x (extended) flag, which RE2 doesn't support",
+		'n': "the n (no auto capture) flag, which RE2 doesn't support",
+		'J': "the J (duplicate names) flag, which RE2 doesn't support",
+	}
+)
+
+// rulesRegex is a regular expression parsed in the dialect of the Coralogix rules engine, which is PCRE-like and
+// differs from the RE2 syntax Go (and many regex testers) use.
+type rulesRegex struct {
+	// groups is the number of capture groups, named ones included.
+	groups     int
+	groupNames []string
+	// re2Differences describes the constructs RE2 either rejects or evaluates differently.
+	re2Differences []string
+}
+
+type rulesRegexReference struct {
+	offset int
+	number int
+	name   string
+}
+
+type rulesRegexParser struct {
+	expression  string
+	pos         int
+	extended    bool
+	regex       *rulesRegex
+	differences map[string]bool
+	references  []rulesRegexReference
+}
+
+// parseRulesRegex checks the syntax of a rule regular expression in the dialect of the Coralogix rules engine.
+func parseRulesRegex(expression string) (*rulesRegex, error) {
+	p := &rulesRegexParser{expression: expression, regex: &rulesRegex{}, differences: make(map[string]bool)}
+	if err := p.parse(); err != nil {
+		return nil, err
+	}
+	if err := p.checkReferences(); err != nil {
+		return nil, err
+	}
+	p.regex.re2Differences = sortedKeys(p.differences)
+	return p.regex, nil
+}
+
+func (p *rulesRegexParser) errorf(offset int, format string, args ...interface{}) error {
+	return &querySyntaxError{language: "Regular expression", query: p.expression, offset: offset, message: fmt.Sprintf(format, args...)}
+}
+
+func (p *rulesRegexParser) parse() error {
+	var openGroups []int
+	canRepeat := false
+	for p.pos < len(p.expression) {
+		start := p.pos
+		switch c := p.expression[p.pos]; {
+		case p.extended && (c == ' ' || c == '\t' || c == '\n' || c == '\r'):
+			p.pos++
+		case p.extended && c == '#':
+			for p.pos < len(p.expression) && p.expression[p.pos] != '\n' {
+				p.pos++
+			}
+		case c == '\\':
+			if err := p.parseEscape(); err != nil {
+				return err
+			}
+			canRepeat = true
+		case c == '[':
+			if err := p.parseClass(); err != nil {
+				return err
+			}
+			canRepeat = true
+		case c == '(':
+			opensGroup, isAtom, err := p.parseGroupStart()
+			if err != nil {
+				return err
+			}
+			if opensGroup {
+				openGroups = append(openGroups, start)
+				canRepeat = false
+			} else if isAtom {
+				canRepeat = true
+			}
+		case c == ')':
+			if len(openGroups) == 0 {
+				return p.errorf(start, "unmatched closing parenthesis")
+			}
+			openGroups = openGroups[:len(openGroups)-1]
+			p.pos++
+			canRepeat = true
+		case c == '|':
+			p.pos++
+			canRepeat = false
+		case c == '*' || c == '+' || c == '?':
+			if !canRepeat {
+				return p.errorf(start, "quantifier %q doesn't follow a repeatable item", c)
+			}
+			p.pos++
+			p.parseQuantifierSuffix()
+			canRepeat = false
+		case c == '{':
+			match := rulesRegexCountedRepeatRegex.FindStringSubmatch(p.expression[p.pos:])
+			if match == nil {
+				// A brace which doesn't start a valid quantifier is a literal.
+				p.pos++
+				canRepeat = true
+				continue
+			}
+			if !canRepeat {
+				return p.errorf(start, "quantifier %q doesn't follow a repeatable item", match[0])
+			}
+			if err := p.checkCountedRepeat(start, match); err != nil {
+				return err
+			}
+			p.pos += len(match[0])
+			p.parseQuantifierSuffix()
+			canRepeat = false
+		default:
+			_, size := utf8.DecodeRuneInString(p.expression[p.pos:])
+			p.pos += size
+			canRepeat = true
+		}
+	}
+	if len(openGroups) > 0 {
+		return p.errorf(openGroups[len(openGroups)-1], "missing closing parenthesis")
+	}
+	return nil
+}
+
+func (p *rulesRegexParser) parseQuantifierSuffix() {
+	if p.pos >= len(p.expression) {
+		return
+	}
+	switch p.expression[p.pos] {
+	case '?':
+		p.pos++
+	case '+':
+		p.differences["possessive quantifiers, which RE2 doesn't support"] = true
+		p.pos++
+	}
+}
+
+func (p *rulesRegexParser) checkCountedRepeat(offset int, match []string) error {
+	min, err := strconv.Atoi(match[1])
+	if err != nil || min > 65535 {
+		return p.errorf(offset, "number too big in %q quantifier", match[0])
+	}
+	max := min
+	if match[2] != "" && match[3] != "" {
+		if max, err = strconv.Atoi(match[3]); err != nil || max > 65535 {
+			return p.errorf(offset, "number too big in %q quantifier", match[0])
+		}
+		if max < min {
+			return p.errorf(offset, "numbers out of order in %q quantifier", match[0])
+		}
+	}
+	if max > 1000 {
+		p.differences["repetition counts above 1000, which RE2 doesn't support"] = true
+	}
+	return nil
+}
+
+// parseGroupStart parses the opening of a parenthesized construct. It returns whether the construct is a group which
+// has to be closed, or otherwise whether it's a complete item a quantifier may follow (e.g. a backreference).
+func (p *rulesRegexParser) parseGroupStart() (opensGroup, isAtom bool, err error) {
+	start := p.pos
+	rest := p.expression[p.pos+1:]
+	switch {
+	case strings.HasPrefix(rest, "*"):
+		end := strings.IndexByte(rest, ')')
+		if end < 2 || !isUpperLetter(rest[1]) {
+			return false, false, p.errorf(start+1, "quantifier %q doesn't follow a repeatable item", '*')
+		}
+		p.differences["backtracking control verbs, which RE2 doesn't support"] = true
+		p.pos += end + 2
+		return false, false, nil
+	case !strings.HasPrefix(rest, "?"):
+		p.regex.groups++
+		p.pos++
+		return true, false, nil
+	}
+
+	rest = rest[1:]
+	p.pos += 2
+	switch {
+	case strings.HasPrefix(rest, "#"):
+		end := strings.IndexByte(rest, ')')
+		if end < 0 {
+			return false, false, p.errorf(start, "missing closing parenthesis for comment")
+		}
+		p.differences["(?#...) comments, which RE2 doesn't support"] = true
+		p.pos += end + 1
+		return false, false, nil
+	case strings.HasPrefix(rest, ":"):
+		p.pos++
+		return true, false, nil
+	case strings.HasPrefix(rest, "|"):
+		p.differences["branch reset groups, which RE2 doesn't support"] = true
+		p.pos++
+		return true, false, nil
+	case strings.HasPrefix(rest, ">"):
+		p.differences["atomic groups, which RE2 doesn't support"] = true
+		p.pos++
+		return true, false, nil
+	case strings.HasPrefix(rest, "="), strings.HasPrefix(rest, "!"):
+		p.differences["lookahead assertions, which RE2 doesn't support"] = true
+		p.pos++
+		return true, false, nil
+	case strings.HasPrefix(rest, "<="), strings.HasPrefix(rest, "<!"):
+		p.differences["lookbehind assertions, which RE2 doesn't support"] = true
+		p.pos += 2
+		return true, false, nil
+	case strings.HasPrefix(rest, "P<"):
+		p.pos++
+		return true, false, p.parseNamedGroup('<', '>')
+	case strings.HasPrefix(rest, "<"):
+		return true, false, p.parseNamedGroup('<', '>')
+	case strings.HasPrefix(rest, "'"):
+		p.differences["named groups with the (?'name') syntax, which RE2 doesn't support"] = true
+		return true, false, p.parseNamedGroup('\'', '\'')
+	case strings.HasPrefix(rest, "P="):
+		p.differences["backreferences, which RE2 doesn't support"] = true
+		p.pos++
+		return false, true, p.parseReference('=', ')')
+	case strings.HasPrefix(rest, "P>"), strings.HasPrefix(rest, "&"):
+		p.differences["recursion and subroutine calls, which RE2 doesn't support"] = true
+		if rest[0] == 'P' {
+			p.pos++
+		}
+		return false, true, p.parseReference(p.expression[p.pos], ')')
+	case strings.HasPrefix(rest, "("):
+		p.differences["conditional groups, which RE2 doesn't support"] = true
+		if strings.HasPrefix(rest, "(?") {
+			// The condition is an assertion, which is parsed as a nested group.
+			return true, false, nil
+		}
+		end := strings.IndexByte(rest, ')')
+		if end < 0 {
+			return false, false, p.errorf(start, "missing closing parenthesis for condition")
+		}
+		p.pos += end + 1
+		return true, false, nil
+	}
+
+	end := 0
+	for end < len(rest) && (rest[end] == 'R' || rest[end] == '+' || rest[end] == '-' || isDigit(rest[end])) {
+		end++
+	}
+	if end > 0 && end < len(rest) && rest[end] == ')' && (rest[:end] == "R" || isDigit(rest[end-1])) {
+		p.differences["recursion and subroutine calls, which RE2 doesn't support"] = true
+		p.pos += end + 1
+		return false, true, nil
+	}
+
+	for i := 0; i < len(rest); i++ {
+		c := rest[i]
+		switch {
+		case c == ')' || c == ':':
+			p.pos += i + 1
+			return c == ':', false, nil
+		case c == '-' || c == '^':
+		default:
+			difference, ok := rulesRegexFlags[c]
+			if !ok {
+				return false, false, p.errorf(start, "unrecognized character %q after (?", c)
+			}
+			if difference != "" {
+				p.differences[difference] = true
+			}
+			if c == 'x' {
+				p.extended = true
+			}
+		}
+	}
+	return false, false, p.errorf(start, "missing closing parenthesis")
+}
+
+// parseNamedGroup parses the name of a named group, starting at the opening delimiter.
+func (p *rulesRegexParser) parseNamedGroup(open, close byte) error {
+	start := p.pos
+	end := strings.IndexByte(p.expression[p.pos+1:], close)
+	if p.expression[p.pos] != open || end < 0 {
+		return p.errorf(start, "missing %q after group name", close)
+	}
+	name := p.expression[p.pos+1 : p.pos+1+end]
+	if !rulesRegexGroupNameRegex.MatchString(name) {
+		return p.errorf(start, "invalid group name %q, group names have to start with a letter or an underscore, contain only letters, digits and underscores, and be up to 32 characters long", name)
+	}
+	if p.regex.hasGroup(name) {
+		return p.errorf(start, "duplicate group name %q", name)
+	}
+	p.regex.groups++
+	p.regex.groupNames = append(p.regex.groupNames, name)
+	p.pos += end + 2
+	return nil
+}
+
+// parseReference parses a reference to a group by name, starting at the opening delimiter.
+func (p *rulesRegexParser) parseReference(open, close byte) error {
+	start := p.pos
+	end := strings.IndexByte(p.expression[p.pos+1:], close)
+	if p.expression[p.pos] != open || end < 0 {
+		return p.errorf(start, "missing %q after group reference", close)
+	}
+	reference := p.expression[p.pos+1 : p.pos+1+end]
+	if number, err := strconv.Atoi(strings.TrimPrefix(reference, "+")); err == nil {
+		if number > 0 {
+			p.references = append(p.references, rulesRegexReference{offset: start, number: number})
+		}
+	} else if rulesRegexGroupNameRegex.MatchString(reference) {
+		p.references = append(p.references, rulesRegexReference{offset: start, name: reference})
+	} else {
+		return p.errorf(start, "invalid group reference %q", reference)
+	}
+	p.pos += end + 2
+	return nil
+}
+
+func (p *rulesRegexParser) parseEscape() error {
+	start := p.pos
+	if p.pos+1 >= len(p.expression) {
+		return p.errorf(start, "trailing backslash")
+	}
+	c := p.expression[p.pos+1]
+	p.pos += 2
+	switch {
+	case c >= '1' && c <= '9':
+		for p.pos < len(p.expression) && isDigit(p.expression[p.pos]) {
+			p.pos++
+		}
+		number, _ := strconv.Atoi(p.expression[start+1 : p.pos])
+		p.differences["backreferences, which RE2 doesn't support"] = true
+		p.references = append(p.references, rulesRegexReference{offset: start, number: number})
+		return nil
+	case c == 'k':
+		if p.pos >= len(p.expression) {
+			return p.errorf(start, `\k isn't followed by a group name`)
+		}
+		p.differences[rulesRegexEscapes[c]] = true
+		switch p.expression[p.pos] {
+		case '<':
+			return p.parseReference('<', '>')
+		case '\'':
+			return p.parseReference('\'', '\'')
+		case '{':
+			return p.parseReference('{', '}')
+		}
+		return p.errorf(start, `\k isn't followed by a group name`)
+	case c == 'g':
+		p.differences[rulesRegexEscapes[c]] = true
+		if p.pos < len(p.expression) {
+			switch p.expression[p.pos] {
+			case '{':
+				return p.parseReference('{', '}')
+			case '<':
+				return p.parseReference('<', '>')
+			case '\'':
+				return p.parseReference('\'', '\'')
+			}
+		}
+		end := p.pos
+		if end < len(p.expression) && (p.expression[end] == '-' || p.expression[end] == '+') {
+			end++
+		}
+		digits := end
+		for end < len(p.expression) && isDigit(p.expression[end]) {
+			end++
+		}
+		if end == digits {
+			return p.errorf(start, `\g isn't followed by a group number or name`)
+		}
+		p.pos = end
+		return nil
+	case c == 'Q':
+		end := strings.Index(p.expression[p.pos:], `\E`)
+		if end < 0 {
+			p.pos = len(p.expression)
+		} else {
+			p.pos += end + 2
+		}
+		return nil
+	}
+	return p.parseCharacterEscape(start, c)
+}
+
+// parseCharacterEscape parses the rest of an escape which is valid inside character classes as well.
+func (p *rulesRegexParser) parseCharacterEscape(start int, c byte) error {
+	switch {
+	case c == 'x':
+		if p.pos < len(p.expression) && p.expression[p.pos] == '{' {
+			end := strings.IndexByte(p.expression[p.pos:], '}')
+			if end < 2 || strings.TrimLeft(p.expression[p.pos+1:p.pos+end], "0123456789abcdefABCDEF") != "" {
+				return p.errorf(start, `invalid \x{...} escape`)
+			}
+			p.pos += end + 1
+			return nil
+		}
+		digits := 0
+		for digits < 2 && p.pos < len(p.expression) && strings.IndexByte("0123456789abcdefABCDEF", p.expression[p.pos]) >= 0 {
+			p.pos++
+			digits++
+		}
+		if digits < 2 {
+			p.differences[`\x with fewer than two hexadecimal digits, which RE2 doesn't support`] = true
+		}
+		return nil
+	case c == 'p' || c == 'P':
+		if p.pos >= len(p.expression) {
+			return p.errorf(start, `\%c isn't followed by a property name`, c)
+		}
+		if p.expression[p.pos] != '{' {
+			if next := p.expression[p.pos]; !isUpperLetter(next) && !(next >= 'a' && next <= 'z') {
+				return p.errorf(start, `\%c isn't followed by a property name`, c)
+			}
+			p.pos++
+			return nil
+		}
+		end := strings.IndexByte(p.expression[p.pos:], '}')
+		if end < 2 {
+			return p.errorf(start, `invalid \%c{...} property`, c)
+		}
+		p.pos += end + 1
+		return nil
+	case c == 'c':
+		if p.pos >= len(p.expression) {
+			return p.errorf(start, `\c isn't followed by a character`)
+		}
+		p.differences[rulesRegexEscapes[c]] = true
+		p.pos++
+		return nil
+	case c >= '1' && c <= '9':
+		// Outside of character classes these are backreferences, which parseEscape handles.
+		p.differences["octal escapes in character classes, which RE2 doesn't support"] = true
+		return nil
+	case c == '0':
+		for i := 0; i < 2 && p.pos < len(p.expression) && p.expression[p.pos] >= '0' && p.expression[p.pos] <= '7'; i++ {
+			p.pos++
+		}
+		return nil
+	case isUpperLetter(c) || (c >= 'a' && c <= 'z'):
+		difference, ok := rulesRegexEscapes[c]
+		if !ok {
+			return p.errorf(start, `unrecognized escape \%c`, c)
+		}
+		if difference != "" {
+			p.differences[difference] = true
+		}
+		return nil
+	}
+	// Escaped non-alphanumeric characters are literals.
+	if c >= utf8.RuneSelf {
+		p.differences["escaped non-ASCII characters, which RE2 doesn't support"] = true
+		_, size := utf8.DecodeRuneInString(p.expression[p.pos-1:])
+		p.pos += size - 1
+	}
+	return nil
+}
+
+func (p *rulesRegexParser) parseClass() error {
+	start := p.pos
+	p.pos++
+	if p.pos < len(p.expression) && p.expression[p.pos] == '^' {
+		p.pos++
+	}
+	first := true
+	// previous is the last literal character of the class, or -1 when the last item isn't a literal character.
+	previous, inRange := rune(-1), false
+	for {
+		if p.pos >= len(p.expression) {
+			return p.errorf(start, "missing closing ] for character class")
+		}
+		itemStart := p.pos
+		current := rune(-1)
+		switch c := p.expression[p.pos]; {
+		case c == ']' && !first:
+			p.pos++
+			return nil
+		case c == '[' && p.pos+1 < len(p.expression) && p.expression[p.pos+1] == ':':
+			end := strings.Index(p.expression[p.pos:], ":]")
+			if end < 2 {
+				current, p.pos = '[', p.pos+1
+				break
+			}
+			name := strings.TrimPrefix(p.expression[p.pos+2:p.pos+end], "^")
+			if !rulesRegexPosixClasses[name] {
+				return p.errorf(itemStart, "unknown POSIX class name %q", name)
+			}
+			p.pos += end + 2
+		case c == '\\':
+			if p.pos+1 >= len(p.expression) {
+				return p.errorf(itemStart, "trailing backslash")
+			}
+			escaped := p.expression[p.pos+1]
+			if escaped == 'Q' {
+				end := strings.Index(p.expression[p.pos+2:], `\E`)
+				if end < 0 {
+					return p.errorf(start, "missing closing ] for character class")
+				}
+				p.pos += end + 4
+				break
+			}
+			p.pos += 2
+			if escaped == 'b' {
+				p.differences[`the \b backspace escape in character classes, which RE2 doesn't support`] = true
+				current = '\b'
+				break
+			}
+			if err := p.parseCharacterEscape(itemStart, escaped); err != nil {
+				return err
+			}
+			if !isUpperLetter(escaped) && !(escaped >= 'a' && escaped <= 'z') && !isDigit(escaped) {
+				current, _ = utf8.DecodeRuneInString(p.expression[itemStart+1:])
+			}
+		case c == '-' && !first && !inRange && previous >= 0 && p.pos+1 < len(p.expression) && p.expression[p.pos+1] != ']':
+			p.pos++
+			inRange = true
+			first = false
+			continue
+		default:
+			var size int
+			current, size = utf8.DecodeRuneInString(p.expression[p.pos:])
+			p.pos += size
+		}
+		if inRange {
+			if current >= 0 && current < previous {
+				return p.errorf(itemStart, "range out of order in character class")
+			}
+			current, inRange = -1, false
+		}
+		previous = current
+		first = false
+	}
+}
+
+func (p *rulesRegexParser) checkReferences() error {
+	for _, reference := range p.references {
+		if reference.name != "" {
+			if !p.regex.hasGroup(reference.name) {
+				return p.errorf(reference.offset, "reference to non-existent group %q", reference.name)
+			}
+		} else if reference.number > p.regex.groups && reference.number < 10 {
+			// Larger numbers which don't refer to a group are octal escapes.
+			return p.errorf(reference.offset, "reference to non-existent group %d", reference.number)
+		}
+	}
+	return nil
+}
+
+func (r *rulesRegex) hasGroup(name string) bool {
+	for _, groupName := range r.groupNames {
+		if groupName == name {
+			return true
+		}
+	}
+	return false
+}
+
+func isUpperLetter(c byte) bool {
+	return c >= 'A' && c <= 'Z'
+}
+
+// validateRulesRegularExpression validates a rule regular expression in the dialect of the Coralogix rules engine,
+// and warns about constructs RE2 (e.g. coralogix_rules_group_simulation) doesn't evaluate the same way.
+func validateRulesRegularExpression(v interface{}, path cty.Path) diag.Diagnostics {
+	regex, err := parseRulesRegex(v.(string))
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Invalid regular expression",
+			Detail:        err.Error(),
+			AttributePath: path,
+		}}
+	}
+	if len(regex.re2Differences) == 0 {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Regular expression isn't RE2 compatible",
+		Detail: fmt.Sprintf("The regular expression is valid for Coralogix, but it uses %s. "+
+			"Testing it with RE2 based tools, e.g. Go or coralogix_rules_group_simulation, won't match the way Coralogix does.",
+			strings.Join(regex.re2Differences, "; ")),
+		AttributePath: path,
+	}}
+}
+
+// rulesRegularExpressionValidator is validateRulesRegularExpression for plugin-framework string attributes.
+type rulesRegularExpressionValidator struct{}
+
+func (v rulesRegularExpressionValidator) Description(_ context.Context) string {
+	return "string must be a regular expression in the dialect of the Coralogix rules engine"
+}
+
+func (v rulesRegularExpressionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v rulesRegularExpressionValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, d := range validateRulesRegularExpression(req.ConfigValue.ValueString(), nil) {
+		if d.Severity == diag.Error {
+			resp.Diagnostics.AddAttributeError(req.Path, d.Summary, d.Detail)
+		} else {
+			resp.Diagnostics.AddAttributeWarning(req.Path, d.Summary, d.Detail)
+		}
+	}
+}
+
+// validateRulesGroupRegexRules checks the regular expressions of parse, extract and replace rules against their other
+// parameters: parse and extract rules write their named capture groups into JSON fields, so they need named groups
+// and parse rules need a JSON destination field, and replacement strings may refer only to existing groups.
+func validateRulesGroupRegexRules(ruleSubgroups []interface{}) error {
+	var errs []string
+	for i, subgroup := range ruleSubgroups {
+		subgroupMap, ok := subgroup.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for j, rule := range subgroupMap["rules"].([]interface{}) {
+			ruleMap, ok := rule.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for _, ruleType := range []string{"parse", "extract", "replace"} {
+				params, ok := ruleMap[ruleType].(map[string]interface{})
+				if !ok {
+					continue
+				}
+				path := fmt.Sprintf("rule_subgroups.%d.rules.%d.%s", i, j, ruleType)
+				if err := validateRegexRule(ruleType, params); err != nil {
+					errs = append(errs, fmt.Sprintf("%s: %s", path, err))
+				}
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+func validateRegexRule(ruleType string, params map[string]interface{}) error {
+	expression, _ := params["regular_expression"].(string)
+	if expression == "" {
+		// Either unknown yet, or already reported by the schema validation.
+		return nil
+	}
+	regex, err := parseRulesRegex(expression)
+	if err != nil {
+		return nil
+	}
+
+	switch ruleType {
+	case "parse", "extract":
+		if len(regex.groupNames) == 0 {
+			return fmt.Errorf("%s rules write named capture groups into JSON fields, but regular_expression has no named capture groups, e.g. (?P<name>...)", ruleType)
+		}
+		destinationField, _ := params["destination_field"].(string)
+		if ruleType == "parse" && destinationField != "" && destinationField != "text" && !strings.HasPrefix(destinationField, "text.") {
+			return fmt.Errorf("destination_field %q can't hold the capture groups %q, it has to be text or a text.<field> JSON field", destinationField, regex.groupNames)
+		}
+	case "replace":
+		replacement, _ := params["replacement_string"].(string)
+		for _, match := range rulesRegexReplacementRefRegex.FindAllStringSubmatch(replacement, -1) {
+			reference := match[2] + match[3]
+			if reference == "" {
+				continue
+			}
+			if number, err := strconv.Atoi(reference); err == nil {
+				if number > regex.groups {
+					return fmt.Errorf("replacement_string refers to capture group %s, but regular_expression has %d capture groups", match[0], regex.groups)
+				}
+			} else if !regex.hasGroup(reference) {
+				return fmt.Errorf("replacement_string refers to capture group %s, but regular_expression has no group named %q", match[0], reference)
+			}
+		}
+	}
+	return nil
+}
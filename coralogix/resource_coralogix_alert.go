@@ -2,23 +2,45 @@ package coralogix
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 	"terraform-provider-coralogix/coralogix/clientset"
 	alerts "terraform-provider-coralogix/coralogix/clientset/grpc/alerts/v2"
-
-	. "github.com/ahmetalpbalkan/go-linq"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 var (
@@ -147,6 +169,8 @@ var (
 	alertValidDeadmanRatioValues                 = getKeysStrings(alertSchemaDeadmanRatiosToProtoDeadmanRatios)
 	alertValidTimeZones                          = []string{"UTC-11", "UTC-10", "UTC-9", "UTC-8", "UTC-7", "UTC-6", "UTC-5", "UTC-4", "UTC-3", "UTC-2", "UTC-1",
 		"UTC+0", "UTC+1", "UTC+2", "UTC+3", "UTC+4", "UTC+5", "UTC+6", "UTC+7", "UTC+8", "UTC+9", "UTC+10", "UTC+11", "UTC+12", "UTC+13", "UTC+14"}
+	alertFixedTimeZoneRegex            = regexp.MustCompile(`^UTC([+-])(\d{1,2})(?::([0-5]\d))?$`)
+	alertTimeInDayRegex                = regexp.MustCompile(`^(0\d|1\d|2[0-3]):[0-5]\d$`)
 	alertSchemaNotifyOnToProtoNotifyOn = map[string]alerts.NotifyOn{
 		"Triggered_only":         alerts.NotifyOn_TRIGGERED_ONLY,
 		"Triggered_and_resolved": alerts.NotifyOn_TRIGGERED_AND_RESOLVED,
@@ -165,6 +189,17 @@ var (
 		alerts.EvaluationWindow_EVALUATION_WINDOW_DYNAMIC:                "Dynamic",
 	}
 	validEvaluationWindow = []string{"Rolling", "Dynamic"}
+	// alertValidPercentages are the values accepted by the percentage fields of metric alerts, which go in increments of 10.
+	alertValidPercentages = []int64{0, 10, 20, 30, 40, 50, 60, 70, 80, 90, 100}
+)
+
+var (
+	_ resource.ResourceWithConfigure        = &AlertResource{}
+	_ resource.ResourceWithConfigValidators = &AlertResource{}
+	_ resource.ResourceWithImportState      = &AlertResource{}
+	_ resource.ResourceWithUpgradeState     = &AlertResource{}
+	_ resource.ResourceWithModifyPlan       = &AlertResource{}
+	_ resource.ResourceWithValidateConfig   = &AlertResource{}
 )
 
 type alertParams struct {
@@ -177,3215 +212,3621 @@ type protoTimeFrameAndRelativeTimeFrame struct {
 	relativeTimeFrame alerts.RelativeTimeframe
 }
 
-func resourceCoralogixAlert() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceCoralogixAlertCreate,
-		ReadContext:   resourceCoralogixAlertRead,
-		UpdateContext: resourceCoralogixAlertUpdate,
-		DeleteContext: resourceCoralogixAlertDelete,
+func NewAlertResource() resource.Resource {
+	return &AlertResource{}
+}
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+type AlertResource struct {
+	client         *clientset.AlertsClient
+	webhooksClient *clientset.WebhooksClient
+}
 
-		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(60 * time.Second),
-			Read:   schema.DefaultTimeout(30 * time.Second),
-			Update: schema.DefaultTimeout(60 * time.Second),
-			Delete: schema.DefaultTimeout(30 * time.Second),
-		},
+type AlertResourceModel struct {
+	ID                  types.String                  `tfsdk:"id"`
+	Enabled             types.Bool                    `tfsdk:"enabled"`
+	Name                types.String                  `tfsdk:"name"`
+	Description         types.String                  `tfsdk:"description"`
+	Severity            types.String                  `tfsdk:"severity"`
+	MetaLabels          types.Map                     `tfsdk:"meta_labels"`
+	ExpirationDate      *AlertExpirationDateModel     `tfsdk:"expiration_date"`
+	NotificationsGroups []AlertNotificationGroupModel `tfsdk:"notifications_group"`
+	PayloadFilters      types.Set                     `tfsdk:"payload_filters"`
+	ShowInInsights      types.Object                  `tfsdk:"show_in_insights"`
+	Scheduling          *AlertSchedulingModel         `tfsdk:"scheduling"`
+	Standard            *StandardAlertModel           `tfsdk:"standard"`
+	Ratio               *RatioAlertModel              `tfsdk:"ratio"`
+	NewValue            *NewValueAlertModel           `tfsdk:"new_value"`
+	UniqueCount         *UniqueCountAlertModel        `tfsdk:"unique_count"`
+	TimeRelative        *TimeRelativeAlertModel       `tfsdk:"time_relative"`
+	Metric              *MetricAlertModel             `tfsdk:"metric"`
+	Tracing             *TracingAlertModel            `tfsdk:"tracing"`
+	Flow                *FlowAlertModel               `tfsdk:"flow"`
+}
+
+type AlertExpirationDateModel struct {
+	Day   types.Int64 `tfsdk:"day"`
+	Month types.Int64 `tfsdk:"month"`
+	Year  types.Int64 `tfsdk:"year"`
+}
+
+type AlertNotificationGroupModel struct {
+	GroupByFields types.List               `tfsdk:"group_by_fields"`
+	Notifications []AlertNotificationModel `tfsdk:"notification"`
+}
+
+type AlertNotificationModel struct {
+	RetriggeringPeriodMinutes types.Int64  `tfsdk:"retriggering_period_minutes"`
+	NotifyOn                  types.String `tfsdk:"notify_on"`
+	IntegrationID             types.String `tfsdk:"integration_id"`
+	EmailRecipients           types.Set    `tfsdk:"email_recipients"`
+}
+
+type AlertShowInInsightsModel struct {
+	RetriggeringPeriodMinutes types.Int64  `tfsdk:"retriggering_period_minutes"`
+	NotifyOn                  types.String `tfsdk:"notify_on"`
+}
+
+type AlertSchedulingModel struct {
+	TimeZone   types.String          `tfsdk:"time_zone"`
+	TimeFrames []AlertTimeFrameModel `tfsdk:"time_frame"`
+}
+
+type AlertTimeFrameModel struct {
+	DaysEnabled types.Set    `tfsdk:"days_enabled"`
+	StartTime   types.String `tfsdk:"start_time"`
+	EndTime     types.String `tfsdk:"end_time"`
+}
+
+type StandardAlertModel struct {
+	SearchQuery  types.String            `tfsdk:"search_query"`
+	Severities   types.Set               `tfsdk:"severities"`
+	Applications types.Set               `tfsdk:"applications"`
+	Subsystems   types.Set               `tfsdk:"subsystems"`
+	Categories   types.Set               `tfsdk:"categories"`
+	Computers    types.Set               `tfsdk:"computers"`
+	Classes      types.Set               `tfsdk:"classes"`
+	Methods      types.Set               `tfsdk:"methods"`
+	IPAddresses  types.Set               `tfsdk:"ip_addresses"`
+	Condition    *StandardConditionModel `tfsdk:"condition"`
+}
+
+type StandardConditionModel struct {
+	Immediately            types.Bool   `tfsdk:"immediately"`
+	LessThan               types.Bool   `tfsdk:"less_than"`
+	MoreThan               types.Bool   `tfsdk:"more_than"`
+	MoreThanUsual          types.Bool   `tfsdk:"more_than_usual"`
+	Threshold              types.Int64  `tfsdk:"threshold"`
+	TimeWindow             types.String `tfsdk:"time_window"`
+	GroupBy                types.List   `tfsdk:"group_by"`
+	GroupByKey             types.String `tfsdk:"group_by_key"`
+	ManageUndetectedValues types.Object `tfsdk:"manage_undetected_values"`
+	EvaluationWindow       types.String `tfsdk:"evaluation_window"`
+}
+
+type ManageUndetectedValuesModel struct {
+	EnableTriggeringOnUndetectedValues types.Bool   `tfsdk:"enable_triggering_on_undetected_values"`
+	AutoRetireRatio                    types.String `tfsdk:"auto_retire_ratio"`
+}
+
+type RatioAlertModel struct {
+	Query1    *RatioQuery1Model    `tfsdk:"query_1"`
+	Query2    *RatioQuery2Model    `tfsdk:"query_2"`
+	Condition *RatioConditionModel `tfsdk:"condition"`
+}
+
+type RatioQuery1Model struct {
+	Alias        types.String `tfsdk:"alias"`
+	SearchQuery  types.String `tfsdk:"search_query"`
+	Severities   types.Set    `tfsdk:"severities"`
+	Applications types.Set    `tfsdk:"applications"`
+	Subsystems   types.Set    `tfsdk:"subsystems"`
+	Categories   types.Set    `tfsdk:"categories"`
+	Computers    types.Set    `tfsdk:"computers"`
+	Classes      types.Set    `tfsdk:"classes"`
+	Methods      types.Set    `tfsdk:"methods"`
+	IPAddresses  types.Set    `tfsdk:"ip_addresses"`
+}
+
+type RatioQuery2Model struct {
+	Alias        types.String `tfsdk:"alias"`
+	SearchQuery  types.String `tfsdk:"search_query"`
+	Severities   types.Set    `tfsdk:"severities"`
+	Applications types.Set    `tfsdk:"applications"`
+	Subsystems   types.Set    `tfsdk:"subsystems"`
+}
+
+type RatioConditionModel struct {
+	MoreThan               types.Bool    `tfsdk:"more_than"`
+	LessThan               types.Bool    `tfsdk:"less_than"`
+	RatioThreshold         types.Float64 `tfsdk:"ratio_threshold"`
+	TimeWindow             types.String  `tfsdk:"time_window"`
+	IgnoreInfinity         types.Bool    `tfsdk:"ignore_infinity"`
+	GroupBy                types.List    `tfsdk:"group_by"`
+	GroupByQ1              types.Bool    `tfsdk:"group_by_q1"`
+	GroupByQ2              types.Bool    `tfsdk:"group_by_q2"`
+	GroupByBoth            types.Bool    `tfsdk:"group_by_both"`
+	ManageUndetectedValues types.Object  `tfsdk:"manage_undetected_values"`
+}
+
+type NewValueAlertModel struct {
+	SearchQuery  types.String            `tfsdk:"search_query"`
+	Severities   types.Set               `tfsdk:"severities"`
+	Applications types.Set               `tfsdk:"applications"`
+	Subsystems   types.Set               `tfsdk:"subsystems"`
+	Categories   types.Set               `tfsdk:"categories"`
+	Computers    types.Set               `tfsdk:"computers"`
+	Classes      types.Set               `tfsdk:"classes"`
+	Methods      types.Set               `tfsdk:"methods"`
+	IPAddresses  types.Set               `tfsdk:"ip_addresses"`
+	Condition    *NewValueConditionModel `tfsdk:"condition"`
+}
+
+type NewValueConditionModel struct {
+	KeyToTrack types.String `tfsdk:"key_to_track"`
+	TimeWindow types.String `tfsdk:"time_window"`
+}
+
+type UniqueCountAlertModel struct {
+	SearchQuery  types.String               `tfsdk:"search_query"`
+	Severities   types.Set                  `tfsdk:"severities"`
+	Applications types.Set                  `tfsdk:"applications"`
+	Subsystems   types.Set                  `tfsdk:"subsystems"`
+	Categories   types.Set                  `tfsdk:"categories"`
+	Computers    types.Set                  `tfsdk:"computers"`
+	Classes      types.Set                  `tfsdk:"classes"`
+	Methods      types.Set                  `tfsdk:"methods"`
+	IPAddresses  types.Set                  `tfsdk:"ip_addresses"`
+	Condition    *UniqueCountConditionModel `tfsdk:"condition"`
+}
+
+type UniqueCountConditionModel struct {
+	UniqueCountKey            types.String `tfsdk:"unique_count_key"`
+	MaxUniqueValues           types.Int64  `tfsdk:"max_unique_values"`
+	TimeWindow                types.String `tfsdk:"time_window"`
+	GroupByKey                types.String `tfsdk:"group_by_key"`
+	MaxUniqueValuesForGroupBy types.Int64  `tfsdk:"max_unique_values_for_group_by"`
+}
+
+type TimeRelativeAlertModel struct {
+	SearchQuery  types.String                `tfsdk:"search_query"`
+	Severities   types.Set                   `tfsdk:"severities"`
+	Applications types.Set                   `tfsdk:"applications"`
+	Subsystems   types.Set                   `tfsdk:"subsystems"`
+	Categories   types.Set                   `tfsdk:"categories"`
+	Computers    types.Set                   `tfsdk:"computers"`
+	Classes      types.Set                   `tfsdk:"classes"`
+	Methods      types.Set                   `tfsdk:"methods"`
+	IPAddresses  types.Set                   `tfsdk:"ip_addresses"`
+	Condition    *TimeRelativeConditionModel `tfsdk:"condition"`
+}
+
+type TimeRelativeConditionModel struct {
+	LessThan               types.Bool    `tfsdk:"less_than"`
+	MoreThan               types.Bool    `tfsdk:"more_than"`
+	RatioThreshold         types.Float64 `tfsdk:"ratio_threshold"`
+	RelativeTimeWindow     types.String  `tfsdk:"relative_time_window"`
+	IgnoreInfinity         types.Bool    `tfsdk:"ignore_infinity"`
+	GroupBy                types.List    `tfsdk:"group_by"`
+	ManageUndetectedValues types.Object  `tfsdk:"manage_undetected_values"`
+}
+
+type MetricAlertModel struct {
+	Lucene *LuceneMetricAlertModel `tfsdk:"lucene"`
+	Promql *PromqlMetricAlertModel `tfsdk:"promql"`
+}
+
+type LuceneMetricAlertModel struct {
+	SearchQuery types.String                `tfsdk:"search_query"`
+	Condition   *LuceneMetricConditionModel `tfsdk:"condition"`
+}
+
+type LuceneMetricConditionModel struct {
+	MetricField                 types.String  `tfsdk:"metric_field"`
+	ArithmeticOperator          types.String  `tfsdk:"arithmetic_operator"`
+	ArithmeticOperatorModifier  types.Int64   `tfsdk:"arithmetic_operator_modifier"`
+	LessThan                    types.Bool    `tfsdk:"less_than"`
+	MoreThan                    types.Bool    `tfsdk:"more_than"`
+	Threshold                   types.Float64 `tfsdk:"threshold"`
+	SampleThresholdPercentage   types.Int64   `tfsdk:"sample_threshold_percentage"`
+	TimeWindow                  types.String  `tfsdk:"time_window"`
+	GroupBy                     types.List    `tfsdk:"group_by"`
+	ReplaceMissingValueWithZero types.Bool    `tfsdk:"replace_missing_value_with_zero"`
+	MinNonNullValuesPercentage  types.Int64   `tfsdk:"min_non_null_values_percentage"`
+	ManageUndetectedValues      types.Object  `tfsdk:"manage_undetected_values"`
+}
+
+type PromqlMetricAlertModel struct {
+	SearchQuery types.String                `tfsdk:"search_query"`
+	Condition   *PromqlMetricConditionModel `tfsdk:"condition"`
+}
+
+type PromqlMetricConditionModel struct {
+	LessThan                    types.Bool    `tfsdk:"less_than"`
+	MoreThan                    types.Bool    `tfsdk:"more_than"`
+	MoreThanUsual               types.Bool    `tfsdk:"more_than_usual"`
+	Threshold                   types.Float64 `tfsdk:"threshold"`
+	TimeWindow                  types.String  `tfsdk:"time_window"`
+	SampleThresholdPercentage   types.Int64   `tfsdk:"sample_threshold_percentage"`
+	GroupBy                     types.List    `tfsdk:"group_by"`
+	ReplaceMissingValueWithZero types.Bool    `tfsdk:"replace_missing_value_with_zero"`
+	MinNonNullValuesPercentage  types.Int64   `tfsdk:"min_non_null_values_percentage"`
+	ManageUndetectedValues      types.Object  `tfsdk:"manage_undetected_values"`
+	EvaluationWindow            types.String  `tfsdk:"evaluation_window"`
+}
+
+type TracingAlertModel struct {
+	Applications                 types.Set               `tfsdk:"applications"`
+	Subsystems                   types.Set               `tfsdk:"subsystems"`
+	Services                     types.Set               `tfsdk:"services"`
+	TagFilters                   []TracingTagFilterModel `tfsdk:"tag_filter"`
+	LatencyThresholdMilliseconds types.Float64           `tfsdk:"latency_threshold_milliseconds"`
+	Condition                    *TracingConditionModel  `tfsdk:"condition"`
+}
+
+type TracingTagFilterModel struct {
+	Field  types.String `tfsdk:"field"`
+	Values types.Set    `tfsdk:"values"`
+}
+
+type TracingConditionModel struct {
+	Immediately types.Bool   `tfsdk:"immediately"`
+	MoreThan    types.Bool   `tfsdk:"more_than"`
+	Threshold   types.Int64  `tfsdk:"threshold"`
+	TimeWindow  types.String `tfsdk:"time_window"`
+	GroupBy     types.List   `tfsdk:"group_by"`
+}
+
+type FlowAlertModel struct {
+	Stages []FlowStageModel `tfsdk:"stage"`
+}
+
+type FlowStageModel struct {
+	Groups     []FlowGroupModel     `tfsdk:"group"`
+	TimeWindow *FlowTimeWindowModel `tfsdk:"time_window"`
+}
+
+type FlowGroupModel struct {
+	SubAlerts    *FlowSubAlertsModel `tfsdk:"sub_alerts"`
+	NextOperator types.String        `tfsdk:"next_operator"`
+}
+
+type FlowSubAlertsModel struct {
+	Operator   types.String          `tfsdk:"operator"`
+	FlowAlerts []FlowInnerAlertModel `tfsdk:"flow_alert"`
+}
 
-		Schema: AlertSchema(),
+type FlowInnerAlertModel struct {
+	Not         types.Bool   `tfsdk:"not"`
+	UserAlertID types.String `tfsdk:"user_alert_id"`
+}
+
+type FlowTimeWindowModel struct {
+	Hours   types.Int64 `tfsdk:"hours"`
+	Minutes types.Int64 `tfsdk:"minutes"`
+	Seconds types.Int64 `tfsdk:"seconds"`
+}
+
+// alertLogsFilters are the log filters shared by the standard, ratio, new_value, unique_count and time_relative alerts.
+type alertLogsFilters struct {
+	SearchQuery  types.String
+	Severities   types.Set
+	Applications types.Set
+	Subsystems   types.Set
+	Categories   types.Set
+	Computers    types.Set
+	Classes      types.Set
+	Methods      types.Set
+	IPAddresses  types.Set
+}
 
-		Description: "Coralogix alert. More info: https://coralogix.com/docs/alerts-api/ .",
+func showInInsightsModelAttr() map[string]attr.Type {
+	return map[string]attr.Type{
+		"retriggering_period_minutes": types.Int64Type,
+		"notify_on":                   types.StringType,
 	}
 }
 
-func AlertSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
-		"enabled": {
-			Type:        schema.TypeBool,
-			Optional:    true,
-			Default:     true,
-			Description: "Determines whether the alert will be active. True by default.",
-		},
-		"name": {
-			Type:         schema.TypeString,
-			Required:     true,
-			ValidateFunc: validation.StringIsNotEmpty,
-			Description:  "Alert name.",
-		},
-		"description": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "Alert description.",
-		},
-		"severity": {
-			Type:         schema.TypeString,
-			Required:     true,
-			ValidateFunc: validation.StringInSlice(alertValidSeverities, false),
-			Description:  fmt.Sprintf("Determines the alert's severity. Can be one of %q", alertValidSeverities),
-		},
-		"meta_labels": {
-			Type: schema.TypeMap,
-			Elem: &schema.Schema{
-				Type: schema.TypeString,
-			},
-			Optional:         true,
-			Description:      "Labels allow you to easily filter by alert type and create views. Insert a new label or use an existing one. You can nest a label using key:value.",
-			ValidateDiagFunc: validation.MapKeyMatch(regexp.MustCompile(`^[A-Za-z\d_-]*$`), "not valid key for meta_label"),
-		},
-		"expiration_date": {
-			Type:     schema.TypeList,
-			Optional: true,
-			MaxItems: 1,
-			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					"day": {
-						Type:         schema.TypeInt,
-						Required:     true,
-						ValidateFunc: validation.IntBetween(1, 31),
-						Description:  `Day of a month. Must be from 1 to 31 and valid for the year and month.`,
+func manageUndetectedValuesModelAttr() map[string]attr.Type {
+	return map[string]attr.Type{
+		"enable_triggering_on_undetected_values": types.BoolType,
+		"auto_retire_ratio":                      types.StringType,
+	}
+}
+
+func (r *AlertResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert"
+}
+
+func (r *AlertResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clientSet, ok := req.ProviderData.(*clientset.ClientSet)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *clientset.ClientSet, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clientSet.Alerts()
+	r.webhooksClient = clientSet.Webhooks()
+}
+
+func (r *AlertResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: 1,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "Alert ID.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Determines whether the alert will be active. True by default.",
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "Alert name.",
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "Alert description.",
+			},
+			"severity": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(alertValidSeverities...),
+				},
+				MarkdownDescription: fmt.Sprintf("Determines the alert's severity. Can be one of %q", alertValidSeverities),
+			},
+			"meta_labels": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.KeysAre(
+						stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Za-z\d_-]*$`), "not valid key for meta_label"),
+					),
+				},
+				MarkdownDescription: "Labels allow you to easily filter by alert type and create views. Insert a new label or use an existing one. You can nest a label using key:value.",
+			},
+			"expiration_date": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"day": schema.Int64Attribute{
+						Required: true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 31),
+						},
+						MarkdownDescription: "Day of a month. Must be from 1 to 31 and valid for the year and month.",
 					},
-					"month": {
-						Type:         schema.TypeInt,
-						Required:     true,
-						ValidateFunc: validation.IntBetween(1, 12),
-						Description:  `Month of a year. Must be from 1 to 12.`,
+					"month": schema.Int64Attribute{
+						Required: true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 12),
+						},
+						MarkdownDescription: "Month of a year. Must be from 1 to 12.",
 					},
-					"year": {
-						Type:         schema.TypeInt,
-						Required:     true,
-						ValidateFunc: validation.IntBetween(1, 9999),
-						Description:  `Year of the date. Must be from 1 to 9999.`,
+					"year": schema.Int64Attribute{
+						Required: true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 9999),
+						},
+						MarkdownDescription: "Year of the date. Must be from 1 to 9999.",
 					},
 				},
+				MarkdownDescription: "The expiration date of the alert (if declared).",
 			},
-			Description: "The expiration date of the alert (if declared).",
-		},
-		"notifications_group": {
-			Type:        schema.TypeSet,
-			Optional:    true,
-			Elem:        notificationGroupSchema(),
-			Set:         schema.HashResource(notificationGroupSchema()),
-			Description: "Defines notifications settings over list of group-by keys (or on empty list).",
-		},
-		"payload_filters": {
-			Type:     schema.TypeSet,
-			Optional: true,
-			Elem: &schema.Schema{
-				Type: schema.TypeString,
-			},
-			Description: "A list of log fields out of the log example which will be included with the alert notification.",
-			Set:         schema.HashString,
-		},
-		"show_in_insights": {
-			Type:     schema.TypeList,
-			MaxItems: 1,
-			Optional: true,
-			Computed: true,
-			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					"retriggering_period_minutes": {
-						Type:         schema.TypeInt,
-						Optional:     true,
-						Computed:     true,
-						ValidateFunc: validation.IntAtLeast(1),
-						Description: "By default, retriggering_period_minutes will be populated with minute for immediate," +
-							" more_than and more_than_usual alerts. For less_than alert it will be populated with the chosen time" +
-							" frame for the less_than condition (in minutes). You may choose to change the suppress window so the " +
-							"alert will be suppressed for a longer period.",
-					},
-					"notify_on": {
-						Type:         schema.TypeString,
-						Optional:     true,
-						Default:      "Triggered_only",
-						ValidateFunc: validation.StringInSlice(validNotifyOn, false),
-						Description:  fmt.Sprintf("Defines the alert's triggering logic. Can be one of %q. Triggered_and_resolved conflicts with new_value, unique_count and flow alerts, and with immediately and more_than_usual conditions", validNotifyOn),
+			"notifications_group": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"group_by_fields": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+							MarkdownDescription: "List of group-by fields to apply the notification logic on. Omit it to apply the notification logic on the whole alert. Every notification group should contain a unique group_by_fields permutation (the order doesn't matter).",
+						},
+						"notification": schema.ListNestedAttribute{
+							Optional: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"retriggering_period_minutes": retriggeringPeriodMinutesSchema(),
+									"notify_on":                   notifyOnSchema(),
+									"integration_id": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.RegexMatches(webhookIDRegex, "must be a numeric webhook ID"),
+											stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("email_recipients")),
+										},
+										MarkdownDescription: "The ID of the webhook to notify (e.g. coralogix_webhook.example.id). The webhook has to exist. Conflicts with email_recipients.",
+									},
+									"email_recipients": schema.SetAttribute{
+										Optional:    true,
+										ElementType: types.StringType,
+										Validators: []validator.Set{
+											setvalidator.SizeAtLeast(1),
+											setvalidator.ValueStringsAre(mailValidationFuncFramework{}),
+										},
+										MarkdownDescription: "The emails to notify. Conflicts with integration_id.",
+									},
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+							MarkdownDescription: "Defines notification logic with optional recipients. Can contain single webhook or email recipients list.",
+						},
 					},
 				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				MarkdownDescription: "Defines notifications settings over list of group-by keys (or on empty list).",
 			},
-		},
-		"scheduling": {
-			Type:     schema.TypeList,
-			Optional: true,
-			Elem: &schema.Resource{
-				Schema: schedulingSchema(),
+			"payload_filters": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				MarkdownDescription: "A list of log fields out of the log example which will be included with the alert notification.",
 			},
-			MaxItems:    1,
-			Description: "Limit the triggering of this alert to specific time frames. Active always by default.",
-		},
-		"standard": {
-			Type:     schema.TypeList,
-			Optional: true,
-			Elem: &schema.Resource{
-				Schema: standardSchema(),
+			"show_in_insights": schema.SingleNestedAttribute{
+				Optional: true,
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"retriggering_period_minutes": retriggeringPeriodMinutesSchema(),
+					"notify_on":                   notifyOnSchema(),
+				},
 			},
-			MaxItems:     1,
-			ExactlyOneOf: validAlertTypes,
-			Description:  "Alert based on number of log occurrences.",
-		},
-		"ratio": {
-			Type:     schema.TypeList,
-			Optional: true,
-			Elem: &schema.Resource{
-				Schema: ratioSchema(),
+			"scheduling": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"time_zone": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  stringdefault.StaticString("UTC+0"),
+						Validators: []validator.String{
+							alertTimeZoneValidator{},
+						},
+						MarkdownDescription: "Specifies the time zone to be used in interpreting the schedule. Can be an IANA time zone name (e.g. Europe/Berlin, Asia/Kolkata)" +
+							" or a fixed offset from UTC+14 to UTC-12, optionally with minutes (e.g. UTC+2, UTC+5:30)." +
+							" Schedules are stored in GMT, so for time zones that observe daylight saving time the offset in effect at apply time is used," +
+							" and the schedule shows up as changed after a transition until it is applied again.",
+					},
+					"time_frame": schema.SetNestedAttribute{
+						Required: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"days_enabled": schema.SetAttribute{
+									Required:    true,
+									ElementType: types.StringType,
+									Validators: []validator.Set{
+										setvalidator.SizeAtLeast(1),
+										setvalidator.ValueStringsAre(stringvalidator.OneOf(alertValidDaysOfWeek...)),
+									},
+									MarkdownDescription: fmt.Sprintf("Days of week. Can be one of %q", alertValidDaysOfWeek),
+								},
+								"start_time": timeInDaySchema("Limit the triggering of this alert to start at specific hour."),
+								"end_time":   timeInDaySchema("Limit the triggering of this alert to end at specific hour."),
+							},
+						},
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+						},
+						MarkdownDescription: "time_frame is a set of days and hours when the alert will be active. Several time frames can be set" +
+							" (e.g. different hours for weekdays and weekends), as long as they don't overlap." +
+							" A time frame which ends before it starts is active until end_time on the following day.",
+					},
+				},
+				MarkdownDescription: "Limit the triggering of this alert to specific time frames. Active always by default.",
 			},
-			MaxItems:     1,
-			ExactlyOneOf: validAlertTypes,
-			Description:  "Alert based on the ratio between queries.",
-		},
-		"new_value": {
-			Type:     schema.TypeList,
-			Optional: true,
-			Elem: &schema.Resource{
-				Schema: newValueSchema(),
+			"standard": schema.SingleNestedAttribute{
+				Optional:            true,
+				Attributes:          standardSchema(),
+				MarkdownDescription: "Alert based on number of log occurrences.",
 			},
-			MaxItems:     1,
-			ExactlyOneOf: validAlertTypes,
-			Description:  "Alert on never before seen log value.",
-		},
-		"unique_count": {
-			Type:     schema.TypeList,
-			Optional: true,
-			Elem: &schema.Resource{
-				Schema: uniqueCountSchema(),
+			"ratio": schema.SingleNestedAttribute{
+				Optional:            true,
+				Attributes:          ratioSchema(),
+				MarkdownDescription: "Alert based on the ratio between queries.",
 			},
-			MaxItems:     1,
-			ExactlyOneOf: validAlertTypes,
-			Description:  "Alert based on unique value count per key.",
-		},
-		"time_relative": {
-			Type:     schema.TypeList,
-			Optional: true,
-			Elem: &schema.Resource{
-				Schema: timeRelativeSchema(),
+			"new_value": schema.SingleNestedAttribute{
+				Optional:            true,
+				Attributes:          newValueSchema(),
+				MarkdownDescription: "Alert on never before seen log value.",
 			},
-			MaxItems:     1,
-			ExactlyOneOf: validAlertTypes,
-			Description:  "Alert based on ratio between timeframes.",
-		},
-		"metric": {
-			Type:     schema.TypeList,
-			Optional: true,
-			Elem: &schema.Resource{
-				Schema: metricSchema(),
+			"unique_count": schema.SingleNestedAttribute{
+				Optional:            true,
+				Attributes:          uniqueCountSchema(),
+				MarkdownDescription: "Alert based on unique value count per key.",
 			},
-			MaxItems:     1,
-			ExactlyOneOf: validAlertTypes,
-			Description:  "Alert based on arithmetic operators for metrics.",
-		},
-		"tracing": {
-			Type:     schema.TypeList,
-			Optional: true,
-			Elem: &schema.Resource{
-				Schema: tracingSchema(),
+			"time_relative": schema.SingleNestedAttribute{
+				Optional:            true,
+				Attributes:          timeRelativeSchema(),
+				MarkdownDescription: "Alert based on ratio between timeframes.",
 			},
-			MaxItems:     1,
-			ExactlyOneOf: validAlertTypes,
-			Description:  "Alert based on tracing latency.",
-		},
-		"flow": {
-			Type:     schema.TypeList,
-			Optional: true,
-			Elem: &schema.Resource{
-				Schema: flowSchema(),
+			"metric": schema.SingleNestedAttribute{
+				Optional:            true,
+				Attributes:          metricSchema(),
+				MarkdownDescription: "Alert based on arithmetic operators for metrics.",
 			},
-			MaxItems:     1,
-			ExactlyOneOf: validAlertTypes,
-			Description:  "Alert based on a combination of alerts in a specific timeframe.",
-		},
-	}
-}
-
-func notificationGroupSchema() *schema.Resource {
-	return &schema.Resource{
-		Schema: map[string]*schema.Schema{
-			"group_by_fields": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
-				Description: "List of group-by fields to apply the notification logic on (can be empty). Every notification should contain unique group_by_fields permutation (the order doesn't matter).",
+			"tracing": schema.SingleNestedAttribute{
+				Optional:            true,
+				Attributes:          tracingSchema(),
+				MarkdownDescription: "Alert based on tracing latency.",
 			},
-			"notification": {
-				Type:        schema.TypeSet,
-				Optional:    true,
-				Elem:        notificationSubgroupSchema(),
-				Set:         schema.HashResource(notificationSubgroupSchema()),
-				Description: "Defines notification logic with optional recipients. Can contain single webhook or email recipients list.",
+			"flow": schema.SingleNestedAttribute{
+				Optional:            true,
+				Attributes:          flowSchema(),
+				MarkdownDescription: "Alert based on a combination of alerts in a specific timeframe.",
 			},
 		},
+		MarkdownDescription: "Coralogix alert. More info: https://coralogix.com/docs/alerts-api/ .",
 	}
 }
 
-func notificationSubgroupSchema() *schema.Resource {
-	return &schema.Resource{
-		Schema: map[string]*schema.Schema{
-			"retriggering_period_minutes": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validation.IntAtLeast(1),
-				Description: "By default, retriggering_period_minutes will be populated with min for immediate," +
-					" more_than and more_than_usual alerts. For less_than alert it will be populated with the chosen time" +
-					" frame for the less_than condition (in minutes). You may choose to change the suppress window so the " +
-					"alert will be suppressed for a longer period.",
-			},
-			"notify_on": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "Triggered_only",
-				ValidateFunc: validation.StringInSlice(validNotifyOn, false),
-				Description:  fmt.Sprintf("Defines the alert's triggering logic. Can be one of %q. Triggered_and_resolved conflicts with new_value, unique_count and flow alerts, and with immediately and more_than_usual conditions", validNotifyOn),
-			},
-			"integration_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringIsNotEmpty,
-				Description:  "Conflicts with emails.",
-			},
-			"email_recipients": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-					//ValidateDiagFunc: mailValidationFunc(),
-				},
-				Set:         schema.HashString,
-				Description: "Conflicts with integration_id.",
-			},
+func retriggeringPeriodMinutesSchema() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
 		},
+		MarkdownDescription: "By default, retriggering_period_minutes will be populated with min for immediate," +
+			" more_than and more_than_usual alerts. For less_than alert it will be populated with the chosen time" +
+			" frame for the less_than condition (in minutes). You may choose to change the suppress window so the " +
+			"alert will be suppressed for a longer period.",
 	}
 }
 
-func schedulingSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
-		"time_zone": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "UTC+0",
-			ValidateFunc: validation.StringInSlice(alertValidTimeZones, false),
-			Description:  fmt.Sprintf("Specifies the time zone to be used in interpreting the schedule. Can be one of %q", alertValidTimeZones),
-		},
-		"time_frame": {
-			Type:        schema.TypeSet,
-			MaxItems:    1,
-			Required:    true,
-			Elem:        timeFrames(),
-			Set:         hashTimeFrames(),
-			Description: "time_frame is a set of days and hours when the alert will be active. ***Currently, supported only for one time_frame***",
+func notifyOnSchema() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("Triggered_only"),
+		Validators: []validator.String{
+			stringvalidator.OneOf(validNotifyOn...),
 		},
+		MarkdownDescription: fmt.Sprintf("Defines the alert's triggering logic. Can be one of %q. Triggered_and_resolved conflicts with new_value, unique_count and flow alerts, and with immediately and more_than_usual conditions", validNotifyOn),
 	}
 }
 
-func timeFrames() *schema.Resource {
-	return &schema.Resource{
-		Schema: map[string]*schema.Schema{
-			"days_enabled": {
-				Type:     schema.TypeSet,
-				Required: true,
-				Elem: &schema.Schema{
-					Type:         schema.TypeString,
-					ValidateFunc: validation.StringInSlice(alertValidDaysOfWeek, false),
-				},
-				Description: fmt.Sprintf("Days of week. Can be one of %q", alertValidDaysOfWeek),
-				Set:         schema.HashString,
-			},
-			"start_time": timeInDaySchema(`Limit the triggering of this alert to start at specific hour.`),
-			"end_time":   timeInDaySchema(`Limit the triggering of this alert to end at specific hour.`),
+func timeInDaySchema(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(alertTimeInDayRegex, "not valid time, only HH:MM format is allowed"),
 		},
+		MarkdownDescription: description,
 	}
 }
 
-func hashTimeFrames() schema.SchemaSetFunc {
-	return schema.HashResource(timeFrames())
-}
-
-func commonAlertSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+func logsFiltersSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
 		"search_query": searchQuerySchema(),
-		"severities": {
-			Type:     schema.TypeSet,
-			Optional: true,
-			Elem: &schema.Schema{
-				Type:         schema.TypeString,
-				ValidateFunc: validation.StringInSlice(alertValidLogSeverities, false),
-			},
-			Description: fmt.Sprintf("An array of log severities that we interested in. Can be one of %q", alertValidLogSeverities),
-			Set:         schema.HashString,
-		},
-		"applications": {
-			Type:     schema.TypeSet,
-			Optional: true,
-			Elem: &schema.Schema{
-				Type: schema.TypeString,
+		"severities":   logSeveritiesSchema(),
+		"applications": schema.SetAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
 			},
-			Description: "An array that contains log’s application names that we want to be alerted on." +
+			MarkdownDescription: "An array that contains log’s application names that we want to be alerted on." +
 				" Applications can be filtered by prefix, suffix, and contains using the next patterns - filter:startsWith:xxx, filter:endsWith:xxx, filter:contains:xxx",
-			Set: schema.HashString,
 		},
-		"subsystems": {
-			Type:     schema.TypeSet,
-			Optional: true,
-			Elem: &schema.Schema{
-				Type: schema.TypeString,
+		"subsystems": schema.SetAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
 			},
-			Description: "An array that contains log’s subsystem names that we want to be notified on. " +
+			MarkdownDescription: "An array that contains log’s subsystem names that we want to be notified on. " +
 				"Subsystems can be filtered by prefix, suffix, and contains using the next patterns - filter:startsWith:xxx, filter:endsWith:xxx, filter:contains:xxx",
-			Set: schema.HashString,
 		},
-		"categories": {
-			Type:     schema.TypeSet,
-			Optional: true,
-			Elem: &schema.Schema{
-				Type: schema.TypeString,
-			},
-			Description: "An array that contains log’s categories that we want to be notified on.",
-			Set:         schema.HashString,
+		"categories":   logsFilterSchema("An array that contains log’s categories that we want to be notified on."),
+		"computers":    logsFilterSchema("An array that contains log’s computer names that we want to be notified on."),
+		"classes":      logsFilterSchema("An array that contains log’s class names that we want to be notified on."),
+		"methods":      logsFilterSchema("An array that contains log’s method names that we want to be notified on."),
+		"ip_addresses": logsFilterSchema("An array that contains log’s IP addresses that we want to be notified on."),
+	}
+}
+
+func logsFilterSchema(description string) schema.SetAttribute {
+	return schema.SetAttribute{
+		Optional:    true,
+		ElementType: types.StringType,
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
 		},
-		"computers": {
-			Type:     schema.TypeSet,
-			Optional: true,
-			Elem: &schema.Schema{
-				Type: schema.TypeString,
-			},
-			Description: "An array that contains log’s computer names that we want to be notified on.",
-			Set:         schema.HashString,
+		MarkdownDescription: description,
+	}
+}
+
+func searchQuerySchema() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+			luceneQueryValidator{},
 		},
-		"classes": {
-			Type:     schema.TypeSet,
-			Optional: true,
-			Elem: &schema.Schema{
-				Type: schema.TypeString,
-			},
-			Description: "An array that contains log’s class names that we want to be notified on.",
-			Set:         schema.HashString,
+		MarkdownDescription: "The search_query that we wanted to be notified on, in Lucene syntax.",
+	}
+}
+
+func logSeveritiesSchema() schema.SetAttribute {
+	return schema.SetAttribute{
+		Optional:    true,
+		ElementType: types.StringType,
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+			setvalidator.ValueStringsAre(stringvalidator.OneOf(alertValidLogSeverities...)),
 		},
-		"methods": {
-			Type:     schema.TypeSet,
-			Optional: true,
-			Elem: &schema.Schema{
-				Type: schema.TypeString,
+		MarkdownDescription: fmt.Sprintf("An array of log severities that we interested in. Can be one of %q", alertValidLogSeverities),
+	}
+}
+
+// conditionOperatorSchema returns the schema of a condition operator flag (e.g. less_than). Exactly one of the
+// operators has to be true, so the rest are left unset rather than being set to false.
+func conditionOperatorSchema(operators []string, validators ...validator.Bool) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional:   true,
+		Validators: validators,
+		MarkdownDescription: fmt.Sprintf("Determines the condition operator. Must be one of - %s.",
+			strings.Join(operators, ", ")),
+	}
+}
+
+func siblingPaths(names ...string) []path.Expression {
+	expressions := make([]path.Expression, 0, len(names))
+	for _, name := range names {
+		expressions = append(expressions, path.MatchRelative().AtParent().AtName(name))
+	}
+	return expressions
+}
+
+func groupBySchema(description string, validators ...validator.List) schema.ListAttribute {
+	return schema.ListAttribute{
+		Optional:            true,
+		ElementType:         types.StringType,
+		Validators:          append([]validator.List{listvalidator.SizeAtLeast(1)}, validators...),
+		MarkdownDescription: description,
+	}
+}
+
+func manageUndetectedValuesSchema(requiredWith ...string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional: true,
+		Computed: true,
+		Attributes: map[string]schema.Attribute{
+			"enable_triggering_on_undetected_values": schema.BoolAttribute{
+				Required:            true,
+				MarkdownDescription: "Determines whether the deadman-option is enabled. When set to true, auto_retire_ratio is required otherwise auto_retire_ratio should be omitted.",
 			},
-			Description: "An array that contains log’s method names that we want to be notified on.",
-			Set:         schema.HashString,
-		},
-		"ip_addresses": {
-			Type:     schema.TypeSet,
-			Optional: true,
-			Elem: &schema.Schema{
-				Type: schema.TypeString,
+			"auto_retire_ratio": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(alertValidDeadmanRatioValues...),
+				},
+				MarkdownDescription: fmt.Sprintf("Defines the triggering auto-retire ratio. Can be one of %q", alertValidDeadmanRatioValues),
 			},
-			Description: "An array that contains log’s IP addresses that we want to be notified on.",
-			Set:         schema.HashString,
 		},
+		Validators: []validator.Object{
+			objectvalidator.AlsoRequires(siblingPaths(requiredWith...)...),
+		},
+		MarkdownDescription: "Manage your logs undetected values - when relevant, enable/disable triggering on undetected values and change the auto retire interval. By default (when relevant), triggering is enabled with retire-ratio=NEVER.",
 	}
 }
 
-func searchQuerySchema() *schema.Schema {
-	return &schema.Schema{
-		Type:         schema.TypeString,
-		Optional:     true,
-		ValidateFunc: validation.StringIsValidRegExp,
-		Description:  "The search_query that we wanted to be notified on.",
+func ignoreInfinitySchema() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+		Validators: []validator.Bool{
+			boolvalidator.ConflictsWith(siblingPaths("less_than")...),
+		},
+		MarkdownDescription: "Not triggered when threshold is infinity (divided by zero).",
 	}
 }
 
-func standardSchema() map[string]*schema.Schema {
-	standardSchema := commonAlertSchema()
-	standardSchema["condition"] = &schema.Schema{
-		Type:     schema.TypeList,
+func standardSchema() map[string]schema.Attribute {
+	operators := []string{"immediately", "less_than", "more_than", "more_than_usual"}
+	standardSchema := logsFiltersSchema()
+	standardSchema["condition"] = schema.SingleNestedAttribute{
 		Required: true,
-		MaxItems: 1,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"immediately": {
-					Type:     schema.TypeBool,
-					Optional: true,
-					ExactlyOneOf: []string{"standard.0.condition.0.immediately",
-						"standard.0.condition.0.more_than",
-						"standard.0.condition.0.less_than",
-						"standard.0.condition.0.more_than_usual"},
-					Description: "Determines the condition operator." +
-						" Must be one of - immediately, less_than, more_than or more_than_usual.",
-				},
-				"less_than": {
-					Type:     schema.TypeBool,
-					Optional: true,
-					ExactlyOneOf: []string{"standard.0.condition.0.immediately",
-						"standard.0.condition.0.more_than",
-						"standard.0.condition.0.less_than",
-						"standard.0.condition.0.more_than_usual"},
-					Description: "Determines the condition operator." +
-						" Must be one of - immediately, less_than, more_than or more_than_usual.",
-					RequiredWith: []string{"standard.0.condition.0.time_window", "standard.0.condition.0.threshold"},
-				},
-				"more_than": {
-					Type:     schema.TypeBool,
-					Optional: true,
-					ExactlyOneOf: []string{"standard.0.condition.0.immediately",
-						"standard.0.condition.0.more_than",
-						"standard.0.condition.0.less_than",
-						"standard.0.condition.0.more_than_usual"},
-					RequiredWith: []string{"standard.0.condition.0.time_window", "standard.0.condition.0.threshold"},
-					Description: "Determines the condition operator." +
-						" Must be one of - immediately, less_than, more_than or more_than_usual.",
-				},
-				"more_than_usual": {
-					Type:     schema.TypeBool,
-					Optional: true,
-					ExactlyOneOf: []string{"standard.0.condition.0.immediately",
-						"standard.0.condition.0.more_than",
-						"standard.0.condition.0.less_than",
-						"standard.0.condition.0.more_than_usual"},
-					Description: "Determines the condition operator." +
-						" Must be one of - immediately, less_than, more_than or more_than_usual.",
-				},
-				"threshold": {
-					Type:          schema.TypeInt,
-					Optional:      true,
-					ConflictsWith: []string{"standard.0.condition.0.immediately"},
-					Description:   "The number of log occurrences that is needed to trigger the alert.",
-				},
-				"time_window": {
-					Type:         schema.TypeString,
-					Optional:     true,
-					ValidateFunc: validation.StringInSlice(alertValidTimeFrames, false),
-					ConflictsWith: []string{"standard.0.condition.0.immediately",
-						"standard.0.condition.0.more_than_usual"},
-					Description: fmt.Sprintf("The bounded time frame for the threshold to be occurred within, to trigger the alert. Can be one of %q", alertValidTimeFrames),
-				},
-				"group_by": {
-					Type:     schema.TypeList,
-					Optional: true,
-					Elem: &schema.Schema{
-						Type: schema.TypeString,
-					},
-					ConflictsWith: []string{"standard.0.condition.0.immediately",
-						"standard.0.condition.0.more_than_usual"},
-					Description: "The fields to 'group by' on. In case of immediately = true switch to group_by_key.",
+		Attributes: map[string]schema.Attribute{
+			"immediately": conditionOperatorSchema(operators,
+				boolvalidator.ExactlyOneOf(siblingPaths("less_than", "more_than", "more_than_usual")...)),
+			"less_than": conditionOperatorSchema(operators,
+				boolvalidator.AlsoRequires(siblingPaths("time_window", "threshold")...)),
+			"more_than": conditionOperatorSchema(operators,
+				boolvalidator.AlsoRequires(siblingPaths("time_window", "threshold")...)),
+			"more_than_usual": conditionOperatorSchema(operators),
+			"threshold": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.ConflictsWith(siblingPaths("immediately")...),
 				},
-				"group_by_key": {
-					Type:     schema.TypeString,
-					Optional: true,
-					ConflictsWith: []string{"standard.0.condition.0.immediately",
-						"standard.0.condition.0.more_than", "standard.0.condition.0.less_than"},
-					Description: "The key to 'group by' on. When more_than_usual = true, 'group_by_key' (single string) can be set instead of 'group_by'.",
+				MarkdownDescription: "The number of log occurrences that is needed to trigger the alert.",
+			},
+			"time_window": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(alertValidTimeFrames...),
+					stringvalidator.ConflictsWith(siblingPaths("immediately", "more_than_usual")...),
 				},
-				"manage_undetected_values": {
-					Type:     schema.TypeList,
-					Optional: true,
-					Computed: true,
-					MaxItems: 1,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"enable_triggering_on_undetected_values": {
-								Type:        schema.TypeBool,
-								Required:    true,
-								Description: "Determines whether the deadman-option is enabled. When set to true, auto_retire_ratio is required otherwise auto_retire_ratio should be omitted.",
-							},
-							"auto_retire_ratio": {
-								Type:         schema.TypeString,
-								Optional:     true,
-								ValidateFunc: validation.StringInSlice(alertValidDeadmanRatioValues, false),
-								Description:  fmt.Sprintf("Defines the triggering auto-retire ratio. Can be one of %q", alertValidDeadmanRatioValues),
-							},
-						},
-					},
-					RequiredWith: []string{"standard.0.condition.0.less_than", "standard.0.condition.0.group_by"},
-					Description:  "Manage your logs undetected values - when relevant, enable/disable triggering on undetected values and change the auto retire interval. By default (when relevant), triggering is enabled with retire-ratio=NEVER.",
+				MarkdownDescription: fmt.Sprintf("The bounded time frame for the threshold to be occurred within, to trigger the alert. Can be one of %q", alertValidTimeFrames),
+			},
+			"group_by": groupBySchema("The fields to 'group by' on. In case of immediately = true switch to group_by_key.",
+				listvalidator.ConflictsWith(siblingPaths("immediately", "more_than_usual")...)),
+			"group_by_key": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(siblingPaths("immediately", "more_than", "less_than")...),
 				},
-				"evaluation_window": {
-					Type:         schema.TypeString,
-					Optional:     true,
-					Computed:     true,
-					ValidateFunc: validation.StringInSlice(validEvaluationWindow, false),
-					RequiredWith: []string{"standard.0.condition.0.more_than"},
-					Description:  fmt.Sprintf("Defines the evaluation-window logic to determine if the threshold has been crossed. Relevant only for more_than condition. Can be one of %q.", validEvaluationWindow),
+				MarkdownDescription: "The key to 'group by' on. When more_than_usual = true, 'group_by_key' (single string) can be set instead of 'group_by'.",
+			},
+			"manage_undetected_values": manageUndetectedValuesSchema("less_than", "group_by"),
+			"evaluation_window": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(validEvaluationWindow...),
+					stringvalidator.AlsoRequires(siblingPaths("more_than")...),
 				},
+				MarkdownDescription: fmt.Sprintf("Defines the evaluation-window logic to determine if the threshold has been crossed. Relevant only for more_than condition. Can be one of %q.", validEvaluationWindow),
 			},
 		},
-		Description: "Defines the conditions for triggering and notify by the alert",
+		MarkdownDescription: "Defines the conditions for triggering and notify by the alert",
 	}
 	return standardSchema
 }
 
-func ratioSchema() map[string]*schema.Schema {
-	query1Schema := commonAlertSchema()
-	query1Schema["alias"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Default:     "Query 1",
-		Description: "Query1 alias.",
+func ratioSchema() map[string]schema.Attribute {
+	operators := []string{"less_than", "more_than"}
+	query1Schema := logsFiltersSchema()
+	query1Schema["alias"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString("Query 1"),
+		MarkdownDescription: "Query1 alias.",
+	}
+	groupByQueryValidators := func(conflictsWith ...string) []validator.Bool {
+		return []validator.Bool{
+			boolvalidator.AlsoRequires(siblingPaths("group_by")...),
+			boolvalidator.ConflictsWith(siblingPaths(conflictsWith...)...),
+		}
 	}
 
-	return map[string]*schema.Schema{
-		"query_1": {
-			Type:     schema.TypeList,
-			Required: true,
-			MaxItems: 1,
-			Elem: &schema.Resource{
-				Schema: query1Schema,
-			},
+	return map[string]schema.Attribute{
+		"query_1": schema.SingleNestedAttribute{
+			Required:   true,
+			Attributes: query1Schema,
 		},
-		"query_2": {
-			Type:     schema.TypeList,
+		"query_2": schema.SingleNestedAttribute{
 			Required: true,
-			MaxItems: 1,
-			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					"alias": {
-						Type:        schema.TypeString,
-						Optional:    true,
-						Default:     "Query 2",
-						Description: "Query2 alias.",
-					},
-					"search_query": searchQuerySchema(),
-					"severities": {
-						Type:     schema.TypeSet,
-						Optional: true,
-						Elem: &schema.Schema{
-							Type:         schema.TypeString,
-							ValidateFunc: validation.StringInSlice(alertValidLogSeverities, false),
-						},
-						Description: fmt.Sprintf("An array of log severities that we interested in. Can be one of %q", alertValidLogSeverities),
-						Set:         schema.HashString,
-					},
-					"applications": {
-						Type:     schema.TypeSet,
-						Optional: true,
-						Elem: &schema.Schema{
-							Type: schema.TypeString,
-						},
-						Description: "An array that contains log’s application names that we want to be alerted on." +
-							" Applications can be filtered by prefix, suffix, and contains using the next patterns - filter:startsWith:xxx, filter:endsWith:xxx, filter:contains:xxx",
-						Set: schema.HashString,
+			Attributes: map[string]schema.Attribute{
+				"alias": schema.StringAttribute{
+					Optional:            true,
+					Computed:            true,
+					Default:             stringdefault.StaticString("Query 2"),
+					MarkdownDescription: "Query2 alias.",
+				},
+				"search_query": searchQuerySchema(),
+				"severities":   logSeveritiesSchema(),
+				"applications": schema.SetAttribute{
+					Optional:    true,
+					ElementType: types.StringType,
+					Validators: []validator.Set{
+						setvalidator.SizeAtLeast(1),
 					},
-					"subsystems": {
-						Type:     schema.TypeSet,
-						Optional: true,
-						Elem: &schema.Schema{
-							Type: schema.TypeString,
-						},
-						Description: "An array that contains log’s subsystem names that we want to be notified on. " +
-							"Subsystems can be filtered by prefix, suffix, and contains using the next patterns - filter:startsWith:xxx, filter:endsWith:xxx, filter:contains:xxx",
-						Set: schema.HashString,
+					MarkdownDescription: "An array that contains log’s application names that we want to be alerted on." +
+						" Applications can be filtered by prefix, suffix, and contains using the next patterns - filter:startsWith:xxx, filter:endsWith:xxx, filter:contains:xxx",
+				},
+				"subsystems": schema.SetAttribute{
+					Optional:    true,
+					ElementType: types.StringType,
+					Validators: []validator.Set{
+						setvalidator.SizeAtLeast(1),
 					},
+					MarkdownDescription: "An array that contains log’s subsystem names that we want to be notified on. " +
+						"Subsystems can be filtered by prefix, suffix, and contains using the next patterns - filter:startsWith:xxx, filter:endsWith:xxx, filter:contains:xxx",
 				},
 			},
 		},
-		"condition": {
-			Type:     schema.TypeList,
+		"condition": schema.SingleNestedAttribute{
 			Required: true,
-			MaxItems: 1,
-			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					"more_than": {
-						Type:         schema.TypeBool,
-						Optional:     true,
-						ExactlyOneOf: []string{"ratio.0.condition.0.more_than", "ratio.0.condition.0.less_than"},
-						Description: "Determines the condition operator." +
-							" Must be one of - less_than or more_than.",
-					},
-					"less_than": {
-						Type:         schema.TypeBool,
-						Optional:     true,
-						ExactlyOneOf: []string{"ratio.0.condition.0.more_than", "ratio.0.condition.0.less_than"},
-					},
-					"ratio_threshold": {
-						Type:        schema.TypeFloat,
-						Required:    true,
-						Description: "The ratio(between the queries) threshold that is needed to trigger the alert.",
-					},
-					"time_window": {
-						Type:         schema.TypeString,
-						Required:     true,
-						ValidateFunc: validation.StringInSlice(alertValidTimeFrames, false),
-						Description:  fmt.Sprintf("The bounded time frame for the threshold to be occurred within, to trigger the alert. Can be one of %q", alertValidTimeFrames),
-					},
-					"ignore_infinity": {
-						Type:          schema.TypeBool,
-						Optional:      true,
-						ConflictsWith: []string{"ratio.0.condition.0.less_than"},
-						Description:   "Not triggered when threshold is infinity (divided by zero).",
-					},
-					"group_by": {
-						Type:     schema.TypeList,
-						Optional: true,
-						Elem: &schema.Schema{
-							Type: schema.TypeString,
-						},
-						Description: "The fields to 'group by' on.",
-					},
-					"group_by_q1": {
-						Type:         schema.TypeBool,
-						Optional:     true,
-						RequiredWith: []string{"ratio.0.condition.0.group_by"},
-						ConflictsWith: []string{"ratio.0.condition.0.group_by_q2",
-							"ratio.0.condition.0.group_by_both"},
-					},
-					"group_by_q2": {
-						Type:         schema.TypeBool,
-						Optional:     true,
-						RequiredWith: []string{"ratio.0.condition.0.group_by"},
-						ConflictsWith: []string{"ratio.0.condition.0.group_by_q1",
-							"ratio.0.condition.0.group_by_both"},
-					},
-					"group_by_both": {
-						Type:         schema.TypeBool,
-						Optional:     true,
-						RequiredWith: []string{"ratio.0.condition.0.group_by"},
-						ConflictsWith: []string{"ratio.0.condition.0.group_by_q1",
-							"ratio.0.condition.0.group_by_q2"},
-					},
-					"manage_undetected_values": {
-						Type:     schema.TypeList,
-						Optional: true,
-						Computed: true,
-						MaxItems: 1,
-						Elem: &schema.Resource{
-							Schema: map[string]*schema.Schema{
-								"enable_triggering_on_undetected_values": {
-									Type:        schema.TypeBool,
-									Required:    true,
-									Description: "Determines whether the deadman-option is enabled. When set to true, auto_retire_ratio is required otherwise auto_retire_ratio should be omitted.",
-								},
-								"auto_retire_ratio": {
-									Type:         schema.TypeString,
-									Optional:     true,
-									ValidateFunc: validation.StringInSlice(alertValidDeadmanRatioValues, false),
-									Description:  fmt.Sprintf("Defines the triggering auto-retire ratio. Can be one of %q", alertValidDeadmanRatioValues),
-								},
-							},
-						},
-						RequiredWith: []string{"ratio.0.condition.0.less_than", "ratio.0.condition.0.group_by"},
-						Description:  "Manage your logs undetected values - when relevant, enable/disable triggering on undetected values and change the auto retire interval. By default (when relevant), triggering is enabled with retire-ratio=NEVER.",
-					},
+			Attributes: map[string]schema.Attribute{
+				"more_than": conditionOperatorSchema(operators,
+					boolvalidator.ExactlyOneOf(siblingPaths("less_than")...)),
+				"less_than": conditionOperatorSchema(operators),
+				"ratio_threshold": schema.Float64Attribute{
+					Required:            true,
+					MarkdownDescription: "The ratio(between the queries) threshold that is needed to trigger the alert.",
 				},
+				"time_window": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(alertValidTimeFrames...),
+					},
+					MarkdownDescription: fmt.Sprintf("The bounded time frame for the threshold to be occurred within, to trigger the alert. Can be one of %q", alertValidTimeFrames),
+				},
+				"ignore_infinity": ignoreInfinitySchema(),
+				"group_by":        groupBySchema("The fields to 'group by' on."),
+				"group_by_q1": schema.BoolAttribute{
+					Optional:            true,
+					Validators:          groupByQueryValidators("group_by_q2", "group_by_both"),
+					MarkdownDescription: "Determines that the group_by is applied on query_1. Conflicts with group_by_q2 and group_by_both.",
+				},
+				"group_by_q2": schema.BoolAttribute{
+					Optional:            true,
+					Validators:          groupByQueryValidators("group_by_q1", "group_by_both"),
+					MarkdownDescription: "Determines that the group_by is applied on query_2. Conflicts with group_by_q1 and group_by_both.",
+				},
+				"group_by_both": schema.BoolAttribute{
+					Optional:            true,
+					Validators:          groupByQueryValidators("group_by_q1", "group_by_q2"),
+					MarkdownDescription: "Determines that the group_by is applied on both queries. Conflicts with group_by_q1 and group_by_q2.",
+				},
+				"manage_undetected_values": manageUndetectedValuesSchema("less_than", "group_by"),
 			},
-			Description: "Defines the conditions for triggering and notify by the alert",
+			MarkdownDescription: "Defines the conditions for triggering and notify by the alert",
 		},
 	}
 }
 
-func newValueSchema() map[string]*schema.Schema {
-	newValueSchema := commonAlertSchema()
-	newValueSchema["condition"] = &schema.Schema{
-		Type:     schema.TypeList,
+func newValueSchema() map[string]schema.Attribute {
+	newValueSchema := logsFiltersSchema()
+	newValueSchema["condition"] = schema.SingleNestedAttribute{
 		Required: true,
-		MaxItems: 1,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"key_to_track": {
-					Type:         schema.TypeString,
-					Required:     true,
-					ValidateFunc: validation.StringIsNotEmpty,
-					Description: "Select a key to track. Note, this key needs to have less than 50K unique values in" +
-						" the defined timeframe.",
+		Attributes: map[string]schema.Attribute{
+			"key_to_track": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
 				},
-				"time_window": {
-					Type:         schema.TypeString,
-					Required:     true,
-					ValidateFunc: validation.StringInSlice(alertValidNewValueTimeFrames, false),
-					Description:  fmt.Sprintf("The bounded time frame for the threshold to be occurred within, to trigger the alert. Can be one of %q", alertValidNewValueTimeFrames),
+				MarkdownDescription: "Select a key to track. Note, this key needs to have less than 50K unique values in" +
+					" the defined timeframe.",
+			},
+			"time_window": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(alertValidNewValueTimeFrames...),
 				},
+				MarkdownDescription: fmt.Sprintf("The bounded time frame for the threshold to be occurred within, to trigger the alert. Can be one of %q", alertValidNewValueTimeFrames),
 			},
 		},
-		Description: "Defines the conditions for triggering and notify by the alert",
+		MarkdownDescription: "Defines the conditions for triggering and notify by the alert",
 	}
 	return newValueSchema
 }
 
-func uniqueCountSchema() map[string]*schema.Schema {
-	uniqueCountSchema := commonAlertSchema()
-	uniqueCountSchema["condition"] = &schema.Schema{
-		Type:     schema.TypeList,
+func uniqueCountSchema() map[string]schema.Attribute {
+	uniqueCountSchema := logsFiltersSchema()
+	uniqueCountSchema["condition"] = schema.SingleNestedAttribute{
 		Required: true,
-		MaxItems: 1,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"unique_count_key": {
-					Type:         schema.TypeString,
-					Required:     true,
-					ValidateFunc: validation.StringIsNotEmpty,
-					Description:  "Defines the key to match to track its unique count.",
-				},
-				"max_unique_values": {
-					Type:     schema.TypeInt,
-					Required: true,
+		Attributes: map[string]schema.Attribute{
+			"unique_count_key": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
 				},
-				"time_window": {
-					Type:         schema.TypeString,
-					Required:     true,
-					ValidateFunc: validation.StringInSlice(alertValidUniqueCountTimeFrames, false),
-					Description:  fmt.Sprintf("The bounded time frame for the threshold to be occurred within, to trigger the alert. Can be one of %q", alertValidUniqueCountTimeFrames),
+				MarkdownDescription: "Defines the key to match to track its unique count.",
+			},
+			"max_unique_values": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The maximum unique values that can be matched before triggering the alert.",
+			},
+			"time_window": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(alertValidUniqueCountTimeFrames...),
 				},
-				"group_by_key": {
-					Type:         schema.TypeString,
-					Optional:     true,
-					RequiredWith: []string{"unique_count.0.condition.0.max_unique_values_for_group_by"},
-					Description:  "The key to 'group by' on.",
+				MarkdownDescription: fmt.Sprintf("The bounded time frame for the threshold to be occurred within, to trigger the alert. Can be one of %q", alertValidUniqueCountTimeFrames),
+			},
+			"group_by_key": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(siblingPaths("max_unique_values_for_group_by")...),
 				},
-				"max_unique_values_for_group_by": {
-					Type:         schema.TypeInt,
-					Optional:     true,
-					RequiredWith: []string{"unique_count.0.condition.0.group_by_key"},
+				MarkdownDescription: "The key to 'group by' on.",
+			},
+			"max_unique_values_for_group_by": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(siblingPaths("group_by_key")...),
 				},
+				MarkdownDescription: "The maximum unique values per group-by key that can be matched before triggering the alert.",
 			},
 		},
-		Description: "Defines the conditions for triggering and notify by the alert",
+		MarkdownDescription: "Defines the conditions for triggering and notify by the alert",
 	}
 	return uniqueCountSchema
 }
 
-func timeRelativeSchema() map[string]*schema.Schema {
-	timeRelativeSchema := commonAlertSchema()
-	timeRelativeSchema["condition"] = &schema.Schema{
-		Type:     schema.TypeList,
+func timeRelativeSchema() map[string]schema.Attribute {
+	operators := []string{"less_than", "more_than"}
+	timeRelativeSchema := logsFiltersSchema()
+	timeRelativeSchema["condition"] = schema.SingleNestedAttribute{
 		Required: true,
-		MaxItems: 1,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"less_than": {
-					Type:     schema.TypeBool,
-					Optional: true,
-					ExactlyOneOf: []string{"time_relative.0.condition.0.more_than",
-						"time_relative.0.condition.0.less_than"},
-					Description: "Determines the condition operator." +
-						" Must be one of - less_than or more_than.",
-				},
-				"more_than": {
-					Type:     schema.TypeBool,
-					Optional: true,
-					ExactlyOneOf: []string{"time_relative.0.condition.0.more_than",
-						"time_relative.0.condition.0.less_than"},
-					Description: "Determines the condition operator." +
-						" Must be one of - less_than or more_than.",
-				},
-				"ratio_threshold": {
-					Type:        schema.TypeFloat,
-					Required:    true,
-					Description: "The ratio threshold that is needed to trigger the alert.",
-				},
-				"relative_time_window": {
-					Type:         schema.TypeString,
-					Required:     true,
-					ValidateFunc: validation.StringInSlice(alertValidRelativeTimeFrames, false),
-					Description:  fmt.Sprintf("Time-window to compare with. Can be one of %q.", alertValidRelativeTimeFrames),
-				},
-				"ignore_infinity": {
-					Type:          schema.TypeBool,
-					Optional:      true,
-					ConflictsWith: []string{"time_relative.0.condition.0.less_than"},
-					Description:   "Not triggered when threshold is infinity (divided by zero).",
-				},
-				"group_by": {
-					Type:     schema.TypeList,
-					Optional: true,
-					Elem: &schema.Schema{
-						Type: schema.TypeString,
-					},
-					Description: "The fields to 'group by' on.",
-				},
-				"manage_undetected_values": {
-					Type:     schema.TypeList,
-					Optional: true,
-					Computed: true,
-					MaxItems: 1,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"enable_triggering_on_undetected_values": {
-								Type:        schema.TypeBool,
-								Required:    true,
-								Description: "Determines whether the deadman-option is enabled. When set to true, auto_retire_ratio is required otherwise auto_retire_ratio should be omitted.",
-							},
-							"auto_retire_ratio": {
-								Type:         schema.TypeString,
-								Optional:     true,
-								ValidateFunc: validation.StringInSlice(alertValidDeadmanRatioValues, false),
-								Description:  fmt.Sprintf("Defines the triggering auto-retire ratio. Can be one of %q", alertValidDeadmanRatioValues),
-							},
-						},
-					},
-					RequiredWith: []string{"time_relative.0.condition.0.less_than", "time_relative.0.condition.0.group_by"},
-					Description:  "Manage your logs undetected values - when relevant, enable/disable triggering on undetected values and change the auto retire interval. By default (when relevant), triggering is enabled with retire-ratio=NEVER.",
+		Attributes: map[string]schema.Attribute{
+			"less_than": conditionOperatorSchema(operators,
+				boolvalidator.ExactlyOneOf(siblingPaths("more_than")...)),
+			"more_than": conditionOperatorSchema(operators),
+			"ratio_threshold": schema.Float64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ratio threshold that is needed to trigger the alert.",
+			},
+			"relative_time_window": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(alertValidRelativeTimeFrames...),
 				},
+				MarkdownDescription: fmt.Sprintf("Time-window to compare with. Can be one of %q.", alertValidRelativeTimeFrames),
 			},
+			"ignore_infinity":          ignoreInfinitySchema(),
+			"group_by":                 groupBySchema("The fields to 'group by' on."),
+			"manage_undetected_values": manageUndetectedValuesSchema("less_than", "group_by"),
 		},
-		Description: "Defines the conditions for triggering and notify by the alert",
+		MarkdownDescription: "Defines the conditions for triggering and notify by the alert",
 	}
 	return timeRelativeSchema
 }
 
-func metricSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
-		"lucene": {
-			Type:     schema.TypeList,
-			MaxItems: 1,
+func metricSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"lucene": schema.SingleNestedAttribute{
 			Optional: true,
-			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					"search_query": {
-						Type:         schema.TypeString,
-						Required:     true,
-						ValidateFunc: validation.StringIsValidRegExp,
-						Description:  "Regular expiration. More info: https://coralogix.com/blog/regex-101/",
-					},
-					"condition": {
-						Type:     schema.TypeList,
-						Required: true,
-						MaxItems: 1,
-						Elem: &schema.Resource{
-							Schema: map[string]*schema.Schema{
-								"metric_field": {
-									Type:        schema.TypeString,
-									Required:    true,
-									Description: "The name of the metric field to alert on.",
-								},
-								"arithmetic_operator": {
-									Type:         schema.TypeString,
-									Required:     true,
-									ValidateFunc: validation.StringInSlice(alertValidArithmeticOperators, false),
-									Description:  fmt.Sprintf("The arithmetic operator to use on the alert. can be one of %q", alertValidArithmeticOperators),
-								},
-								"arithmetic_operator_modifier": {
-									Type:         schema.TypeInt,
-									Optional:     true,
-									ValidateFunc: validation.IntBetween(0, 100),
-									Description:  "When arithmetic_operator = \"Percentile\" you need to supply the value in this property, 0 < value < 100.",
-								},
-								"less_than": {
-									Type:     schema.TypeBool,
-									Optional: true,
-									ExactlyOneOf: []string{"metric.0.lucene.0.condition.0.less_than",
-										"metric.0.lucene.0.condition.0.more_than"},
-									Description: "Determines the condition operator." +
-										" Must be one of - less_than or more_than.",
-								},
-								"more_than": {
-									Type:     schema.TypeBool,
-									Optional: true,
-									ExactlyOneOf: []string{"metric.0.lucene.0.condition.0.less_than",
-										"metric.0.lucene.0.condition.0.more_than"},
-									Description: "Determines the condition operator." +
-										" Must be one of - less_than or more_than.",
-								},
-								"threshold": {
-									Type:        schema.TypeFloat,
-									Required:    true,
-									Description: "The number of log threshold that is needed to trigger the alert.",
-								},
-								"sample_threshold_percentage": {
-									Type:         schema.TypeInt,
-									Required:     true,
-									ValidateFunc: validation.All(validation.IntDivisibleBy(10), validation.IntBetween(0, 100)),
-									Description:  "The metric value must cross the threshold within this percentage of the timeframe (sum and count arithmetic operators do not use this parameter since they aggregate over the entire requested timeframe), increments of 10, 0 <= value <= 100.",
-								},
-								"time_window": {
-									Type:         schema.TypeString,
-									Required:     true,
-									ValidateFunc: validation.StringInSlice(alertValidMetricTimeFrames, false),
-									Description:  fmt.Sprintf("The bounded time frame for the threshold to be occurred within, to trigger the alert. Can be one of %q", alertValidMetricTimeFrames),
-								},
-								"group_by": {
-									Type:     schema.TypeList,
-									Optional: true,
-									Elem: &schema.Schema{
-										Type: schema.TypeString,
-									},
-									Description: "The fields to 'group by' on.",
-								},
-								"replace_missing_value_with_zero": {
-									Type:          schema.TypeBool,
-									Optional:      true,
-									ConflictsWith: []string{"metric.0.lucene.0.condition.0.min_non_null_values_percentage"},
-									Description:   "If set to true, missing data will be considered as 0, otherwise, it will not be considered at all.",
-								},
-								"min_non_null_values_percentage": {
-									Type:          schema.TypeInt,
-									Optional:      true,
-									ValidateFunc:  validation.All(validation.IntDivisibleBy(10), validation.IntBetween(0, 100)),
-									ConflictsWith: []string{"metric.0.lucene.0.condition.0.replace_missing_value_with_zero"},
-									Description:   "The minimum percentage of the timeframe that should have values for this alert to trigger",
-								},
-								"manage_undetected_values": {
-									Type:     schema.TypeList,
-									Optional: true,
-									Computed: true,
-									MaxItems: 1,
-									Elem: &schema.Resource{
-										Schema: map[string]*schema.Schema{
-											"enable_triggering_on_undetected_values": {
-												Type:        schema.TypeBool,
-												Required:    true,
-												Description: "Determines whether the deadman-option is enabled. When set to true, auto_retire_ratio is required otherwise auto_retire_ratio should be omitted.",
-											},
-											"auto_retire_ratio": {
-												Type:         schema.TypeString,
-												Optional:     true,
-												ValidateFunc: validation.StringInSlice(alertValidDeadmanRatioValues, false),
-												Description:  fmt.Sprintf("Defines the triggering auto-retire ratio. Can be one of %q", alertValidDeadmanRatioValues),
-											},
-										},
-									},
-									RequiredWith: []string{"metric.0.lucene.0.condition.0.less_than", "metric.0.lucene.0.condition.0.group_by"},
-									Description:  "Manage your logs undetected values - when relevant, enable/disable triggering on undetected values and change the auto retire interval. By default (when relevant), triggering is enabled with retire-ratio=NEVER.",
-								},
-							},
-						},
-						Description: "Defines the conditions for triggering and notify by the alert",
+			Attributes: map[string]schema.Attribute{
+				"search_query": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						luceneQueryValidator{},
 					},
+					MarkdownDescription: "The search_query that we wanted to be notified on, in Lucene syntax.",
+				},
+				"condition": schema.SingleNestedAttribute{
+					Required:            true,
+					Attributes:          luceneMetricConditionSchema(),
+					MarkdownDescription: "Defines the conditions for triggering and notify by the alert",
 				},
 			},
-			ExactlyOneOf: []string{"metric.0.lucene", "metric.0.promql"},
+			Validators: []validator.Object{
+				objectvalidator.ExactlyOneOf(siblingPaths("promql")...),
+			},
 		},
-		"promql": {
-			Type:     schema.TypeList,
-			MaxItems: 1,
+		"promql": schema.SingleNestedAttribute{
 			Optional: true,
-			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					"search_query": {
-						Type:         schema.TypeString,
-						Required:     true,
-						ValidateFunc: validation.StringIsValidRegExp,
-						Description:  "Regular expiration. More info: https://coralogix.com/blog/regex-101/",
-					},
-					"condition": {
-						Type:     schema.TypeList,
-						Required: true,
-						MaxItems: 1,
-						Elem: &schema.Resource{
-							Schema: map[string]*schema.Schema{
-								"less_than": {
-									Type:     schema.TypeBool,
-									Optional: true,
-									ExactlyOneOf: []string{"metric.0.promql.0.condition.0.less_than",
-										"metric.0.promql.0.condition.0.more_than",
-										"metric.0.promql.0.condition.0.more_than_usual"},
-									Description: "Determines the condition operator." +
-										" Must be one of - less_than or more_than.",
-								},
-								"more_than": {
-									Type:     schema.TypeBool,
-									Optional: true,
-									ExactlyOneOf: []string{"metric.0.promql.0.condition.0.less_than",
-										"metric.0.promql.0.condition.0.more_than",
-										"metric.0.promql.0.condition.0.more_than_usual"},
-									Description: "Determines the condition operator." +
-										" Must be one of - less_than or more_than.",
-								},
-								"more_than_usual": {
-									Type:     schema.TypeBool,
-									Optional: true,
-									ExactlyOneOf: []string{"metric.0.promql.0.condition.0.more_than",
-										"metric.0.promql.0.condition.0.less_than",
-										"metric.0.promql.0.condition.0.more_than_usual"},
-									Description: "Determines the condition operator." +
-										" Must be one of - immediately, less_than, more_than or more_than_usual.",
-								},
-								"threshold": {
-									Type:        schema.TypeFloat,
-									Required:    true,
-									Description: "The threshold that is needed to trigger the alert.",
-								},
-								"time_window": {
-									Type:         schema.TypeString,
-									Required:     true,
-									ValidateFunc: validation.StringInSlice(alertValidMetricTimeFrames, false),
-									Description:  fmt.Sprintf("The bounded time frame for the threshold to be occurred within, to trigger the alert. Can be one of %q", alertValidMetricTimeFrames),
-								},
-								"sample_threshold_percentage": {
-									Type:         schema.TypeInt,
-									Required:     true,
-									ValidateFunc: validation.All(validation.IntDivisibleBy(10), validation.IntBetween(0, 100)),
-								},
-								"replace_missing_value_with_zero": {
-									Type:          schema.TypeBool,
-									Optional:      true,
-									ConflictsWith: []string{"metric.0.promql.0.condition.0.min_non_null_values_percentage", "metric.0.promql.0.condition.0.more_than_usual"},
-									Description:   "If set to true, missing data will be considered as 0, otherwise, it will not be considered at all.",
-								},
-								"min_non_null_values_percentage": {
-									Type:          schema.TypeInt,
-									Optional:      true,
-									ConflictsWith: []string{"metric.0.promql.0.condition.0.replace_missing_value_with_zero"},
-									ValidateFunc:  validation.All(validation.IntDivisibleBy(10), validation.IntBetween(0, 100)),
-								},
-								"manage_undetected_values": {
-									Type:     schema.TypeList,
-									Optional: true,
-									Computed: true,
-									MaxItems: 1,
-									Elem: &schema.Resource{
-										Schema: map[string]*schema.Schema{
-											"enable_triggering_on_undetected_values": {
-												Type:        schema.TypeBool,
-												Required:    true,
-												Description: "Determines whether the deadman-option is enabled. When set to true, auto_retire_ratio is required otherwise auto_retire_ratio should be omitted.",
-											},
-											"auto_retire_ratio": {
-												Type:         schema.TypeString,
-												Optional:     true,
-												ValidateFunc: validation.StringInSlice(alertValidDeadmanRatioValues, false),
-												Description:  fmt.Sprintf("Defines the triggering auto-retire ratio. Can be one of %q", alertValidDeadmanRatioValues),
-											},
-										},
-									},
-									RequiredWith: []string{"metric.0.promql.0.condition.0.less_than"},
-									Description:  "Manage your logs undetected values - when relevant, enable/disable triggering on undetected values and change the auto retire interval. By default (when relevant), triggering is enabled with retire-ratio=NEVER.",
-								},
-							},
-						},
-						Description: "Defines the conditions for triggering and notify by the alert",
+			Attributes: map[string]schema.Attribute{
+				"search_query": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						promqlQueryValidator{},
 					},
+					MarkdownDescription: "The PromQL query that we wanted to be notified on.",
+				},
+				"condition": schema.SingleNestedAttribute{
+					Required:            true,
+					Attributes:          promqlMetricConditionSchema(),
+					MarkdownDescription: "Defines the conditions for triggering and notify by the alert",
 				},
 			},
-			ExactlyOneOf: []string{"metric.0.lucene", "metric.0.promql"},
 		},
 	}
 }
 
-func tracingSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
-		"applications": {
-			Type:     schema.TypeSet,
-			Optional: true,
-			Elem: &schema.Schema{
-				Type: schema.TypeString,
+func luceneMetricConditionSchema() map[string]schema.Attribute {
+	operators := []string{"less_than", "more_than"}
+	return map[string]schema.Attribute{
+		"metric_field": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "The name of the metric field to alert on.",
+		},
+		"arithmetic_operator": schema.StringAttribute{
+			Required: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(alertValidArithmeticOperators...),
 			},
-			Description: "An array that contains log’s application names that we want to be alerted on." +
-				" Applications can be filtered by prefix, suffix, and contains using the next patterns - filter:notEquals:xxx, filter:startsWith:xxx, filter:endsWith:xxx, filter:contains:xxx",
-			Set: schema.HashString,
+			MarkdownDescription: fmt.Sprintf("The arithmetic operator to use on the alert. can be one of %q", alertValidArithmeticOperators),
 		},
-		"subsystems": {
-			Type:     schema.TypeSet,
+		"arithmetic_operator_modifier": schema.Int64Attribute{
 			Optional: true,
-			Elem: &schema.Schema{
-				Type: schema.TypeString,
+			Computed: true,
+			Default:  int64default.StaticInt64(0),
+			Validators: []validator.Int64{
+				int64validator.Between(0, 100),
 			},
-			Description: "An array that contains log’s subsystems names that we want to be alerted on." +
-				" Applications can be filtered by prefix, suffix, and contains using the next patterns - filter:notEquals:xxx, filter:startsWith:xxx, filter:endsWith:xxx, filter:contains:xxx",
-			Set: schema.HashString,
+			MarkdownDescription: "When arithmetic_operator = \"Percentile\" you need to supply the value in this property, 0 < value < 100.",
 		},
-		"services": {
-			Type:     schema.TypeSet,
-			Optional: true,
-			Elem: &schema.Schema{
-				Type: schema.TypeString,
+		"less_than": conditionOperatorSchema(operators,
+			boolvalidator.ExactlyOneOf(siblingPaths("more_than")...)),
+		"more_than": conditionOperatorSchema(operators),
+		"threshold": schema.Float64Attribute{
+			Required:            true,
+			MarkdownDescription: "The number of log threshold that is needed to trigger the alert.",
+		},
+		"sample_threshold_percentage": sampleThresholdPercentageSchema(),
+		"time_window": schema.StringAttribute{
+			Required: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(alertValidMetricTimeFrames...),
+			},
+			MarkdownDescription: fmt.Sprintf("The bounded time frame for the threshold to be occurred within, to trigger the alert. Can be one of %q", alertValidMetricTimeFrames),
+		},
+		"group_by":                        groupBySchema("The fields to 'group by' on."),
+		"replace_missing_value_with_zero": replaceMissingValueWithZeroSchema(),
+		"min_non_null_values_percentage":  minNonNullValuesPercentageSchema(),
+		"manage_undetected_values":        manageUndetectedValuesSchema("less_than", "group_by"),
+	}
+}
+
+func promqlMetricConditionSchema() map[string]schema.Attribute {
+	operators := []string{"less_than", "more_than", "more_than_usual"}
+	return map[string]schema.Attribute{
+		"less_than": conditionOperatorSchema(operators,
+			boolvalidator.ExactlyOneOf(siblingPaths("more_than", "more_than_usual")...)),
+		"more_than":       conditionOperatorSchema(operators),
+		"more_than_usual": conditionOperatorSchema(operators),
+		"threshold": schema.Float64Attribute{
+			Required:            true,
+			MarkdownDescription: "The threshold that is needed to trigger the alert.",
+		},
+		"time_window": schema.StringAttribute{
+			Required: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(alertValidMetricTimeFrames...),
 			},
-			Description: "An array that contains log’s services names that we want to be alerted on." +
-				" Applications can be filtered by prefix, suffix, and contains using the next patterns - filter:notEquals:xxx, filter:startsWith:xxx, filter:endsWith:xxx, filter:contains:xxx",
-			Set: schema.HashString,
+			MarkdownDescription: fmt.Sprintf("The bounded time frame for the threshold to be occurred within, to trigger the alert. Can be one of %q", alertValidMetricTimeFrames),
 		},
-		"tag_filter": {
-			Type:     schema.TypeSet,
+		"sample_threshold_percentage": sampleThresholdPercentageSchema(),
+		"group_by": groupBySchema("The labels to 'group by' on, in addition to the query's own aggregation. " +
+			"When more_than_usual = true, a baseline is learned per group."),
+		"replace_missing_value_with_zero": replaceMissingValueWithZeroSchema("more_than_usual"),
+		"min_non_null_values_percentage":  minNonNullValuesPercentageSchema(),
+		"manage_undetected_values":        manageUndetectedValuesSchema("less_than"),
+		"evaluation_window": schema.StringAttribute{
 			Optional: true,
-			Elem:     tagFilterSchema(),
-			Set:      schema.HashResource(tagFilterSchema()),
+			Computed: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(validEvaluationWindow...),
+				stringvalidator.AlsoRequires(siblingPaths("more_than")...),
+			},
+			MarkdownDescription: fmt.Sprintf("Defines the evaluation-window logic to determine if the threshold has been crossed. Relevant only for more_than condition, more_than_usual is always evaluated against the learned baseline. Can be one of %q.", validEvaluationWindow),
 		},
-		"latency_threshold_milliseconds": {
-			Type:         schema.TypeFloat,
-			Optional:     true,
-			ValidateFunc: validation.FloatAtLeast(0),
+	}
+}
+
+func sampleThresholdPercentageSchema() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Required: true,
+		Validators: []validator.Int64{
+			int64validator.OneOf(alertValidPercentages...),
 		},
-		"condition": {
-			Type:     schema.TypeList,
-			Required: true,
-			MaxItems: 1,
-			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					"immediately": {
-						Type:         schema.TypeBool,
-						Optional:     true,
-						ExactlyOneOf: []string{"tracing.0.condition.0.immediately", "tracing.0.condition.0.more_than"},
-						Description: "Determines the condition operator." +
-							" Must be one of - immediately or more_than.",
-					},
-					"more_than": {
-						Type:         schema.TypeBool,
-						Optional:     true,
-						ExactlyOneOf: []string{"tracing.0.condition.0.immediately", "tracing.0.condition.0.more_than"},
-						RequiredWith: []string{"tracing.0.condition.0.time_window"},
-						Description: "Determines the condition operator." +
-							" Must be one of - immediately or more_than.",
-					},
-					"threshold": {
-						Type:          schema.TypeInt,
-						Optional:      true,
-						ConflictsWith: []string{"tracing.0.condition.0.immediately"},
-						Description:   "The number of log occurrences that is needed to trigger the alert.",
-					},
-					"time_window": {
-						Type:          schema.TypeString,
-						Optional:      true,
-						ForceNew:      true,
-						ValidateFunc:  validation.StringInSlice(alertValidTimeFrames, false),
-						ConflictsWith: []string{"tracing.0.condition.0.immediately"},
-						RequiredWith:  []string{"tracing.0.condition.0.more_than"},
-						Description:   fmt.Sprintf("The bounded time frame for the threshold to be occurred within, to trigger the alert. Can be one of %q", alertValidTimeFrames),
+		MarkdownDescription: "The metric value must cross the threshold within this percentage of the timeframe (sum and count arithmetic operators do not use this parameter since they aggregate over the entire requested timeframe), increments of 10, 0 <= value <= 100.",
+	}
+}
+
+func replaceMissingValueWithZeroSchema(conflictsWith ...string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+		Validators: []validator.Bool{
+			boolvalidator.ConflictsWith(siblingPaths(append([]string{"min_non_null_values_percentage"}, conflictsWith...)...)...),
+		},
+		MarkdownDescription: "If set to true, missing data will be considered as 0, otherwise, it will not be considered at all.",
+	}
+}
+
+func minNonNullValuesPercentageSchema() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional: true,
+		Computed: true,
+		Default:  int64default.StaticInt64(0),
+		Validators: []validator.Int64{
+			int64validator.OneOf(alertValidPercentages...),
+			int64validator.ConflictsWith(siblingPaths("replace_missing_value_with_zero")...),
+		},
+		MarkdownDescription: "The minimum percentage of the timeframe that should have values for this alert to trigger",
+	}
+}
+
+func tracingSchema() map[string]schema.Attribute {
+	operators := []string{"immediately", "more_than"}
+	return map[string]schema.Attribute{
+		"applications": logsFilterSchema("An array that contains log’s application names that we want to be alerted on." +
+			" Applications can be filtered by prefix, suffix, and contains using the next patterns - filter:notEquals:xxx, filter:startsWith:xxx, filter:endsWith:xxx, filter:contains:xxx"),
+		"subsystems": logsFilterSchema("An array that contains log’s subsystems names that we want to be alerted on." +
+			" Subsystems can be filtered by prefix, suffix, and contains using the next patterns - filter:notEquals:xxx, filter:startsWith:xxx, filter:endsWith:xxx, filter:contains:xxx"),
+		"services": logsFilterSchema("An array that contains log’s services names that we want to be alerted on." +
+			" Services can be filtered by prefix, suffix, and contains using the next patterns - filter:notEquals:xxx, filter:startsWith:xxx, filter:endsWith:xxx, filter:contains:xxx"),
+		"tag_filter": schema.SetNestedAttribute{
+			Optional: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"field": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The tag to filter by.",
 					},
-					"group_by": {
-						Type:     schema.TypeList,
-						Optional: true,
-						Elem: &schema.Schema{
-							Type: schema.TypeString,
+					"values": schema.SetAttribute{
+						Required:    true,
+						ElementType: types.StringType,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
 						},
-						ConflictsWith: []string{"tracing.0.condition.0.immediately"},
-						Description:   "The fields to 'group by' on.",
+						MarkdownDescription: "Tag filter values can be filtered by prefix, suffix, and contains using the next patterns - filter:notEquals:xxx, filter:startsWith:xxx, filter:endsWith:xxx, filter:contains:xxx",
 					},
 				},
 			},
-			Description: "Defines the conditions for triggering and notify by the alert",
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+			},
 		},
-	}
-}
-
-func tagFilterSchema() *schema.Resource {
-	return &schema.Resource{
-		Schema: map[string]*schema.Schema{
-			"field": {
-				Type:     schema.TypeString,
-				Required: true,
+		"latency_threshold_milliseconds": schema.Float64Attribute{
+			Optional: true,
+			Computed: true,
+			Default:  float64default.StaticFloat64(0),
+			Validators: []validator.Float64{
+				float64validator.AtLeast(0),
 			},
-			"values": {
-				Type:     schema.TypeSet,
-				Required: true,
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
+		},
+		"condition": schema.SingleNestedAttribute{
+			Required: true,
+			Attributes: map[string]schema.Attribute{
+				"immediately": conditionOperatorSchema(operators,
+					boolvalidator.ExactlyOneOf(siblingPaths("more_than")...)),
+				"more_than": conditionOperatorSchema(operators,
+					boolvalidator.AlsoRequires(siblingPaths("time_window")...)),
+				"threshold": schema.Int64Attribute{
+					Optional: true,
+					Computed: true,
+					Default:  int64default.StaticInt64(0),
+					Validators: []validator.Int64{
+						int64validator.ConflictsWith(siblingPaths("immediately")...),
+					},
+					MarkdownDescription: "The number of log occurrences that is needed to trigger the alert.",
+				},
+				"time_window": schema.StringAttribute{
+					Optional: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.String{
+						stringvalidator.OneOf(alertValidTimeFrames...),
+						stringvalidator.ConflictsWith(siblingPaths("immediately")...),
+						stringvalidator.AlsoRequires(siblingPaths("more_than")...),
+					},
+					MarkdownDescription: fmt.Sprintf("The bounded time frame for the threshold to be occurred within, to trigger the alert. Can be one of %q", alertValidTimeFrames),
 				},
-				Set:         schema.HashString,
-				Description: "Tag filter values can be filtered by prefix, suffix, and contains using the next patterns - filter:notEquals:xxx, filter:startsWith:xxx, filter:endsWith:xxx, filter:contains:xxx",
+				"group_by": groupBySchema("The fields to 'group by' on.",
+					listvalidator.ConflictsWith(siblingPaths("immediately")...)),
 			},
+			MarkdownDescription: "Defines the conditions for triggering and notify by the alert",
 		},
 	}
 }
 
-func flowSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
-		"stage": {
-			Type:     schema.TypeList,
+func flowSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"stage": schema.ListNestedAttribute{
 			Required: true,
-			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					"group": {
-						Type:     schema.TypeList,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"group": schema.ListNestedAttribute{
 						Required: true,
-						Elem: &schema.Resource{
-							Schema: map[string]*schema.Schema{
-								"sub_alerts": {
-									Type:     schema.TypeList,
-									MaxItems: 1,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"sub_alerts": schema.SingleNestedAttribute{
 									Required: true,
-									Elem: &schema.Resource{
-										Schema: map[string]*schema.Schema{
-											"operator": {
-												Type:         schema.TypeString,
-												Required:     true,
-												ValidateFunc: validation.StringInSlice(alertValidFlowOperator, false),
+									Attributes: map[string]schema.Attribute{
+										"operator": schema.StringAttribute{
+											Required: true,
+											Validators: []validator.String{
+												stringvalidator.OneOf(alertValidFlowOperator...),
 											},
-											"flow_alert": {
-												Type:     schema.TypeList,
-												Required: true,
-												Elem: &schema.Resource{
-													Schema: map[string]*schema.Schema{
-														"not": {
-															Type:     schema.TypeBool,
-															Optional: true,
-															Default:  false,
-														},
-														"user_alert_id": {
-															Type:     schema.TypeString,
-															Required: true,
-														},
+											MarkdownDescription: fmt.Sprintf("The operator to use on the alert. can be one of %q", alertValidFlowOperator),
+										},
+										"flow_alert": schema.ListNestedAttribute{
+											Required: true,
+											NestedObject: schema.NestedAttributeObject{
+												Attributes: map[string]schema.Attribute{
+													"not": schema.BoolAttribute{
+														Optional:            true,
+														Computed:            true,
+														Default:             booldefault.StaticBool(false),
+														MarkdownDescription: "Determines whether the flow alert triggers when the sub alert does not trigger.",
+													},
+													"user_alert_id": schema.StringAttribute{
+														Required:            true,
+														MarkdownDescription: "The ID of the alert to be part of the flow. The alert must exist, and flow alerts can't reference each other in a cycle.",
 													},
 												},
 											},
+											Validators: []validator.List{
+												listvalidator.SizeAtLeast(1),
+											},
 										},
 									},
 								},
-								"next_operator": {
-									Type:         schema.TypeString,
-									Required:     true,
-									ValidateFunc: validation.StringInSlice(alertValidFlowOperator, false),
+								"next_operator": schema.StringAttribute{
+									Required: true,
+									Validators: []validator.String{
+										stringvalidator.OneOf(alertValidFlowOperator...),
+									},
+									MarkdownDescription: fmt.Sprintf("The operator joining this group with the next one. can be one of %q. All the groups of a stage, except the last one, must have the same next_operator.", alertValidFlowOperator),
 								},
 							},
 						},
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+					"time_window": schema.SingleNestedAttribute{
+						Optional: true,
+						Attributes: map[string]schema.Attribute{
+							"hours":   flowTimeWindowUnitSchema(),
+							"minutes": flowTimeWindowUnitSchema(),
+							"seconds": flowTimeWindowUnitSchema(),
+						},
+						MarkdownDescription: "Timeframe for flow stage.",
 					},
-					"time_window": timeSchema("Timeframe for flow stage."),
 				},
 			},
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
 		},
 	}
 }
 
-func resourceCoralogixAlertCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	createAlertRequest, diags := extractCreateAlertRequest(d)
-	if len(diags) != 0 {
-		return diags
+func flowTimeWindowUnitSchema() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional: true,
+		Computed: true,
+		Default:  int64default.StaticInt64(0),
+		Validators: []validator.Int64{
+			int64validator.AtLeast(0),
+		},
 	}
+}
 
-	log.Printf("[INFO] Creating new alert: %#v", createAlertRequest)
-	AlertResp, err := meta.(*clientset.ClientSet).Alerts().CreateAlert(ctx, createAlertRequest)
-
-	if err != nil {
-		log.Printf("[ERROR] Received error: %#v", err)
-		return handleRpcError(err, "alert")
+func (r *AlertResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	alertTypes := make([]path.Expression, 0, len(validAlertTypes))
+	for _, alertType := range validAlertTypes {
+		alertTypes = append(alertTypes, path.MatchRoot(alertType))
+	}
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(alertTypes...),
 	}
-	Alert := AlertResp.GetAlert()
-	log.Printf("[INFO] Submitted new alert: %#v", Alert)
-	d.SetId(Alert.GetUniqueIdentifier().GetValue())
-
-	return resourceCoralogixAlertRead(ctx, d, meta)
 }
 
-func resourceCoralogixAlertRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	id := wrapperspb.String(d.Id())
-	getAlertRequest := &alerts.GetAlertByUniqueIdRequest{
-		Id: id,
+// ValidateConfig reports overlapping scheduling time frames, see validateTimeFramesOverlap.
+func (r *AlertResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var scheduling types.Object
+	diags := req.Config.GetAttribute(ctx, path.Root("scheduling"), &scheduling)
+	if diags.HasError() || scheduling.IsNull() || scheduling.IsUnknown() {
+		return
 	}
 
-	log.Printf("[INFO] Reading alert %s", id)
-	alertResp, err := meta.(*clientset.ClientSet).Alerts().GetAlert(ctx, getAlertRequest)
-	if err != nil {
-		log.Printf("[ERROR] Received error: %#v", err)
-		if status.Code(err) == codes.NotFound {
-			d.SetId("")
-			return diag.Diagnostics{diag.Diagnostic{
-				Severity: diag.Warning,
-				Summary:  fmt.Sprintf("Alert %q is in state, but no longer exists in Coralogix backend", id),
-				Detail:   fmt.Sprintf("%s will be recreated when you apply", id),
-			}}
-		}
-		return handleRpcErrorWithID(err, "alert", id.GetValue())
+	var schedulingModel AlertSchedulingModel
+	if diags := scheduling.As(ctx, &schedulingModel, basetypes.ObjectAsOptions{}); diags.HasError() {
+		// Time frames which are unknown until apply can't be checked yet. They'll be checked on the next plan.
+		return
 	}
-	alert := alertResp.GetAlert()
-	log.Printf("[INFO] Received alert: %#v", alert)
 
-	return setAlert(d, alert)
+	resp.Diagnostics.Append(validateTimeFramesOverlap(schedulingModel.TimeFrames)...)
 }
 
-func resourceCoralogixAlertUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	req, diags := extractAlert(d)
-	if len(diags) != 0 {
-		return diags
-	}
-
-	id := d.Id()
-	updateAlertRequest := &alerts.UpdateAlertByUniqueIdRequest{
-		Alert: req,
+func (r *AlertResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			StateUpgrader: r.upgradeAlertStateV0ToV1,
+		},
 	}
+}
 
-	log.Printf("[INFO] Updating alert %s", updateAlertRequest)
-	alertResp, err := meta.(*clientset.ClientSet).Alerts().UpdateAlert(ctx, updateAlertRequest)
-	if err != nil {
-		log.Printf("[ERROR] Received error: %#v", err)
-		return handleRpcErrorWithID(err, "alert", id)
+// upgradeAlertStateV0ToV1 converts the state written by the SDK based resource, where every nested block was stored
+// as a list, into the nested attributes of the current schema. Unset optional values were stored as their zero value,
+// so they are converted to null.
+func (r *AlertResource) upgradeAlertStateV0ToV1(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	if req.RawState == nil || req.RawState.JSON == nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Alert State", "The prior state of the alert is missing.")
+		return
 	}
-	log.Printf("[INFO] Submitted updated alert: %#v", alertResp)
-	d.SetId(alertResp.GetAlert().GetUniqueIdentifier().GetValue())
-
-	return resourceCoralogixAlertRead(ctx, d, meta)
-}
 
-func resourceCoralogixAlertDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	id := wrapperspb.String(d.Id())
-	deleteAlertRequest := &alerts.DeleteAlertByUniqueIdRequest{
-		Id: id,
+	decoder := json.NewDecoder(strings.NewReader(string(req.RawState.JSON)))
+	decoder.UseNumber()
+	var rawState map[string]interface{}
+	if err := decoder.Decode(&rawState); err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Alert State", "Could not decode the prior state of the alert: "+err.Error())
+		return
 	}
 
-	log.Printf("[INFO] Deleting alert %s\n", id)
-	_, err := meta.(*clientset.ClientSet).Alerts().DeleteAlert(ctx, deleteAlertRequest)
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	upgradedState, err := upgradeObjectFromSDKv2State(ctx, schemaResp.Schema.Attributes, rawState)
 	if err != nil {
-		log.Printf("[ERROR] Received error: %#v\n", err)
-		return handleRpcErrorWithID(err, "alert", id.GetValue())
+		resp.Diagnostics.AddError("Unable to Upgrade Alert State", "Could not convert the prior state of the alert: "+err.Error())
+		return
 	}
-	log.Printf("[INFO] alert %s deleted\n", id)
-
-	d.SetId("")
-	return nil
-}
 
-func extractCreateAlertRequest(d *schema.ResourceData) (*alerts.CreateAlertRequest, diag.Diagnostics) {
-	var diags diag.Diagnostics
-	enabled := wrapperspb.Bool(d.Get("enabled").(bool))
-	name := wrapperspb.String(d.Get("name").(string))
-	description := wrapperspb.String(d.Get("description").(string))
-	severity := expandAlertSeverity(d.Get("severity").(string))
-	metaLabels := extractMetaLabels(d.Get("meta_labels"))
-	expirationDate := expandExpirationDate(d.Get("expiration_date"))
-	showInInsight := expandShowInInsight(d.Get("show_in_insights"))
-	notificationGroups, dgs := expandNotificationGroups(d.Get("notifications_group"))
-	diags = append(diags, dgs...)
-	payloadFilters := expandPayloadFilters(d.Get("payload_filters"))
-	scheduling := expandActiveWhen(d.Get("scheduling"))
-	alertTypeParams, tracingAlert, dgs := expandAlertType(d)
-	diags = append(diags, dgs...)
-
-	return &alerts.CreateAlertRequest{
-		Name:                       name,
-		Description:                description,
-		IsActive:                   enabled,
-		Severity:                   severity,
-		MetaLabels:                 metaLabels,
-		Expiration:                 expirationDate,
-		ShowInInsight:              showInInsight,
-		NotificationGroups:         notificationGroups,
-		NotificationPayloadFilters: payloadFilters,
-		ActiveWhen:                 scheduling,
-		Filters:                    alertTypeParams.Filters,
-		Condition:                  alertTypeParams.Condition,
-		TracingAlert:               tracingAlert,
-	}, diags
+	resp.State.Raw = upgradedState
 }
 
-func extractAlert(d *schema.ResourceData) (*alerts.Alert, diag.Diagnostics) {
-	var diags diag.Diagnostics
-	id := wrapperspb.String(d.Id())
-	enabled := wrapperspb.Bool(d.Get("enabled").(bool))
-	name := wrapperspb.String(d.Get("name").(string))
-	description := wrapperspb.String(d.Get("description").(string))
-	severity := expandAlertSeverity(d.Get("severity").(string))
-	metaLabels := extractMetaLabels(d.Get("meta_labels"))
-	expirationDate := expandExpirationDate(d.Get("expiration_date"))
-	showInInsight := expandShowInInsight(d.Get("show_in_insights"))
-	notificationGroups, dgs := expandNotificationGroups(d.Get("notifications_group"))
-	diags = append(diags, dgs...)
-	payloadFilters := expandPayloadFilters(d.Get("payload_filters"))
-	scheduling := expandActiveWhen(d.Get("scheduling"))
-	alertTypeParams, tracingAlert, dgs := expandAlertType(d)
-	diags = append(diags, dgs...)
-
-	return &alerts.Alert{
-		UniqueIdentifier:           id,
-		Name:                       name,
-		Description:                description,
-		IsActive:                   enabled,
-		Severity:                   severity,
-		MetaLabels:                 metaLabels,
-		Expiration:                 expirationDate,
-		ShowInInsight:              showInInsight,
-		NotificationGroups:         notificationGroups,
-		NotificationPayloadFilters: payloadFilters,
-		ActiveWhen:                 scheduling,
-		Filters:                    alertTypeParams.Filters,
-		Condition:                  alertTypeParams.Condition,
-		TracingAlert:               tracingAlert,
-	}, diags
-}
+func (r *AlertResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
 
-func expandPayloadFilters(v interface{}) []*wrapperspb.StringValue {
-	return interfaceSliceToWrappedStringSlice(v.(*schema.Set).List())
+	if r.webhooksClient != nil {
+		resp.Diagnostics.Append(r.validatePlanNotifications(ctx, req.Plan)...)
+	}
+	if r.client != nil {
+		resp.Diagnostics.Append(r.validatePlanFlow(ctx, req.Plan)...)
+	}
+	resp.Diagnostics.Append(validatePlanScheduling(ctx, req.Plan, req.State)...)
 }
 
-func setAlert(d *schema.ResourceData, alert *alerts.Alert) diag.Diagnostics {
-	if err := d.Set("name", alert.GetName().GetValue()); err != nil {
-		return diag.FromErr(err)
+// validatePlanScheduling warns about upcoming offset changes of the scheduling time zone when the schedule is going
+// to be applied, see alertTimeZoneTransitionDiagnostics.
+func validatePlanScheduling(ctx context.Context, plan tfsdk.Plan, state tfsdk.State) diag.Diagnostics {
+	var scheduling types.Object
+	diags := plan.GetAttribute(ctx, path.Root("scheduling"), &scheduling)
+	if diags.HasError() || scheduling.IsNull() || scheduling.IsUnknown() {
+		return diags
 	}
-
-	if err := d.Set("description", alert.GetDescription().GetValue()); err != nil {
-		return diag.FromErr(err)
+	if !state.Raw.IsNull() {
+		var stateScheduling types.Object
+		diags.Append(state.GetAttribute(ctx, path.Root("scheduling"), &stateScheduling)...)
+		if diags.HasError() || scheduling.Equal(stateScheduling) {
+			return diags
+		}
 	}
 
-	if err := d.Set("enabled", alert.GetIsActive().GetValue()); err != nil {
-		return diag.FromErr(err)
+	var timeZone types.String
+	diags.Append(plan.GetAttribute(ctx, path.Root("scheduling").AtName("time_zone"), &timeZone)...)
+	if diags.HasError() || timeZone.IsNull() || timeZone.IsUnknown() {
+		return diags
 	}
+	diags.Append(alertTimeZoneTransitionDiagnostics(timeZone.ValueString(), time.Now())...)
+	return diags
+}
 
-	if err := d.Set("severity", flattenAlertSeverity(alert.GetSeverity().String())); err != nil {
-		return diag.FromErr(err)
+func (r *AlertResource) validatePlanNotifications(ctx context.Context, plan tfsdk.Plan) diag.Diagnostics {
+	var notificationsGroups types.List
+	diags := plan.GetAttribute(ctx, path.Root("notifications_group"), &notificationsGroups)
+	if diags.HasError() || notificationsGroups.IsNull() || notificationsGroups.IsUnknown() {
+		return diags
 	}
 
-	if err := d.Set("meta_labels", flattenMetaLabels(alert.GetMetaLabels())); err != nil {
-		return diag.FromErr(err)
+	var groups []AlertNotificationGroupModel
+	if dgs := notificationsGroups.ElementsAs(ctx, &groups, false); dgs.HasError() {
+		// Nested lists which are unknown until apply can't be checked yet. They'll be checked on the next plan.
+		return diags
 	}
 
-	if err := d.Set("expiration_date", flattenExpirationDate(alert.GetExpiration())); err != nil {
-		return diag.FromErr(err)
-	}
+	return r.validateNotificationsWebhooks(ctx, groups)
+}
 
-	if err := d.Set("notifications_group", flattenNotificationGroups(alert.GetNotificationGroups())); err != nil {
-		return diag.FromErr(err)
+// validateNotificationsWebhooks verifies that every integration_id refers to an existing webhook,
+// so a webhook that was deleted outside Terraform is reported at plan time instead of failing the apply.
+func (r *AlertResource) validateNotificationsWebhooks(ctx context.Context, groups []AlertNotificationGroupModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	checked := make(map[string]diag.Diagnostics)
+	for i, group := range groups {
+		for j, notification := range group.Notifications {
+			webhookID := notification.IntegrationID
+			if webhookID.IsNull() || webhookID.IsUnknown() {
+				continue
+			}
+			id := webhookID.ValueString()
+			if _, ok := checked[id]; !ok {
+				checked[id] = r.validateWebhook(ctx, id)
+			}
+			webhookPath := path.Root("notifications_group").AtListIndex(i).AtName("notification").AtListIndex(j).AtName("integration_id")
+			for _, d := range checked[id] {
+				if d.Severity() == diag.SeverityError {
+					diags.AddAttributeError(webhookPath, d.Summary(), d.Detail())
+				} else {
+					diags.AddAttributeWarning(webhookPath, d.Summary(), d.Detail())
+				}
+			}
+		}
 	}
+	return diags
+}
 
-	if err := d.Set("payload_filters", wrappedStringSliceToStringSlice(alert.GetNotificationPayloadFilters())); err != nil {
-		return diag.FromErr(err)
+func (r *AlertResource) validateWebhook(ctx context.Context, webhookID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	log.Printf("[INFO] Reading webhook %s", webhookID)
+	webhookResp, err := r.webhooksClient.GetWebhook(ctx, webhookID)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			diags.AddError("Webhook not found",
+				fmt.Sprintf("Webhook %q doesn't exist. It might have been deleted outside of Terraform.", webhookID))
+		} else {
+			diags.AddWarning("Unable to verify webhook",
+				fmt.Sprintf("Webhook %q couldn't be read, so it wasn't verified - %s", webhookID, handleRpcErrorNewFramework(err, "webhook")))
+		}
+		return diags
 	}
+	log.Printf("[INFO] Received webhook: %s", webhookResp)
+	return diags
+}
 
-	if err := d.Set("show_in_insights", flattenShowInInsight(alert.GetShowInInsight())); err != nil {
-		return diag.FromErr(err)
+func (r *AlertResource) validatePlanFlow(ctx context.Context, plan tfsdk.Plan) diag.Diagnostics {
+	var flow types.Object
+	diags := plan.GetAttribute(ctx, path.Root("flow"), &flow)
+	if diags.HasError() || flow.IsNull() || flow.IsUnknown() {
+		return diags
 	}
 
-	if err := d.Set("scheduling", flattenScheduling(d, alert.GetActiveWhen())); err != nil {
-		return diag.FromErr(err)
+	var flowModel FlowAlertModel
+	if dgs := flow.As(ctx, &flowModel, basetypes.ObjectAsOptions{}); dgs.HasError() {
+		// Nested lists which are unknown until apply can't be checked yet. They'll be checked on the next plan.
+		return diags
 	}
 
-	alertType, alertTypeParams := flattenAlertType(alert)
-	if err := d.Set(alertType, alertTypeParams); err != nil {
-		return diag.FromErr(err)
+	var id types.String
+	diags.Append(plan.GetAttribute(ctx, path.Root("id"), &id)...)
+	if diags.HasError() {
+		return diags
 	}
 
-	return nil
-}
-
-func flattenAlertSeverity(str string) string {
-	return alertProtoSeverityToSchemaSeverity[str]
+	diags.Append(validateFlowOperators(flowModel)...)
+	graph := &flowAlertsGraph{client: r.client, alerts: make(map[string]*flowAlertsGraphNode), acyclic: make(map[string]bool)}
+	diags.Append(graph.validate(ctx, id.ValueString(), flowModel)...)
+	return diags
 }
 
-func flattenMetaLabels(labels []*alerts.MetaLabel) interface{} {
-	result := make(map[string]interface{})
-	for _, l := range labels {
-		key := l.GetKey().GetValue()
-		val := l.GetValue().GetValue()
-		result[key] = val
+// validateFlowOperators verifies that the groups of every stage are joined with a single operator, as the groups are
+// evaluated in order without precedence. The next_operator of the last group of a stage doesn't join any group.
+func validateFlowOperators(flow FlowAlertModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for i, stage := range flow.Stages {
+		if len(stage.Groups) < 3 {
+			continue
+		}
+		first := stage.Groups[0].NextOperator
+		if first.IsNull() || first.IsUnknown() {
+			continue
+		}
+		for j, group := range stage.Groups[1 : len(stage.Groups)-1] {
+			if group.NextOperator.IsNull() || group.NextOperator.IsUnknown() || group.NextOperator.Equal(first) {
+				continue
+			}
+			diags.AddAttributeError(
+				path.Root("flow").AtName("stage").AtListIndex(i).AtName("group").AtListIndex(j+1).AtName("next_operator"),
+				"Flow operators mismatch",
+				fmt.Sprintf("The groups of stage %d are joined with %s by group 0 but with %s by group %d. All the groups of a stage, except the last one, must have the same next_operator.",
+					i, first.ValueString(), group.NextOperator.ValueString(), j+1))
+		}
 	}
-	return result
+	return diags
 }
 
-func flattenShowInInsight(showInInsight *alerts.ShowInInsight) interface{} {
-	notifyEveryMin := int(showInInsight.GetRetriggeringPeriodSeconds().GetValue() / 60)
-	notifyOn := alertProtoNotifyOnToSchemaNotifyOn[showInInsight.GetNotifyOn()]
-	showInInsightSchema := map[string]interface{}{
-		"retriggering_period_minutes": notifyEveryMin,
-		"notify_on":                   notifyOn,
-	}
-	return []interface{}{
-		showInInsightSchema,
-	}
+// flowAlertsGraph resolves the sub-alerts of a flow alert, and the sub-alerts of nested flow alerts, from Coralogix,
+// so that dangling references and cycles are reported at plan time instead of failing the apply.
+type flowAlertsGraph struct {
+	client  *clientset.AlertsClient
+	alerts  map[string]*flowAlertsGraphNode
+	acyclic map[string]bool
 }
 
-func flattenNotificationGroups(notificationGroups []*alerts.AlertNotificationGroups) interface{} {
-	result := make([]interface{}, 0, len(notificationGroups))
-	for _, group := range notificationGroups {
-		notificationGroup := flattenNotificationGroup(group)
-		result = append(result, notificationGroup)
-	}
-	return result
+type flowAlertsGraphNode struct {
+	alert *alerts.Alert
+	diags diag.Diagnostics
 }
 
-func flattenNotificationGroup(notificationGroup *alerts.AlertNotificationGroups) interface{} {
-	groupByFields := wrappedStringSliceToStringSlice(notificationGroup.GetGroupByFields())
-	notifications := flattenNotifications(notificationGroup.GetNotifications())
-	return map[string]interface{}{
-		"group_by_fields": groupByFields,
-		"notification":    notifications,
+func (g *flowAlertsGraph) validate(ctx context.Context, alertID string, flow FlowAlertModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	// A new alert has no ID yet, so nothing can reference it.
+	var stack []string
+	if alertID != "" {
+		stack = []string{alertID}
 	}
-}
 
-func flattenNotifications(notifications []*alerts.AlertNotification) interface{} {
-	result := make([]interface{}, 0, len(notifications))
-	for _, n := range notifications {
-		notificationSubgroup := flattenNotificationSubgroup(n)
-		result = append(result, notificationSubgroup)
+	for i, stage := range flow.Stages {
+		for j, group := range stage.Groups {
+			if group.SubAlerts == nil {
+				continue
+			}
+			for k, flowAlert := range group.SubAlerts.FlowAlerts {
+				subAlertID := flowAlert.UserAlertID
+				if subAlertID.IsNull() || subAlertID.IsUnknown() {
+					continue
+				}
+				subAlertPath := path.Root("flow").AtName("stage").AtListIndex(i).AtName("group").AtListIndex(j).
+					AtName("sub_alerts").AtName("flow_alert").AtListIndex(k).AtName("user_alert_id")
+				id := subAlertID.ValueString()
+				if id == alertID {
+					diags.AddAttributeError(subAlertPath, "Flow alerts cycle", "A flow alert can't reference itself.")
+					continue
+				}
+
+				node := g.node(ctx, id)
+				for _, d := range node.diags {
+					if d.Severity() == diag.SeverityError {
+						diags.AddAttributeError(subAlertPath, d.Summary(), d.Detail())
+					} else {
+						diags.AddAttributeWarning(subAlertPath, d.Summary(), d.Detail())
+					}
+				}
+				if node.alert == nil {
+					continue
+				}
+				if cycle := g.findCycle(ctx, id, stack); cycle != nil {
+					diags.AddAttributeError(subAlertPath, "Flow alerts cycle",
+						fmt.Sprintf("Flow alerts can't reference each other in a cycle: %s", g.describe(cycle, alertID)))
+				}
+			}
+		}
 	}
-	return result
+	return diags
 }
 
-func flattenNotificationSubgroup(notification *alerts.AlertNotification) interface{} {
-	notifyEveryMin := int(notification.GetRetriggeringPeriodSeconds().GetValue() / 60)
-	notifyOn := alertProtoNotifyOnToSchemaNotifyOn[notification.GetNotifyOn()]
-	notificationSchema := map[string]interface{}{
-		"retriggering_period_minutes": notifyEveryMin,
-		"notify_on":                   notifyOn,
+// node returns the alert with the given ID, reading it only once.
+func (g *flowAlertsGraph) node(ctx context.Context, id string) *flowAlertsGraphNode {
+	if node, ok := g.alerts[id]; ok {
+		return node
 	}
 
-	switch integration := notification.GetIntegrationType().(type) {
-	case *alerts.AlertNotification_IntegrationId:
-		notificationSchema["integration_id"] = strconv.Itoa(int(integration.IntegrationId.GetValue()))
-	case *alerts.AlertNotification_Recipients:
-		notificationSchema["email_recipients"] = wrappedStringSliceToStringSlice(integration.Recipients.Emails)
+	node := &flowAlertsGraphNode{}
+	log.Printf("[INFO] Reading flow sub-alert %s", id)
+	getAlertResp, err := g.client.GetAlert(ctx, &alerts.GetAlertByUniqueIdRequest{Id: wrapperspb.String(id)})
+	switch {
+	case status.Code(err) == codes.NotFound:
+		node.diags.AddError("Flow sub-alert not found",
+			fmt.Sprintf("Alert %q doesn't exist. It might have been deleted outside of Terraform.", id))
+	case err != nil:
+		node.diags.AddWarning("Unable to verify flow sub-alert",
+			fmt.Sprintf("Alert %q couldn't be read, so it wasn't verified - %s", id, handleRpcErrorNewFramework(err, "Alert")))
+	default:
+		node.alert = getAlertResp.GetAlert()
+		if !node.alert.GetIsActive().GetValue() {
+			node.diags.AddWarning("Flow sub-alert is disabled",
+				fmt.Sprintf("Alert %q (%s) is disabled, so the flow won't trigger until it's enabled.", node.alert.GetName().GetValue(), id))
+		}
 	}
-
-	return notificationSchema
+	g.alerts[id] = node
+	return node
 }
 
-func flattenScheduling(d *schema.ResourceData, activeWhen *alerts.AlertActiveWhen) interface{} {
-	scheduling, ok := d.GetOk("scheduling")
-	if !ok || activeWhen == nil {
+// findCycle follows the flow sub-alerts of the alert with the given ID, and returns the first path which leads back
+// to an alert in stack (the alerts which lead to it), or nil when there is none.
+func (g *flowAlertsGraph) findCycle(ctx context.Context, id string, stack []string) []string {
+	for i, stackID := range stack {
+		if stackID == id {
+			return append(append([]string{}, stack[i:]...), id)
+		}
+	}
+	if g.acyclic[id] {
 		return nil
 	}
 
-	timeZone := scheduling.([]interface{})[0].(map[string]interface{})["time_zone"].(string)
-
-	timeFrames := flattenTimeFrames(activeWhen, timeZone)
-
-	return []interface{}{
-		map[string]interface{}{
-			"time_zone":  timeZone,
-			"time_frame": timeFrames,
-		},
+	if alert := g.node(ctx, id).alert; alert != nil {
+		stack = append(stack[:len(stack):len(stack)], id)
+		for _, subAlertID := range flowSubAlertIDs(alert) {
+			if cycle := g.findCycle(ctx, subAlertID, stack); cycle != nil {
+				return cycle
+			}
+		}
 	}
+	g.acyclic[id] = true
+	return nil
 }
 
-func flattenTimeFrames(activeWhen *alerts.AlertActiveWhen, timeZone string) interface{} {
-	timeFrames := activeWhen.GetTimeframes()
-	utc := flattenUtc(timeZone)
-	result := schema.NewSet(hashTimeFrames(), []interface{}{})
-	for _, tf := range timeFrames {
-		m := flattenTimeFrame(tf, utc)
-		result.Add(m)
+func (g *flowAlertsGraph) describe(cycle []string, alertID string) string {
+	described := make([]string, 0, len(cycle))
+	for _, id := range cycle {
+		switch node := g.alerts[id]; {
+		case id == alertID:
+			described = append(described, fmt.Sprintf("this alert (%s)", id))
+		case node != nil && node.alert != nil:
+			described = append(described, fmt.Sprintf("%q (%s)", node.alert.GetName().GetValue(), id))
+		default:
+			described = append(described, id)
+		}
 	}
-	return result
+	return strings.Join(described, " -> ")
 }
 
-func flattenUtc(timeZone string) int32 {
-	utcStr := strings.Split(timeZone, "UTC")[1]
-	utc, _ := strconv.Atoi(utcStr)
-	return int32(utc)
+func flowSubAlertIDs(alert *alerts.Alert) []string {
+	var ids []string
+	for _, stage := range alert.GetCondition().GetFlow().GetStages() {
+		for _, group := range stage.GetGroups() {
+			for _, flowAlert := range group.GetAlerts().GetValues() {
+				ids = append(ids, flowAlert.GetId().GetValue())
+			}
+		}
+	}
+	return ids
 }
 
-func flattenTimeFrame(tf *alerts.AlertActiveTimeframe, utc int32) map[string]interface{} {
-	tr := tf.GetRange()
-	activityStartGMT, activityEndGMT := tr.GetStart(), tr.GetEnd()
-	daysOffset := getDaysOffsetFromGMT(activityStartGMT, utc)
-	activityStartUTC := flattenTimeInDay(activityStartGMT, utc)
-	activityEndUTC := flattenTimeInDay(activityEndGMT, utc)
-	daysOfWeek := flattenDaysOfWeek(tf.GetDaysOfWeek(), daysOffset)
+func (r *AlertResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	jsm := &jsonpb.Marshaler{}
+	var plan AlertResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	return map[string]interface{}{
-		"days_enabled": daysOfWeek,
-		"start_time":   activityStartUTC,
-		"end_time":     activityEndUTC,
+	createAlertRequest, diags := extractCreateAlertRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-}
+	alertStr, _ := jsm.MarshalToString(createAlertRequest)
+	log.Printf("[INFO] Creating new alert: %s", alertStr)
+	createResp, err := r.client.CreateAlert(ctx, createAlertRequest)
+	if err != nil {
+		log.Printf("[ERROR] Received error: %#v", err)
+		resp.Diagnostics.AddError("Error creating Alert",
+			"Could not create Alert, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	alert := createResp.GetAlert()
+	alertStr, _ = jsm.MarshalToString(alert)
+	log.Printf("[INFO] Submitted new alert: %s", alertStr)
 
-func getDaysOffsetFromGMT(activityStartGMT *alerts.Time, utc int32) int32 {
-	daysOffset := int32(activityStartGMT.GetHours()+utc) / 24
-	if daysOffset < 0 {
-		daysOffset += 7
+	plan, diags = flattenAlert(ctx, alert, timeZoneOfScheduling(plan.Scheduling))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	return daysOffset
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
 }
 
-func flattenTimeInDay(t *alerts.Time, utc int32) string {
-	hours := convertGmtToUtc(t.GetHours(), utc)
-	hoursStr := toTwoDigitsFormat(hours)
-	minStr := toTwoDigitsFormat(t.GetMinutes())
-	return fmt.Sprintf("%s:%s", hoursStr, minStr)
-}
+func (r *AlertResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AlertResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-func flattenDaysOfWeek(daysOfWeek []alerts.DayOfWeek, daysOffset int32) interface{} {
-	result := schema.NewSet(schema.HashString, []interface{}{})
-	for _, d := range daysOfWeek {
-		dayConvertedFromGmtToUtc := alerts.DayOfWeek((int32(d) + daysOffset) % 7)
-		day := alertProtoDayOfWeekToSchemaDayOfWeek[dayConvertedFromGmtToUtc.String()]
-		result.Add(day)
+	id := state.ID.ValueString()
+	log.Printf("[INFO] Reading alert: %s", id)
+	getAlertResp, err := r.client.GetAlert(ctx, &alerts.GetAlertByUniqueIdRequest{Id: wrapperspb.String(id)})
+	if err != nil {
+		log.Printf("[ERROR] Received error: %#v", err)
+		if status.Code(err) == codes.NotFound {
+			resp.Diagnostics.AddWarning(
+				fmt.Sprintf("Alert %q is in state, but no longer exists in Coralogix backend", id),
+				fmt.Sprintf("%s will be recreated when you apply", id),
+			)
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error reading Alert",
+				handleRpcErrorNewFramework(err, "Alert"),
+			)
+		}
+		return
 	}
-	return result
+	alert := getAlertResp.GetAlert()
+	log.Printf("[INFO] Received alert: %#v", alert)
+
+	state, diags = flattenAlert(ctx, alert, timeZoneOfScheduling(state.Scheduling))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
 }
 
-func flattenAlertType(a *alerts.Alert) (alertType string, alertSchema interface{}) {
-	filters := a.GetFilters()
-	condition := a.GetCondition().GetCondition()
+func (r *AlertResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	jsm := &jsonpb.Marshaler{}
+	var plan AlertResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	switch filters.GetFilterType() {
-	case alerts.AlertFilters_FILTER_TYPE_TEXT_OR_UNSPECIFIED:
-		if _, ok := condition.(*alerts.AlertCondition_NewValue); ok {
-			alertType = "new_value"
-			alertSchema = flattenNewValueAlert(filters, condition)
+	alert, diags := extractAlert(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	updateAlertRequest := &alerts.UpdateAlertByUniqueIdRequest{
+		Alert: alert,
+	}
+	alertStr, _ := jsm.MarshalToString(updateAlertRequest)
+	log.Printf("[INFO] Updating alert: %s", alertStr)
+	updateResp, err := r.client.UpdateAlert(ctx, updateAlertRequest)
+	if err != nil {
+		log.Printf("[ERROR] Received error: %#v", err)
+		resp.Diagnostics.AddError(
+			"Error updating Alert",
+			"Could not update Alert, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	alertStr, _ = jsm.MarshalToString(updateResp)
+	log.Printf("[INFO] Submitted updated alert: %s", alertStr)
+
+	// Get refreshed alert value from Coralogix
+	id := plan.ID.ValueString()
+	getAlertResp, err := r.client.GetAlert(ctx, &alerts.GetAlertByUniqueIdRequest{Id: wrapperspb.String(id)})
+	if err != nil {
+		log.Printf("[ERROR] Received error: %#v", err)
+		if status.Code(err) == codes.NotFound {
+			resp.Diagnostics.AddWarning(
+				fmt.Sprintf("Alert %q is in state, but no longer exists in Coralogix backend", id),
+				fmt.Sprintf("%s will be recreated when you apply", id),
+			)
+			resp.State.RemoveResource(ctx)
 		} else {
-			alertType = "standard"
-			alertSchema = flattenStandardAlert(filters, condition)
+			resp.Diagnostics.AddError(
+				"Error reading Alert",
+				handleRpcErrorNewFramework(err, "Alert"),
+			)
 		}
-	case alerts.AlertFilters_FILTER_TYPE_RATIO:
-		alertType = "ratio"
-		alertSchema = flattenRatioAlert(filters, condition)
-	case alerts.AlertFilters_FILTER_TYPE_UNIQUE_COUNT:
-		alertType = "unique_count"
-		alertSchema = flattenUniqueCountAlert(filters, condition)
-	case alerts.AlertFilters_FILTER_TYPE_TIME_RELATIVE:
-		alertType = "time_relative"
-		alertSchema = flattenTimeRelativeAlert(filters, condition)
-	case alerts.AlertFilters_FILTER_TYPE_METRIC:
-		alertType = "metric"
-		alertSchema = flattenMetricAlert(filters, condition)
-	case alerts.AlertFilters_FILTER_TYPE_TRACING:
-		alertType = "tracing"
-		alertSchema = flattenTracingAlert(condition, a.TracingAlert)
-	case alerts.AlertFilters_FILTER_TYPE_FLOW:
-		alertType = "flow"
-		alertSchema = flattenFlowAlert(condition)
+		return
 	}
+	log.Printf("[INFO] Received alert: %#v", getAlertResp)
 
-	return
-}
+	plan, diags = flattenAlert(ctx, getAlertResp.GetAlert(), timeZoneOfScheduling(plan.Scheduling))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-func flattenNewValueAlert(filters *alerts.AlertFilters, condition interface{}) interface{} {
-	alertSchema := flattenCommonAlert(filters)
-	conditionMap := flattenNewValueCondition(condition)
-	alertSchema["condition"] = []interface{}{conditionMap}
-	return []interface{}{alertSchema}
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
 }
 
-func flattenNewValueCondition(condition interface{}) interface{} {
-	conditionParams := condition.(*alerts.AlertCondition_NewValue).NewValue.GetParameters()
-	return map[string]interface{}{
-		"time_window":  alertProtoNewValueTimeFrameToSchemaTimeFrame[conditionParams.GetTimeframe().String()],
-		"key_to_track": conditionParams.GetGroupBy()[0].GetValue(),
+func (r *AlertResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AlertResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-}
 
-func flattenStandardAlert(filters *alerts.AlertFilters, condition interface{}) interface{} {
-	alertSchemaMap := flattenCommonAlert(filters)
-	conditionSchema := flattenStandardCondition(condition)
-	alertSchemaMap["condition"] = conditionSchema
-	return []interface{}{alertSchemaMap}
+	id := state.ID.ValueString()
+	log.Printf("[INFO] Deleting alert %s\n", id)
+	if _, err := r.client.DeleteAlert(ctx, &alerts.DeleteAlertByUniqueIdRequest{Id: wrapperspb.String(id)}); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error Deleting Alert %s", id),
+			handleRpcErrorNewFramework(err, "Alert"),
+		)
+		return
+	}
+	log.Printf("[INFO] Alert %s deleted\n", id)
 }
 
-func flattenStandardCondition(condition interface{}) (conditionSchema interface{}) {
-	var conditionParams *alerts.ConditionParameters
-	switch condition := condition.(type) {
-	case *alerts.AlertCondition_Immediate:
-		conditionSchema = []interface{}{
-			map[string]interface{}{
-				"immediately": true,
-			},
-		}
-	case *alerts.AlertCondition_LessThan:
-		conditionParams = condition.LessThan.GetParameters()
-		groupBy := wrappedStringSliceToStringSlice(conditionParams.GroupBy)
-		m := map[string]interface{}{
-			"less_than":   true,
-			"threshold":   int(conditionParams.GetThreshold().GetValue()),
-			"group_by":    groupBy,
-			"time_window": alertProtoTimeFrameToSchemaTimeFrame[conditionParams.Timeframe.String()],
-		}
+func (r *AlertResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
 
-		if len(groupBy) > 0 {
-			m["manage_undetected_values"] = flattenManageUndetectedValues(conditionParams.GetRelatedExtendedData())
-		}
+func timeZoneOfScheduling(scheduling *AlertSchedulingModel) types.String {
+	if scheduling == nil {
+		return types.StringNull()
+	}
+	return scheduling.TimeZone
+}
 
-		conditionSchema = []interface{}{m}
-	case *alerts.AlertCondition_MoreThan:
-		conditionParams = condition.MoreThan.GetParameters()
-		conditionSchema = []interface{}{
-			map[string]interface{}{
-				"more_than":         true,
-				"threshold":         int(conditionParams.GetThreshold().GetValue()),
-				"group_by":          wrappedStringSliceToStringSlice(conditionParams.GroupBy),
-				"time_window":       alertProtoTimeFrameToSchemaTimeFrame[conditionParams.Timeframe.String()],
-				"evaluation_window": alertProtoToSchemaEvaluationWindow[condition.MoreThan.GetEvaluationWindow()],
-			},
-		}
-	case *alerts.AlertCondition_MoreThanUsual:
-		conditionParams = condition.MoreThanUsual.GetParameters()
-		conditionMap := map[string]interface{}{
-			"more_than_usual": true,
-			"threshold":       int(conditionParams.GetThreshold().GetValue()),
-		}
-		if groupBy := conditionParams.GetGroupBy(); len(groupBy) > 0 {
-			conditionMap["group_by_key"] = groupBy[0].Value
-		}
-		conditionSchema = []interface{}{
-			conditionMap,
-		}
+func extractCreateAlertRequest(ctx context.Context, plan AlertResourceModel) (*alerts.CreateAlertRequest, diag.Diagnostics) {
+	alert, diags := extractAlert(ctx, plan)
+	if diags.HasError() {
+		return nil, diags
 	}
 
-	return
+	return &alerts.CreateAlertRequest{
+		Name:                       alert.Name,
+		Description:                alert.Description,
+		IsActive:                   alert.IsActive,
+		Severity:                   alert.Severity,
+		MetaLabels:                 alert.MetaLabels,
+		Expiration:                 alert.Expiration,
+		ShowInInsight:              alert.ShowInInsight,
+		NotificationGroups:         alert.NotificationGroups,
+		NotificationPayloadFilters: alert.NotificationPayloadFilters,
+		ActiveWhen:                 alert.ActiveWhen,
+		Filters:                    alert.Filters,
+		Condition:                  alert.Condition,
+		TracingAlert:               alert.TracingAlert,
+	}, diags
 }
 
-func flattenManageUndetectedValues(data *alerts.RelatedExtendedData) interface{} {
-	if data == nil {
-		return []map[string]interface{}{
-			{
-				"enable_triggering_on_undetected_values": true,
-				"auto_retire_ratio":                      flattenDeadmanRatio(alerts.CleanupDeadmanDuration_CLEANUP_DEADMAN_DURATION_NEVER_OR_UNSPECIFIED),
-			},
-		}
-	} else if data.GetShouldTriggerDeadman().GetValue() {
-		return []map[string]interface{}{
-			{
-				"enable_triggering_on_undetected_values": true,
-				"auto_retire_ratio":                      flattenDeadmanRatio(data.GetCleanupDeadmanDuration()),
-			},
-		}
+func extractAlert(ctx context.Context, plan AlertResourceModel) (*alerts.Alert, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	showInInsight, dgs := expandShowInInsight(ctx, plan.ShowInInsights)
+	diags.Append(dgs...)
+	notificationGroups, dgs := expandNotificationGroups(plan.NotificationsGroups)
+	diags.Append(dgs...)
+	scheduling, dgs := expandActiveWhen(plan.Scheduling)
+	diags.Append(dgs...)
+	alertTypeParams, tracingAlert, dgs := expandAlertType(ctx, plan)
+	diags.Append(dgs...)
+	if diags.HasError() {
+		return nil, diags
 	}
 
-	return []map[string]interface{}{
-		{
-			"enable_triggering_on_undetected_values": false,
-		},
+	var id *wrapperspb.StringValue
+	if !plan.ID.IsNull() && !plan.ID.IsUnknown() {
+		id = wrapperspb.String(plan.ID.ValueString())
 	}
-}
 
-func flattenDeadmanRatio(cleanupDeadmanDuration alerts.CleanupDeadmanDuration) string {
-	deadmanRatioStr := alerts.CleanupDeadmanDuration_name[int32(cleanupDeadmanDuration)]
-	deadmanRatio := alertProtoDeadmanRatiosToSchemaDeadmanRatios[deadmanRatioStr]
-	return deadmanRatio
-}
-
-func flattenRatioAlert(filters *alerts.AlertFilters, condition interface{}) interface{} {
-	query1Map := flattenCommonAlert(filters)
-	query1Map["alias"] = filters.GetAlias().GetValue()
-	query2 := filters.GetRatioAlerts()[0]
-	query2Map := flattenQuery2ParamsMap(query2)
-	conditionMap := flattenRatioCondition(condition, query2)
-
-	return []interface{}{
-		map[string]interface{}{
-			"query_1":   []interface{}{query1Map},
-			"query_2":   []interface{}{query2Map},
-			"condition": []interface{}{conditionMap},
-		},
-	}
+	return &alerts.Alert{
+		UniqueIdentifier:           id,
+		Name:                       typeStringToWrapperspbString(plan.Name),
+		Description:                typeStringToWrapperspbString(plan.Description),
+		IsActive:                   wrapperspb.Bool(plan.Enabled.ValueBool()),
+		Severity:                   expandAlertSeverity(plan.Severity.ValueString()),
+		MetaLabels:                 expandMetaLabels(plan.MetaLabels),
+		Expiration:                 expandExpirationDate(plan.ExpirationDate),
+		ShowInInsight:              showInInsight,
+		NotificationGroups:         notificationGroups,
+		NotificationPayloadFilters: typeStringSliceToWrappedStringSlice(plan.PayloadFilters.Elements()),
+		ActiveWhen:                 scheduling,
+		Filters:                    alertTypeParams.Filters,
+		Condition:                  alertTypeParams.Condition,
+		TracingAlert:               tracingAlert,
+	}, diags
 }
 
-func flattenRatioCondition(condition interface{}, query2 *alerts.AlertFilters_RatioAlert) interface{} {
-	var conditionParams *alerts.ConditionParameters
-	ratioParamsMap := make(map[string]interface{})
+func expandAlertSeverity(severity string) alerts.AlertSeverity {
+	severityStr := alertSchemaSeverityToProtoSeverity[severity]
+	formatStandardVal := alerts.AlertSeverity_value[severityStr]
+	return alerts.AlertSeverity(formatStandardVal)
+}
 
-	lessThan := false
-	switch condition := condition.(type) {
-	case *alerts.AlertCondition_LessThan:
-		conditionParams = condition.LessThan.GetParameters()
-		ratioParamsMap["less_than"] = true
-		lessThan = true
-	case *alerts.AlertCondition_MoreThan:
-		conditionParams = condition.MoreThan.GetParameters()
-		ratioParamsMap["more_than"] = true
-	default:
+func expandExpirationDate(expirationDate *AlertExpirationDateModel) *alerts.Date {
+	if expirationDate == nil {
 		return nil
 	}
+	return &alerts.Date{
+		Year:  int32(expirationDate.Year.ValueInt64()),
+		Month: int32(expirationDate.Month.ValueInt64()),
+		Day:   int32(expirationDate.Day.ValueInt64()),
+	}
+}
 
-	ratioParamsMap["ratio_threshold"] = conditionParams.GetThreshold().GetValue()
-	ratioParamsMap["time_window"] = alertProtoTimeFrameToSchemaTimeFrame[conditionParams.GetTimeframe().String()]
-	ratioParamsMap["ignore_infinity"] = conditionParams.GetIgnoreInfinity().GetValue()
-
-	groupByQ1 := conditionParams.GetGroupBy()
-	groupByQ2 := query2.GetGroupBy()
-	var groupBy []string
-	if len(groupByQ1) > 0 {
-		groupBy = wrappedStringSliceToStringSlice(groupByQ1)
-		if len(groupByQ2) > 0 {
-			ratioParamsMap["group_by_both"] = true
-		} else {
-			ratioParamsMap["group_by_q1"] = true
-		}
-	} else if len(groupByQ2) > 0 {
-		groupBy = wrappedStringSliceToStringSlice(groupByQ2)
-		ratioParamsMap["group_by_q1"] = true
+func expandShowInInsight(ctx context.Context, showInInsights types.Object) (*alerts.ShowInInsight, diag.Diagnostics) {
+	if showInInsights.IsNull() || showInInsights.IsUnknown() {
+		return nil, nil
 	}
-	ratioParamsMap["group_by"] = groupBy
 
-	if len(groupBy) > 0 && lessThan {
-		ratioParamsMap["manage_undetected_values"] = flattenManageUndetectedValues(conditionParams.GetRelatedExtendedData())
+	var showInInsightsModel AlertShowInInsightsModel
+	if diags := showInInsights.As(ctx, &showInInsightsModel, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true}); diags.HasError() {
+		return nil, diags
 	}
 
-	return ratioParamsMap
+	retriggeringPeriodSeconds := wrapperspb.UInt32(uint32(showInInsightsModel.RetriggeringPeriodMinutes.ValueInt64()) * 60)
+	notifyOn := alertSchemaNotifyOnToProtoNotifyOn[showInInsightsModel.NotifyOn.ValueString()]
+	return &alerts.ShowInInsight{
+		RetriggeringPeriodSeconds: retriggeringPeriodSeconds,
+		NotifyOn:                  &notifyOn,
+	}, nil
 }
 
-func flattenQuery2ParamsMap(query2 *alerts.AlertFilters_RatioAlert) interface{} {
-	return map[string]interface{}{
-		"alias":        query2.GetAlias().GetValue(),
-		"search_query": query2.GetText().GetValue(),
-		"severities":   extractSeverities(query2.GetSeverities()),
-		"applications": wrappedStringSliceToStringSlice(query2.GetApplications()),
-		"subsystems":   wrappedStringSliceToStringSlice(query2.GetSubsystems()),
+func expandNotificationGroups(notificationGroups []AlertNotificationGroupModel) ([]*alerts.AlertNotificationGroups, diag.Diagnostics) {
+	result := make([]*alerts.AlertNotificationGroups, 0, len(notificationGroups))
+	var diags diag.Diagnostics
+	for _, notificationGroup := range notificationGroups {
+		notifications, dgs := expandNotifications(notificationGroup.Notifications)
+		diags.Append(dgs...)
+		result = append(result, &alerts.AlertNotificationGroups{
+			GroupByFields: typeStringSliceToWrappedStringSlice(notificationGroup.GroupByFields.Elements()),
+			Notifications: notifications,
+		})
 	}
+	return result, diags
 }
 
-func flattenUniqueCountAlert(filters *alerts.AlertFilters, condition interface{}) interface{} {
-	alertSchema := flattenCommonAlert(filters)
-	conditionMap := flattenUniqueCountCondition(condition)
-	alertSchema["condition"] = []interface{}{conditionMap}
-	return []interface{}{alertSchema}
+func expandNotifications(notifications []AlertNotificationModel) ([]*alerts.AlertNotification, diag.Diagnostics) {
+	result := make([]*alerts.AlertNotification, 0, len(notifications))
+	var diags diag.Diagnostics
+	for _, n := range notifications {
+		notification, err := expandNotification(n)
+		if err != nil {
+			diags.AddError("Error expanding notification", err.Error())
+			continue
+		}
+		result = append(result, notification)
+	}
+	return result, diags
 }
 
-func flattenUniqueCountCondition(condition interface{}) interface{} {
-	conditionParams := condition.(*alerts.AlertCondition_UniqueCount).UniqueCount.GetParameters()
-	conditionMap := map[string]interface{}{
-		"unique_count_key":  conditionParams.GetCardinalityFields()[0].GetValue(),
-		"max_unique_values": conditionParams.GetThreshold().GetValue(),
-		"time_window":       alertProtoUniqueCountTimeFrameToSchemaTimeFrame[conditionParams.GetTimeframe().String()],
-	}
+func expandNotification(notificationModel AlertNotificationModel) (*alerts.AlertNotification, error) {
+	notifyEverySec := wrapperspb.UInt32(uint32(notificationModel.RetriggeringPeriodMinutes.ValueInt64()) * 60)
+	notifyOn := alertSchemaNotifyOnToProtoNotifyOn[notificationModel.NotifyOn.ValueString()]
 
-	if groupBy := conditionParams.GetGroupBy(); len(groupBy) > 0 {
-		conditionMap["group_by_key"] = conditionParams.GetGroupBy()[0].GetValue()
-		conditionMap["max_unique_values_for_group_by"] = conditionParams.GetMaxUniqueCountValuesForGroupByKey().GetValue()
+	notification := &alerts.AlertNotification{
+		RetriggeringPeriodSeconds: notifyEverySec,
+		NotifyOn:                  &notifyOn,
 	}
 
-	return conditionMap
-}
+	var isWebhookIdDefined bool
+	if webhookID := notificationModel.IntegrationID.ValueString(); webhookID != "" {
+		isWebhookIdDefined = true
+		id, err := strconv.Atoi(webhookID)
+		if err != nil {
+			return nil, fmt.Errorf("integration_id %q is not a valid webhook ID", webhookID)
+		}
+		notification.IntegrationType = &alerts.AlertNotification_IntegrationId{
+			IntegrationId: wrapperspb.UInt32(uint32(id)),
+		}
+	}
 
-func flattenTimeRelativeAlert(filters *alerts.AlertFilters, condition interface{}) interface{} {
-	alertSchema := flattenCommonAlert(filters)
-	conditionMap := flattenTimeRelativeCondition(condition)
-	alertSchema["condition"] = []interface{}{conditionMap}
-	return []interface{}{alertSchema}
-}
+	if emails := notificationModel.EmailRecipients.Elements(); len(emails) != 0 {
+		if isWebhookIdDefined {
+			return nil, fmt.Errorf("required exactly on of 'integration_id' or 'email_recipients'")
+		}
 
-func flattenTimeRelativeCondition(condition interface{}) interface{} {
-	var conditionParams *alerts.ConditionParameters
-	timeRelativeCondition := make(map[string]interface{})
-	switch condition := condition.(type) {
-	case *alerts.AlertCondition_LessThan:
-		conditionParams = condition.LessThan.GetParameters()
-		timeRelativeCondition["less_than"] = true
-		if len(conditionParams.GroupBy) > 0 {
-			timeRelativeCondition["manage_undetected_values"] = flattenManageUndetectedValues(conditionParams.GetRelatedExtendedData())
+		notification.IntegrationType = &alerts.AlertNotification_Recipients{
+			Recipients: &alerts.Recipients{
+				Emails: typeStringSliceToWrappedStringSlice(emails),
+			},
 		}
-	case *alerts.AlertCondition_MoreThan:
-		conditionParams = condition.MoreThan.GetParameters()
-		timeRelativeCondition["more_than"] = true
-	default:
-		return nil
 	}
 
-	timeRelativeCondition["ignore_infinity"] = conditionParams.GetIgnoreInfinity().GetValue()
-	timeRelativeCondition["ratio_threshold"] = int(conditionParams.GetThreshold().GetValue())
-	timeRelativeCondition["group_by"] = wrappedStringSliceToStringSlice(conditionParams.GroupBy)
-	timeFrame := conditionParams.GetTimeframe()
-	relativeTimeFrame := conditionParams.GetRelativeTimeframe()
-	timeRelativeCondition["relative_time_window"] = flattenRelativeTimeWindow(timeFrame, relativeTimeFrame)
-
-	return timeRelativeCondition
+	return notification, nil
 }
 
-func flattenRelativeTimeWindow(timeFrame alerts.Timeframe, relativeTimeFrame alerts.RelativeTimeframe) string {
-	p := protoTimeFrameAndRelativeTimeFrame{timeFrame: timeFrame, relativeTimeFrame: relativeTimeFrame}
-	return alertProtoTimeFrameAndRelativeTimeFrameToSchemaRelativeTimeFrame[p]
+func expandMetaLabels(metaLabels types.Map) []*alerts.MetaLabel {
+	elements := metaLabels.Elements()
+	result := make([]*alerts.MetaLabel, 0, len(elements))
+	for key, value := range elements {
+		result = append(result, &alerts.MetaLabel{
+			Key:   wrapperspb.String(key),
+			Value: wrapperspb.String(value.(types.String).ValueString()),
+		})
+	}
+	return result
 }
 
-func flattenMetricAlert(filters *alerts.AlertFilters, condition interface{}) interface{} {
-	var conditionParams *alerts.ConditionParameters
-	var conditionStr string
-	switch condition := condition.(type) {
-	case *alerts.AlertCondition_LessThan:
-		conditionParams = condition.LessThan.GetParameters()
-		conditionStr = "less_than"
-	case *alerts.AlertCondition_MoreThan:
-		conditionParams = condition.MoreThan.GetParameters()
-		conditionStr = "more_than"
-	case *alerts.AlertCondition_MoreThanUsual:
-		conditionParams = condition.MoreThanUsual.GetParameters()
-		conditionStr = "more_than_usual"
-	default:
-		return nil
+func expandActiveWhen(scheduling *AlertSchedulingModel) (*alerts.AlertActiveWhen, diag.Diagnostics) {
+	if scheduling == nil {
+		return nil, nil
 	}
 
-	var metricTypeStr string
-	var searchQuery string
-	var conditionMap map[string]interface{}
-	promqlParams := conditionParams.GetMetricAlertPromqlParameters()
-	if promqlParams != nil {
-		metricTypeStr = "promql"
-		searchQuery = promqlParams.GetPromqlText().GetValue()
-		conditionMap = flattenPromQLCondition(conditionParams)
-	} else {
-		metricTypeStr = "lucene"
-		searchQuery = filters.GetText().GetValue()
-		conditionMap = flattenLuceneCondition(conditionParams)
-	}
-	conditionMap[conditionStr] = true
-	if conditionStr == "less_than" {
-		conditionMap["manage_undetected_values"] = flattenManageUndetectedValues(conditionParams.GetRelatedExtendedData())
+	var diags diag.Diagnostics
+	offset, err := alertTimeZoneOffsetMinutes(scheduling.TimeZone.ValueString(), time.Now())
+	if err != nil {
+		diags.AddAttributeError(path.Root("scheduling").AtName("time_zone"), "Invalid time zone", err.Error())
+		return nil, diags
 	}
 
-	metricMap := map[string]interface{}{
-		"search_query": searchQuery,
-		"condition":    []interface{}{conditionMap},
+	return &alerts.AlertActiveWhen{
+		Timeframes: expandActiveTimeframes(scheduling.TimeFrames, offset),
+	}, nil
+}
+
+func expandActiveTimeframes(timeFrames []AlertTimeFrameModel, offset int32) []*alerts.AlertActiveTimeframe {
+	result := make([]*alerts.AlertActiveTimeframe, 0, len(timeFrames))
+	for _, tf := range timeFrames {
+		result = append(result, expandActiveTimeFrame(tf, offset))
 	}
+	return result
+}
 
-	return []interface{}{
-		map[string]interface{}{
-			metricTypeStr: []interface{}{metricMap},
-		},
+func expandActiveTimeFrame(timeFrame AlertTimeFrameModel, offset int32) *alerts.AlertActiveTimeframe {
+	daysOfWeek := expandDaysOfWeek(timeFrame.DaysEnabled)
+	frameRange := expandRange(timeFrame.StartTime, timeFrame.EndTime)
+	frameRange, daysOfWeek = convertTimeFramesToGMT(frameRange, daysOfWeek, offset)
+
+	return &alerts.AlertActiveTimeframe{
+		DaysOfWeek: daysOfWeek,
+		Range:      frameRange,
 	}
 }
 
-func flattenPromQLCondition(params *alerts.ConditionParameters) (promQLConditionMap map[string]interface{}) {
-	promqlParams := params.GetMetricAlertPromqlParameters()
-	promQLConditionMap =
-		map[string]interface{}{
-			"threshold":                       params.GetThreshold().GetValue(),
-			"time_window":                     alertProtoMetricTimeFrameToMetricSchemaTimeFrame[params.GetTimeframe().String()],
-			"sample_threshold_percentage":     promqlParams.GetSampleThresholdPercentage().GetValue(),
-			"replace_missing_value_with_zero": promqlParams.GetSwapNullValues().GetValue(),
-			"min_non_null_values_percentage":  promqlParams.GetNonNullPercentage().GetValue(),
+// validateTimeFramesOverlap returns an error for every pair of time frames that are active at the same time
+// on some day of week. Time frames are compared as ranges of minutes within a week, so frames crossing midnight
+// (or the end of Sunday) are handled as well. Time frames with values which are unknown until apply are skipped.
+func validateTimeFramesOverlap(timeFrames []AlertTimeFrameModel) diag.Diagnostics {
+	type weekRange struct {
+		start, end int32
+		timeFrame  string
+	}
+	var ranges []weekRange
+	for _, tf := range timeFrames {
+		if tf.StartTime.IsUnknown() || tf.EndTime.IsUnknown() || tf.DaysEnabled.IsUnknown() {
+			continue
 		}
-	return
+		frameRange := expandRange(tf.StartTime, tf.EndTime)
+		start := frameRange.GetStart().GetHours()*60 + frameRange.GetStart().GetMinutes()
+		end := frameRange.GetEnd().GetHours()*60 + frameRange.GetEnd().GetMinutes()
+		if end <= start {
+			end += minutesInDay
+		}
+		days := wrappedStringSliceToStringSlice(typeStringSliceToWrappedStringSlice(tf.DaysEnabled.Elements()))
+		sort.Slice(days, func(i, j int) bool {
+			return alertSchemaDayOfWeekToProtoDayOfWeekValue(days[i]) < alertSchemaDayOfWeekToProtoDayOfWeekValue(days[j])
+		})
+		description := fmt.Sprintf("%s-%s on %s", tf.StartTime.ValueString(), tf.EndTime.ValueString(), strings.Join(days, ", "))
+		for _, d := range expandDaysOfWeek(tf.DaysEnabled) {
+			dayStart := int32(d) * minutesInDay
+			ranges = append(ranges, weekRange{start: dayStart + start, end: dayStart + end, timeFrame: description})
+		}
+	}
+
+	var diags diag.Diagnostics
+	reported := make(map[string]bool)
+	for i := range ranges {
+		for j := i + 1; j < len(ranges); j++ {
+			r1, r2 := ranges[i], ranges[j]
+			if r1.timeFrame == r2.timeFrame {
+				continue
+			}
+			if !weekRangesOverlap(r1.start, r1.end, r2.start, r2.end) {
+				continue
+			}
+			key := r1.timeFrame + "|" + r2.timeFrame
+			if reported[key] {
+				continue
+			}
+			reported[key] = true
+			diags.AddAttributeError(path.Root("scheduling").AtName("time_frame"),
+				"Overlapping scheduling time frames",
+				fmt.Sprintf("scheduling time frames %q and %q overlap", r1.timeFrame, r2.timeFrame))
+		}
+	}
+	return diags
 }
 
-func flattenLuceneCondition(params *alerts.ConditionParameters) map[string]interface{} {
-	metricParams := params.GetMetricAlertParameters()
-	return map[string]interface{}{
-		"metric_field":                    metricParams.GetMetricField().GetValue(),
-		"arithmetic_operator":             alertProtoArithmeticOperatorToSchemaArithmetic[metricParams.GetArithmeticOperator().String()],
-		"threshold":                       params.GetThreshold().GetValue(),
-		"arithmetic_operator_modifier":    metricParams.GetArithmeticOperatorModifier().GetValue(),
-		"sample_threshold_percentage":     metricParams.GetSampleThresholdPercentage().GetValue(),
-		"time_window":                     alertProtoMetricTimeFrameToMetricSchemaTimeFrame[params.GetTimeframe().String()],
-		"group_by":                        wrappedStringSliceToStringSlice(params.GetGroupBy()),
-		"replace_missing_value_with_zero": metricParams.GetSwapNullValues().GetValue(),
-		"min_non_null_values_percentage":  metricParams.GetNonNullPercentage().GetValue(),
-	}
-}
-
-func flattenTracingAlert(condition interface{}, tracingAlert *alerts.TracingAlert) interface{} {
-	latencyThresholdMS := float64(tracingAlert.GetConditionLatency()) / float64(time.Millisecond.Microseconds())
-	applications, subsystems, services := flattenTracingFilters(tracingAlert.GetFieldFilters())
-	tagFilters := flattenTagFiltersData(tracingAlert.GetTagFilters())
-	conditionSchema := flattenTracingCondition(condition)
-
-	return []interface{}{
-		map[string]interface{}{
-			"latency_threshold_milliseconds": latencyThresholdMS,
-			"applications":                   applications,
-			"subsystems":                     subsystems,
-			"services":                       services,
-			"tag_filter":                     tagFilters,
-			"condition":                      conditionSchema,
-		},
+// weekRangesOverlap checks whether [start1, end1) and [start2, end2) overlap, where both ranges are minutes
+// within a week and may wrap past its end.
+func weekRangesOverlap(start1, end1, start2, end2 int32) bool {
+	minutesInWeek := 7 * minutesInDay
+	for _, shift := range []int32{-minutesInWeek, 0, minutesInWeek} {
+		if start1 < end2+shift && start2+shift < end1 {
+			return true
+		}
 	}
+	return false
 }
 
-func flattenTracingFilters(tracingFilters []*alerts.FilterData) (applications, subsystems, services interface{}) {
-	filtersData := flattenFiltersData(tracingFilters)
-	applications = filtersData["applicationName"]
-	subsystems = filtersData["subsystemName"]
-	services = filtersData["serviceName"]
-	return
+func alertSchemaDayOfWeekToProtoDayOfWeekValue(day string) int32 {
+	return alerts.DayOfWeek_value[alertSchemaDayOfWeekToProtoDayOfWeek[day]]
 }
 
-func flattenFlowAlert(condition interface{}) interface{} {
-	return []interface{}{flattenFlowAlertsCondition(condition.(*alerts.AlertCondition_Flow))}
+// convertTimeFramesToGMT moves a time frame from a time zone with the given offset (in minutes) to GMT.
+// The days of week are shifted according to the start time, so a frame that starts on Monday 01:00 in UTC+2
+// starts on Sunday 23:00 in GMT.
+func convertTimeFramesToGMT(frameRange *alerts.TimeRange, daysOfWeek []alerts.DayOfWeek, offset int32) (*alerts.TimeRange, []alerts.DayOfWeek) {
+	start, daysOffset := shiftTimeInDay(frameRange.GetStart(), -offset)
+	end, _ := shiftTimeInDay(frameRange.GetEnd(), -offset)
+
+	return &alerts.TimeRange{Start: start, End: end}, shiftDaysOfWeek(daysOfWeek, daysOffset)
 }
 
-func flattenFlowAlertsCondition(condition *alerts.AlertCondition_Flow) interface{} {
-	stages := flattenStages(condition.Flow.GetStages())
-	return map[string]interface{}{
-		"stage": stages,
-	}
+// shiftTimeInDay adds offset minutes to t, and returns the shifted time together with the number of days it moved.
+func shiftTimeInDay(t *alerts.Time, offset int32) (*alerts.Time, int32) {
+	minutes := t.GetHours()*60 + t.GetMinutes() + offset
+	daysOffset := floorDiv(minutes, minutesInDay)
+	minutes -= daysOffset * minutesInDay
+
+	return &alerts.Time{
+		Hours:   minutes / 60,
+		Minutes: minutes % 60,
+	}, daysOffset
 }
 
-func flattenStages(stages []*alerts.FlowStage) []interface{} {
-	result := make([]interface{}, 0, len(stages))
-	for _, stage := range stages {
-		result = append(result, flattenStage(stage))
+func shiftDaysOfWeek(daysOfWeek []alerts.DayOfWeek, daysOffset int32) []alerts.DayOfWeek {
+	result := make([]alerts.DayOfWeek, 0, len(daysOfWeek))
+	for _, d := range daysOfWeek {
+		day := (int32(d) + daysOffset) % 7
+		if day < 0 {
+			day += 7
+		}
+		result = append(result, alerts.DayOfWeek(day))
 	}
 	return result
 }
 
-func flattenStage(stage *alerts.FlowStage) interface{} {
-	timeMS := int(stage.GetTimeframe().GetMs().GetValue())
-	return map[string]interface{}{
-		"group":       flattenGroups(stage.GetGroups()),
-		"time_window": flattenTimeframe(timeMS),
+func floorDiv(a, b int32) int32 {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
 	}
+	return q
 }
 
-func flattenGroups(groups []*alerts.FlowGroup) []interface{} {
-	result := make([]interface{}, 0, len(groups))
-	for _, g := range groups {
-		result = append(result, flattenGroup(g))
-	}
-	return result
+// alertTimeZoneValidator accepts an IANA time zone name or a fixed offset from UTC.
+type alertTimeZoneValidator struct{}
+
+func (v alertTimeZoneValidator) Description(_ context.Context) string {
+	return "value must be an IANA time zone name or a fixed offset between UTC-12 and UTC+14"
+}
+
+func (v alertTimeZoneValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
 }
 
-func flattenGroup(fg *alerts.FlowGroup) interface{} {
-	subAlerts := flattenSubAlerts(fg.GetAlerts())
-	operator := fg.GetNextOp().String()
-	return map[string]interface{}{
-		"sub_alerts":    subAlerts,
-		"next_operator": operator,
+func (v alertTimeZoneValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := alertTimeZoneOffsetMinutes(req.ConfigValue.ValueString(), time.Now()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid time zone", err.Error())
 	}
 }
 
-func flattenSubAlerts(subAlerts *alerts.FlowAlerts) interface{} {
-	operator := subAlerts.GetOp().String()
-	flowAlerts := make([]interface{}, 0, len(subAlerts.GetValues()))
-	for _, sa := range subAlerts.GetValues() {
-		flowAlerts = append(flowAlerts, flattenInnerFlowAlert(sa))
+// alertTimeZoneOffsetMinutes returns the offset from GMT (in minutes) of timeZone at the given moment.
+// timeZone is either a fixed offset (UTC+2, UTC+5:30) or an IANA time zone name (Asia/Kolkata).
+func alertTimeZoneOffsetMinutes(timeZone string, at time.Time) (int32, error) {
+	if matches := alertFixedTimeZoneRegex.FindStringSubmatch(timeZone); matches != nil {
+		hours, _ := strconv.Atoi(matches[2])
+		var minutes int
+		if matches[3] != "" {
+			minutes, _ = strconv.Atoi(matches[3])
+		}
+		offset := int32(hours*60 + minutes)
+		if matches[1] == "-" {
+			offset = -offset
+		}
+		if offset < -12*60 || offset > 14*60 {
+			return 0, fmt.Errorf("time zone offset %q must be between UTC-12 and UTC+14", timeZone)
+		}
+		return offset, nil
 	}
 
-	return []interface{}{
-		map[string]interface{}{
-			"operator":   operator,
-			"flow_alert": flowAlerts,
-		},
+	location, err := loadAlertTimeZoneLocation(timeZone)
+	if err != nil {
+		return 0, err
 	}
+	_, offset := at.In(location).Zone()
+	return int32(offset / 60), nil
 }
 
-func flattenInnerFlowAlert(subAlert *alerts.FlowAlert) interface{} {
-	return map[string]interface{}{
-		"not":           subAlert.GetNot().GetValue(),
-		"user_alert_id": subAlert.GetId().GetValue(),
+func loadAlertTimeZoneLocation(timeZone string) (*time.Location, error) {
+	if timeZone == "" || timeZone == "Local" {
+		return nil, fmt.Errorf("time zone %q is not valid, expected an IANA time zone name or a fixed offset such as UTC+2", timeZone)
+	}
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, fmt.Errorf("time zone %q is not valid, expected an IANA time zone name or a fixed offset such as UTC+2 - %s", timeZone, err)
 	}
+	return location, nil
 }
 
-func flattenFiltersData(filtersData []*alerts.FilterData) map[string]interface{} {
-	result := make(map[string]interface{}, len(filtersData))
-	for _, filter := range filtersData {
-		field := filter.GetField()
-		result[field] = flattenFilters(filter.GetFilters())
+// alertTimeZoneTransitionDiagnostics warns when timeZone is going to change its offset within the next year,
+// as the backend keeps schedules in GMT and the schedule has to be applied again after the transition.
+func alertTimeZoneTransitionDiagnostics(timeZone string, now time.Time) diag.Diagnostics {
+	if alertFixedTimeZoneRegex.MatchString(timeZone) {
+		return nil
+	}
+	location, err := loadAlertTimeZoneLocation(timeZone)
+	if err != nil {
+		return nil
+	}
+	transition, ok := nextTimeZoneTransition(location, now)
+	if !ok {
+		return nil
 	}
-	return result
-}
 
-func flattenTagFiltersData(filtersData []*alerts.FilterData) interface{} {
-	fieldToFilters := flattenFiltersData(filtersData)
-	result := make([]interface{}, 0, len(fieldToFilters))
-	for field, filters := range fieldToFilters {
-		filterSchema := map[string]interface{}{
-			"field":  field,
-			"values": filters,
+	_, offset := now.In(location).Zone()
+	_, nextOffset := transition.In(location).Zone()
+	var diags diag.Diagnostics
+	diags.AddAttributeWarning(path.Root("scheduling").AtName("time_zone"),
+		fmt.Sprintf("Time zone %q changes its offset on %s", timeZone, transition.In(location).Format(time.RFC3339)),
+		fmt.Sprintf("Alert schedules are stored in GMT, so the schedule is converted with the current offset (%s). "+
+			"After the transition to %s the alert will be active at shifted hours, and the next plan will show a change "+
+			"in scheduling. Apply it again to convert the schedule with the new offset.",
+			formatTimeZoneOffset(offset), formatTimeZoneOffset(nextOffset)),
+	)
+	return diags
+}
+
+// nextTimeZoneTransition returns the first moment within a year from now at which location changes its offset.
+func nextTimeZoneTransition(location *time.Location, now time.Time) (time.Time, bool) {
+	_, offset := now.In(location).Zone()
+	before := now
+	for after := now.Add(24 * time.Hour); after.Before(now.AddDate(1, 0, 1)); after = after.Add(24 * time.Hour) {
+		if _, o := after.In(location).Zone(); o != offset {
+			for after.Sub(before) > time.Second {
+				middle := before.Add(after.Sub(before) / 2)
+				if _, o := middle.In(location).Zone(); o != offset {
+					after = middle
+				} else {
+					before = middle
+				}
+			}
+			return after.Truncate(time.Second), true
 		}
-		result = append(result, filterSchema)
+		before = after
 	}
-	return result
+	return time.Time{}, false
 }
 
-func flattenFilters(filters []*alerts.Filters) []string {
-	result := make([]string, 0)
-	for _, f := range filters {
-		values := f.GetValues()
-		switch operator := f.GetOperator(); operator {
-		case "notEquals", "contains", "startsWith", "endsWith":
-			for i, val := range values {
-				values[i] = fmt.Sprintf("filter:%s:%s", operator, val)
-			}
-		}
-		result = append(result, values...)
+func formatTimeZoneOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign = "-"
+		seconds = -seconds
 	}
-	return result
+	return fmt.Sprintf("UTC%s%s:%s", sign, toTwoDigitsFormat(int32(seconds/3600)), toTwoDigitsFormat(int32(seconds%3600/60)))
 }
 
-func flattenTracingCondition(condition interface{}) interface{} {
-	switch condition := condition.(type) {
-	case *alerts.AlertCondition_Immediate:
-		return []interface{}{
-			map[string]interface{}{
-				"immediately": true,
-			},
-		}
-	case *alerts.AlertCondition_MoreThan:
-		conditionParams := condition.MoreThan.GetParameters()
-		return []interface{}{
-			map[string]interface{}{
-				"more_than":   true,
-				"threshold":   conditionParams.GetThreshold().GetValue(),
-				"time_window": alertProtoTimeFrameToSchemaTimeFrame[conditionParams.GetTimeframe().String()],
-				"group_by":    wrappedStringSliceToStringSlice(conditionParams.GetGroupBy()),
-			},
-		}
-	default:
-		return nil
+func expandDaysOfWeek(daysEnabled types.Set) []alerts.DayOfWeek {
+	elements := daysEnabled.Elements()
+	result := make([]alerts.DayOfWeek, 0, len(elements))
+	for _, v := range elements {
+		dayOfWeekStr := alertSchemaDayOfWeekToProtoDayOfWeek[v.(types.String).ValueString()]
+		dayOfWeekVal := alerts.DayOfWeek_value[dayOfWeekStr]
+		result = append(result, alerts.DayOfWeek(dayOfWeekVal))
 	}
+	return result
 }
 
-func flattenCommonAlert(filters *alerts.AlertFilters) map[string]interface{} {
-	metadata := filters.GetMetadata()
-	return map[string]interface{}{
-		"search_query": filters.GetText().GetValue(),
-		"severities":   extractSeverities(filters.GetSeverities()),
-		"applications": wrappedStringSliceToStringSlice(metadata.GetApplications()),
-		"subsystems":   wrappedStringSliceToStringSlice(metadata.GetSubsystems()),
-		"categories":   wrappedStringSliceToStringSlice(metadata.GetCategories()),
-		"computers":    wrappedStringSliceToStringSlice(metadata.GetComputers()),
-		"classes":      wrappedStringSliceToStringSlice(metadata.GetClasses()),
-		"methods":      wrappedStringSliceToStringSlice(metadata.GetMethods()),
-		"ip_addresses": wrappedStringSliceToStringSlice(metadata.GetIpAddresses()),
+func expandRange(activityStarts, activityEnds types.String) *alerts.TimeRange {
+	return &alerts.TimeRange{
+		Start: expandTimeInDay(activityStarts.ValueString()),
+		End:   expandTimeInDay(activityEnds.ValueString()),
 	}
 }
 
-func extractSeverities(severities []alerts.AlertFilters_LogSeverity) []string {
-	result := make([]string, 0, len(severities))
-	for _, s := range severities {
-		result = append(result, alertProtoLogSeverityToSchemaLogSeverity[s.String()])
+func expandTimeInDay(s string) *alerts.Time {
+	timeArr := strings.Split(s, ":")
+	if len(timeArr) != 2 {
+		return &alerts.Time{}
+	}
+	hours, _ := strconv.Atoi(timeArr[0])
+	minutes, _ := strconv.Atoi(timeArr[1])
+	return &alerts.Time{
+		Hours:   int32(hours),
+		Minutes: int32(minutes),
 	}
-	return result
 }
 
-func flattenExpirationDate(expiration *alerts.Date) []map[string]int {
-	if expiration == nil {
-		return nil
-	}
-	m := map[string]int{
-		"year":  int(expiration.GetYear()),
-		"month": int(expiration.GetMonth()),
-		"day":   int(expiration.GetDay()),
+func expandAlertType(ctx context.Context, plan AlertResourceModel) (alertTypeParams *alertParams, tracingAlert *alerts.TracingAlert, diags diag.Diagnostics) {
+	switch {
+	case plan.Standard != nil:
+		alertTypeParams, diags = expandStandard(ctx, plan.Standard)
+	case plan.Ratio != nil:
+		alertTypeParams, diags = expandRatio(ctx, plan.Ratio)
+	case plan.NewValue != nil:
+		alertTypeParams = expandNewValue(plan.NewValue)
+	case plan.UniqueCount != nil:
+		alertTypeParams = expandUniqueCount(plan.UniqueCount)
+	case plan.TimeRelative != nil:
+		alertTypeParams, diags = expandTimeRelative(ctx, plan.TimeRelative)
+	case plan.Metric != nil:
+		alertTypeParams, diags = expandMetric(ctx, plan.Metric)
+	case plan.Tracing != nil:
+		alertTypeParams, tracingAlert, diags = expandTracing(plan.Tracing)
+	case plan.Flow != nil:
+		alertTypeParams = expandFlow(plan.Flow)
+	default:
+		diags.AddError("Missing alert type", fmt.Sprintf("Exactly one of %q has to be set", validAlertTypes))
 	}
 
-	return []map[string]int{m}
-}
-
-func expandAlertSeverity(severity string) alerts.AlertSeverity {
-	severityStr := alertSchemaSeverityToProtoSeverity[severity]
-	formatStandardVal := alerts.AlertSeverity_value[severityStr]
-	return alerts.AlertSeverity(formatStandardVal)
+	return
 }
 
-func expandExpirationDate(v interface{}) *alerts.Date {
-	l := v.([]interface{})
-	if len(l) == 0 || l[0] == nil {
-		return nil
-	}
-	raw := l[0]
-	m := raw.(map[string]interface{})
-	return &alerts.Date{
-		Year:  int32(m["year"].(int)),
-		Month: int32(m["month"].(int)),
-		Day:   int32(m["day"].(int)),
+func expandStandard(ctx context.Context, standard *StandardAlertModel) (*alertParams, diag.Diagnostics) {
+	condition, diags := expandStandardCondition(ctx, standard.Condition)
+	if diags.HasError() {
+		return nil, diags
 	}
+	filters := expandCommonAlertFilter(alertLogsFilters{
+		SearchQuery:  standard.SearchQuery,
+		Severities:   standard.Severities,
+		Applications: standard.Applications,
+		Subsystems:   standard.Subsystems,
+		Categories:   standard.Categories,
+		Computers:    standard.Computers,
+		Classes:      standard.Classes,
+		Methods:      standard.Methods,
+		IPAddresses:  standard.IPAddresses,
+	})
+	filters.FilterType = alerts.AlertFilters_FILTER_TYPE_TEXT_OR_UNSPECIFIED
+
+	return &alertParams{
+		Condition: condition,
+		Filters:   filters,
+	}, nil
 }
 
-func expandShowInInsight(v interface{}) *alerts.ShowInInsight {
-	l := v.([]interface{})
-	if len(l) == 0 || l[0] == nil {
-		return nil
+func expandStandardCondition(ctx context.Context, condition *StandardConditionModel) (*alerts.AlertCondition, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	switch {
+	case condition.Immediately.ValueBool():
+		return &alerts.AlertCondition{
+			Condition: &alerts.AlertCondition_Immediate{},
+		}, nil
+	case condition.MoreThanUsual.ValueBool():
+		threshold := wrapperspb.Double(float64(condition.Threshold.ValueInt64()))
+		groupBy := []*wrapperspb.StringValue{wrapperspb.String(condition.GroupByKey.ValueString())}
+		parameters := &alerts.ConditionParameters{
+			Threshold: threshold,
+			GroupBy:   groupBy,
+		}
+		return &alerts.AlertCondition{
+			Condition: &alerts.AlertCondition_MoreThanUsual{
+				MoreThanUsual: &alerts.MoreThanUsualCondition{Parameters: parameters},
+			},
+		}, nil
+	case condition.LessThan.ValueBool():
+		relatedExtendedData, dgs := expandRelatedExtendedData(ctx, condition.ManageUndetectedValues)
+		if dgs.HasError() {
+			return nil, dgs
+		}
+		parameters := expandStandardConditionParameters(condition)
+		parameters.RelatedExtendedData = relatedExtendedData
+		return &alerts.AlertCondition{
+			Condition: &alerts.AlertCondition_LessThan{
+				LessThan: &alerts.LessThanCondition{Parameters: parameters},
+			},
+		}, nil
+	case condition.MoreThan.ValueBool():
+		return &alerts.AlertCondition{
+			Condition: &alerts.AlertCondition_MoreThan{
+				MoreThan: &alerts.MoreThanCondition{
+					Parameters:       expandStandardConditionParameters(condition),
+					EvaluationWindow: expandEvaluationWindow(condition.EvaluationWindow),
+				},
+			},
+		}, nil
 	}
-	raw := l[0]
-	m := raw.(map[string]interface{})
 
-	retriggeringPeriodSeconds := wrapperspb.UInt32(uint32(m["retriggering_period_minutes"].(int)) * 60)
-	notifyOn := alertSchemaNotifyOnToProtoNotifyOn[m["notify_on"].(string)]
-	return &alerts.ShowInInsight{
-		RetriggeringPeriodSeconds: retriggeringPeriodSeconds,
-		NotifyOn:                  &notifyOn,
+	diags.AddError("Invalid standard alert condition", "immediately, less_than, more_than or more_than_usual have to be true")
+	return nil, diags
+}
+
+func expandStandardConditionParameters(condition *StandardConditionModel) *alerts.ConditionParameters {
+	return &alerts.ConditionParameters{
+		Threshold: wrapperspb.Double(float64(condition.Threshold.ValueInt64())),
+		Timeframe: expandTimeFrame(condition.TimeWindow.ValueString()),
+		GroupBy:   typeStringSliceToWrappedStringSlice(condition.GroupBy.Elements()),
 	}
 }
 
-func expandNotificationGroups(v interface{}) ([]*alerts.AlertNotificationGroups, diag.Diagnostics) {
-	v = v.(*schema.Set).List()
-	l := v.([]interface{})
-	result := make([]*alerts.AlertNotificationGroups, 0, len(l))
-	var diags diag.Diagnostics
-	for _, s := range l {
-		ml, dgs := expandNotificationGroup(s)
-		diags = append(diags, dgs...)
-		result = append(result, ml)
+func expandEvaluationWindow(evaluationWindow types.String) *alerts.EvaluationWindow {
+	if evaluationWindow.IsNull() || evaluationWindow.IsUnknown() || evaluationWindow.ValueString() == "" {
+		return nil
 	}
-	return result, diags
+	result := alertSchemaToProtoEvaluationWindow[evaluationWindow.ValueString()]
+	return &result
 }
 
-func expandNotificationGroup(v interface{}) (*alerts.AlertNotificationGroups, diag.Diagnostics) {
-	if v == nil {
+// expandRelatedExtendedData expands manage_undetected_values, which is relevant only for less_than conditions.
+func expandRelatedExtendedData(ctx context.Context, manageUndetectedValues types.Object) (*alerts.RelatedExtendedData, diag.Diagnostics) {
+	if manageUndetectedValues.IsNull() || manageUndetectedValues.IsUnknown() {
 		return nil, nil
 	}
-	m := v.(map[string]interface{})
 
-	groupByFields := interfaceSliceToWrappedStringSlice(m["group_by_fields"].([]interface{}))
-	notifications, diags := expandNotificationSubgroups(m["notification"])
-	if len(diags) != 0 {
+	var manageUndetectedValuesModel ManageUndetectedValuesModel
+	if diags := manageUndetectedValues.As(ctx, &manageUndetectedValuesModel, basetypes.ObjectAsOptions{}); diags.HasError() {
 		return nil, diags
 	}
 
-	return &alerts.AlertNotificationGroups{
-		GroupByFields: groupByFields,
-		Notifications: notifications,
+	var diags diag.Diagnostics
+	autoRetireRatio := manageUndetectedValuesModel.AutoRetireRatio.ValueString()
+	if manageUndetectedValuesModel.EnableTriggeringOnUndetectedValues.ValueBool() {
+		if autoRetireRatio == "" {
+			diags.AddError("Invalid manage_undetected_values", "auto_retire_ratio is required when enable_triggering_on_undetected_values = true")
+			return nil, diags
+		}
+		cleanupDeadmanDurationStr := alertSchemaDeadmanRatiosToProtoDeadmanRatios[autoRetireRatio]
+		cleanupDeadmanDuration := alerts.CleanupDeadmanDuration(alerts.CleanupDeadmanDuration_value[cleanupDeadmanDurationStr])
+		return &alerts.RelatedExtendedData{
+			CleanupDeadmanDuration: &cleanupDeadmanDuration,
+			ShouldTriggerDeadman:   wrapperspb.Bool(true),
+		}, nil
+	}
+
+	if autoRetireRatio != "" {
+		diags.AddError("Invalid manage_undetected_values", "auto_retire_ratio is not allowed when enable_triggering_on_undetected_values = false")
+		return nil, diags
+	}
+	return &alerts.RelatedExtendedData{
+		ShouldTriggerDeadman: wrapperspb.Bool(false),
 	}, nil
 }
 
-func expandNotificationSubgroups(v interface{}) ([]*alerts.AlertNotification, diag.Diagnostics) {
-	v = v.(*schema.Set).List()
-	notifications := v.([]interface{})
-	result := make([]*alerts.AlertNotification, 0, len(notifications))
+func expandRatio(ctx context.Context, ratio *RatioAlertModel) (*alertParams, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	for _, n := range notifications {
-		notification, err := expandNotificationSubgroup(n)
-		if err != nil {
-			diags = append(diags, diag.FromErr(err)...)
+	condition := ratio.Condition
+	groupBy := typeStringSliceToWrappedStringSlice(condition.GroupBy.Elements())
+	var groupByQ1, groupByQ2 []*wrapperspb.StringValue
+	if len(groupBy) > 0 {
+		switch {
+		case condition.GroupByQ1.ValueBool():
+			groupByQ1 = groupBy
+		case condition.GroupByQ2.ValueBool():
+			groupByQ2 = groupBy
+		case condition.GroupByBoth.ValueBool():
+			groupByQ1 = groupBy
+			groupByQ2 = groupBy
+		default:
+			diags.AddError("Invalid ratio alert condition", "group_by is required with one of - group_by_q1/group_by_q2/group_by_both")
+			return nil, diags
 		}
-		result = append(result, notification)
 	}
-	return result, diags
-}
 
-func expandNotificationSubgroup(v interface{}) (*alerts.AlertNotification, error) {
-	if v == nil {
-		return nil, nil
+	parameters := &alerts.ConditionParameters{
+		Threshold:      wrapperspb.Double(condition.RatioThreshold.ValueFloat64()),
+		Timeframe:      expandTimeFrame(condition.TimeWindow.ValueString()),
+		GroupBy:        groupByQ1,
+		IgnoreInfinity: wrapperspb.Bool(condition.IgnoreInfinity.ValueBool()),
+	}
+	alertCondition, diags := expandLessThanOrMoreThanAlertCondition(ctx, condition.LessThan, condition.MoreThan, condition.ManageUndetectedValues, parameters)
+	if diags.HasError() {
+		return nil, diags
 	}
-	m := v.(map[string]interface{})
 
-	notifyEverySec := wrapperspb.UInt32(uint32(m["retriggering_period_minutes"].(int)) * 60)
-	notifyOn := alertSchemaNotifyOnToProtoNotifyOn[m["notify_on"].(string)]
+	query1 := ratio.Query1
+	filters := expandCommonAlertFilter(alertLogsFilters{
+		SearchQuery:  query1.SearchQuery,
+		Severities:   query1.Severities,
+		Applications: query1.Applications,
+		Subsystems:   query1.Subsystems,
+		Categories:   query1.Categories,
+		Computers:    query1.Computers,
+		Classes:      query1.Classes,
+		Methods:      query1.Methods,
+		IPAddresses:  query1.IPAddresses,
+	})
+	filters.FilterType = alerts.AlertFilters_FILTER_TYPE_RATIO
+	filters.Alias = typeStringToWrapperspbString(query1.Alias)
+	filters.RatioAlerts = []*alerts.AlertFilters_RatioAlert{expandQuery2(ratio.Query2, groupByQ2)}
 
-	notification := &alerts.AlertNotification{
-		RetriggeringPeriodSeconds: notifyEverySec,
-		NotifyOn:                  &notifyOn,
-	}
+	return &alertParams{
+		Condition: alertCondition,
+		Filters:   filters,
+	}, nil
+}
 
-	var isWebhookIdDefined bool
-	if webhookID, ok := m["integration_id"].(string); ok && webhookID != "" {
-		isWebhookIdDefined = true
-		id, _ := strconv.Atoi(webhookID)
-		notification.IntegrationType = &alerts.AlertNotification_IntegrationId{
-			IntegrationId: wrapperspb.UInt32(uint32(id)),
-		}
+func expandQuery2(query2 *RatioQuery2Model, groupBy []*wrapperspb.StringValue) *alerts.AlertFilters_RatioAlert {
+	return &alerts.AlertFilters_RatioAlert{
+		Alias:        typeStringToWrapperspbString(query2.Alias),
+		Text:         typeStringToWrapperspbString(query2.SearchQuery),
+		Severities:   expandAlertFiltersSeverities(query2.Severities),
+		Applications: typeStringSliceToWrappedStringSlice(query2.Applications.Elements()),
+		Subsystems:   typeStringSliceToWrappedStringSlice(query2.Subsystems.Elements()),
+		GroupBy:      groupBy,
 	}
+}
 
-	if emails := m["email_recipients"].(*schema.Set).List(); len(emails) != 0 {
-		if isWebhookIdDefined {
-			return nil, fmt.Errorf("required exactly on of 'integration_id' or 'email_recipients'")
-		}
+func expandNewValue(newValue *NewValueAlertModel) *alertParams {
+	filters := expandCommonAlertFilter(alertLogsFilters{
+		SearchQuery:  newValue.SearchQuery,
+		Severities:   newValue.Severities,
+		Applications: newValue.Applications,
+		Subsystems:   newValue.Subsystems,
+		Categories:   newValue.Categories,
+		Computers:    newValue.Computers,
+		Classes:      newValue.Classes,
+		Methods:      newValue.Methods,
+		IPAddresses:  newValue.IPAddresses,
+	})
+	filters.FilterType = alerts.AlertFilters_FILTER_TYPE_TEXT_OR_UNSPECIFIED
 
-		notification.IntegrationType = &alerts.AlertNotification_Recipients{
-			Recipients: &alerts.Recipients{
-				Emails: interfaceSliceToWrappedStringSlice(emails),
+	return &alertParams{
+		Condition: &alerts.AlertCondition{
+			Condition: &alerts.AlertCondition_NewValue{
+				NewValue: &alerts.NewValueCondition{
+					Parameters: &alerts.ConditionParameters{
+						Timeframe: expandNewValueTimeFrame(newValue.Condition.TimeWindow.ValueString()),
+						GroupBy:   []*wrapperspb.StringValue{wrapperspb.String(newValue.Condition.KeyToTrack.ValueString())},
+					},
+				},
 			},
-		}
-	}
+		},
+		Filters: filters,
+	}
+}
+
+func expandUniqueCount(uniqueCount *UniqueCountAlertModel) *alertParams {
+	condition := uniqueCount.Condition
+	filters := expandCommonAlertFilter(alertLogsFilters{
+		SearchQuery:  uniqueCount.SearchQuery,
+		Severities:   uniqueCount.Severities,
+		Applications: uniqueCount.Applications,
+		Subsystems:   uniqueCount.Subsystems,
+		Categories:   uniqueCount.Categories,
+		Computers:    uniqueCount.Computers,
+		Classes:      uniqueCount.Classes,
+		Methods:      uniqueCount.Methods,
+		IPAddresses:  uniqueCount.IPAddresses,
+	})
+	filters.FilterType = alerts.AlertFilters_FILTER_TYPE_UNIQUE_COUNT
 
-	return notification, nil
+	return &alertParams{
+		Condition: &alerts.AlertCondition{
+			Condition: &alerts.AlertCondition_UniqueCount{
+				UniqueCount: &alerts.UniqueCountCondition{
+					Parameters: &alerts.ConditionParameters{
+						CardinalityFields:                 []*wrapperspb.StringValue{wrapperspb.String(condition.UniqueCountKey.ValueString())},
+						Threshold:                         wrapperspb.Double(float64(condition.MaxUniqueValues.ValueInt64())),
+						Timeframe:                         expandUniqueValueTimeFrame(condition.TimeWindow.ValueString()),
+						GroupBy:                           []*wrapperspb.StringValue{wrapperspb.String(condition.GroupByKey.ValueString())},
+						MaxUniqueCountValuesForGroupByKey: wrapperspb.UInt32(uint32(condition.MaxUniqueValuesForGroupBy.ValueInt64())),
+					},
+				},
+			},
+		},
+		Filters: filters,
+	}
 }
 
-func extractMetaLabels(v interface{}) []*alerts.MetaLabel {
-	m := v.(map[string]interface{})
-	result := make([]*alerts.MetaLabel, 0, len(m))
-	for key, val := range m {
-		ml := &alerts.MetaLabel{
-			Key:   wrapperspb.String(key),
-			Value: wrapperspb.String(val.(string)),
-		}
-		result = append(result, ml)
+func expandCommonAlertFilter(filters alertLogsFilters) *alerts.AlertFilters {
+	return &alerts.AlertFilters{
+		Severities: expandAlertFiltersSeverities(filters.Severities),
+		Metadata: &alerts.AlertFilters_MetadataFilters{
+			Categories:   typeStringSliceToWrappedStringSlice(filters.Categories.Elements()),
+			Applications: typeStringSliceToWrappedStringSlice(filters.Applications.Elements()),
+			Subsystems:   typeStringSliceToWrappedStringSlice(filters.Subsystems.Elements()),
+			Computers:    typeStringSliceToWrappedStringSlice(filters.Computers.Elements()),
+			Classes:      typeStringSliceToWrappedStringSlice(filters.Classes.Elements()),
+			Methods:      typeStringSliceToWrappedStringSlice(filters.Methods.Elements()),
+			IpAddresses:  typeStringSliceToWrappedStringSlice(filters.IPAddresses.Elements()),
+		},
+		Text: typeStringToWrapperspbString(filters.SearchQuery),
 	}
-	return result
 }
 
-func expandActiveWhen(v interface{}) *alerts.AlertActiveWhen {
-	l := v.([]interface{})
-	if len(l) == 0 {
-		return nil
+func expandTimeRelative(ctx context.Context, timeRelative *TimeRelativeAlertModel) (*alertParams, diag.Diagnostics) {
+	condition := timeRelative.Condition
+	timeFrame, relativeTimeframe := expandTimeFrameAndRelativeTimeframe(condition.RelativeTimeWindow.ValueString())
+	parameters := &alerts.ConditionParameters{
+		Timeframe:         timeFrame,
+		RelativeTimeframe: relativeTimeframe,
+		GroupBy:           typeStringSliceToWrappedStringSlice(condition.GroupBy.Elements()),
+		Threshold:         wrapperspb.Double(condition.RatioThreshold.ValueFloat64()),
+		IgnoreInfinity:    wrapperspb.Bool(condition.IgnoreInfinity.ValueBool()),
+	}
+	alertCondition, diags := expandLessThanOrMoreThanAlertCondition(ctx, condition.LessThan, condition.MoreThan, condition.ManageUndetectedValues, parameters)
+	if diags.HasError() {
+		return nil, diags
 	}
 
-	schedulingMap := l[0].(map[string]interface{})
-	utc := flattenUtc(schedulingMap["time_zone"].(string))
-	timeFrames := schedulingMap["time_frame"].(*schema.Set).List()
-
-	expandedTimeframes := expandActiveTimeframes(timeFrames, utc)
+	filters := expandCommonAlertFilter(alertLogsFilters{
+		SearchQuery:  timeRelative.SearchQuery,
+		Severities:   timeRelative.Severities,
+		Applications: timeRelative.Applications,
+		Subsystems:   timeRelative.Subsystems,
+		Categories:   timeRelative.Categories,
+		Computers:    timeRelative.Computers,
+		Classes:      timeRelative.Classes,
+		Methods:      timeRelative.Methods,
+		IPAddresses:  timeRelative.IPAddresses,
+	})
+	filters.FilterType = alerts.AlertFilters_FILTER_TYPE_TIME_RELATIVE
 
-	return &alerts.AlertActiveWhen{
-		Timeframes: expandedTimeframes,
-	}
+	return &alertParams{
+		Condition: alertCondition,
+		Filters:   filters,
+	}, nil
 }
 
-func expandActiveTimeframes(timeFrames []interface{}, utc int32) []*alerts.AlertActiveTimeframe {
-	result := make([]*alerts.AlertActiveTimeframe, 0, len(timeFrames))
-	for _, tf := range timeFrames {
-		alertActiveTimeframe := expandActiveTimeFrame(tf, utc)
-		result = append(result, alertActiveTimeframe)
+// expandLessThanOrMoreThanAlertCondition wraps parameters with a less_than or a more_than condition. The undetected
+// values are managed only for less_than conditions.
+func expandLessThanOrMoreThanAlertCondition(ctx context.Context, lessThan, moreThan types.Bool, manageUndetectedValues types.Object,
+	parameters *alerts.ConditionParameters) (*alerts.AlertCondition, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	switch {
+	case lessThan.ValueBool():
+		relatedExtendedData, dgs := expandRelatedExtendedData(ctx, manageUndetectedValues)
+		if dgs.HasError() {
+			return nil, dgs
+		}
+		parameters.RelatedExtendedData = relatedExtendedData
+		return &alerts.AlertCondition{
+			Condition: &alerts.AlertCondition_LessThan{
+				LessThan: &alerts.LessThanCondition{Parameters: parameters},
+			},
+		}, nil
+	case moreThan.ValueBool():
+		return &alerts.AlertCondition{
+			Condition: &alerts.AlertCondition_MoreThan{
+				MoreThan: &alerts.MoreThanCondition{Parameters: parameters},
+			},
+		}, nil
 	}
-	return result
+
+	diags.AddError("Invalid alert condition", "less_than or more_than have to be true")
+	return nil, diags
 }
 
-func expandActiveTimeFrame(timeFrame interface{}, utc int32) *alerts.AlertActiveTimeframe {
-	m := timeFrame.(map[string]interface{})
-	daysOfWeek := expandDaysOfWeek(m["days_enabled"])
-	frameRange := expandRange(m["start_time"], m["end_time"])
-	frameRange, daysOfWeek = convertTimeFramesToGMT(frameRange, daysOfWeek, utc)
+func expandTimeFrameAndRelativeTimeframe(relativeTimeframeStr string) (alerts.Timeframe, alerts.RelativeTimeframe) {
+	p := alertSchemaRelativeTimeFrameToProtoTimeFrameAndRelativeTimeFrame[relativeTimeframeStr]
+	return p.timeFrame, p.relativeTimeFrame
+}
 
-	alertActiveTimeframe := &alerts.AlertActiveTimeframe{
-		DaysOfWeek: daysOfWeek,
-		Range:      frameRange,
+func expandMetric(ctx context.Context, metric *MetricAlertModel) (*alertParams, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var condition *alerts.AlertCondition
+	filters := &alerts.AlertFilters{
+		FilterType: alerts.AlertFilters_FILTER_TYPE_METRIC,
 	}
-	return alertActiveTimeframe
-}
 
-func convertTimeFramesToGMT(frameRange *alerts.TimeRange, daysOfWeek []alerts.DayOfWeek, utc int32) (*alerts.TimeRange, []alerts.DayOfWeek) {
-	daysOfWeekOffset := daysOfWeekOffsetToGMT(frameRange, utc)
-	frameRange.Start.Hours = convertUtcToGmt(frameRange.GetStart().GetHours(), utc)
-	frameRange.End.Hours = convertUtcToGmt(frameRange.GetEnd().GetHours(), utc)
-	if daysOfWeekOffset != 0 {
-		for i, d := range daysOfWeek {
-			daysOfWeek[i] = alerts.DayOfWeek((int32(d) + daysOfWeekOffset) % 7)
-		}
+	switch {
+	case metric.Promql != nil:
+		condition, diags = expandPromqlMetricCondition(ctx, metric.Promql)
+	case metric.Lucene != nil:
+		filters.Text = typeStringToWrapperspbString(metric.Lucene.SearchQuery)
+		condition, diags = expandLuceneMetricCondition(ctx, metric.Lucene)
+	default:
+		diags.AddError("Invalid metric alert", "Exactly one of lucene or promql has to be set")
+	}
+	if diags.HasError() {
+		return nil, diags
 	}
 
-	return frameRange, daysOfWeek
+	return &alertParams{
+		Condition: condition,
+		Filters:   filters,
+	}, nil
 }
 
-func daysOfWeekOffsetToGMT(frameRange *alerts.TimeRange, utc int32) int32 {
-	daysOfWeekOffset := int32(frameRange.Start.Hours-utc) / 24
-	if daysOfWeekOffset < 0 {
-		daysOfWeekOffset += 7
+func expandPromqlMetricCondition(ctx context.Context, promql *PromqlMetricAlertModel) (*alerts.AlertCondition, diag.Diagnostics) {
+	condition := promql.Condition
+	parameters := &alerts.ConditionParameters{
+		Threshold: wrapperspb.Double(condition.Threshold.ValueFloat64()),
+		Timeframe: expandMetricTimeFrame(condition.TimeWindow.ValueString()),
+		GroupBy:   typeStringSliceToWrappedStringSlice(condition.GroupBy.Elements()),
+		MetricAlertPromqlParameters: &alerts.MetricAlertPromqlConditionParameters{
+			PromqlText:                typeStringToWrapperspbString(promql.SearchQuery),
+			SampleThresholdPercentage: wrapperspb.UInt32(uint32(condition.SampleThresholdPercentage.ValueInt64())),
+			NonNullPercentage:         wrapperspb.UInt32(uint32(condition.MinNonNullValuesPercentage.ValueInt64())),
+			SwapNullValues:            wrapperspb.Bool(condition.ReplaceMissingValueWithZero.ValueBool()),
+		},
+	}
+
+	if condition.MoreThanUsual.ValueBool() {
+		return &alerts.AlertCondition{
+			Condition: &alerts.AlertCondition_MoreThanUsual{
+				MoreThanUsual: &alerts.MoreThanUsualCondition{Parameters: parameters},
+			},
+		}, nil
 	}
-	return daysOfWeekOffset
+	if condition.MoreThan.ValueBool() {
+		return &alerts.AlertCondition{
+			Condition: &alerts.AlertCondition_MoreThan{
+				MoreThan: &alerts.MoreThanCondition{
+					Parameters:       parameters,
+					EvaluationWindow: expandEvaluationWindow(condition.EvaluationWindow),
+				},
+			},
+		}, nil
+	}
+	return expandLessThanOrMoreThanAlertCondition(ctx, condition.LessThan, condition.MoreThan, condition.ManageUndetectedValues, parameters)
 }
 
-func convertUtcToGmt(hours, utc int32) int32 {
-	hours -= utc
-	if hours < 0 {
-		hours += 24
-	} else if hours >= 24 {
-		hours -= 24
+func expandLuceneMetricCondition(ctx context.Context, lucene *LuceneMetricAlertModel) (*alerts.AlertCondition, diag.Diagnostics) {
+	condition := lucene.Condition
+	parameters := &alerts.ConditionParameters{
+		Threshold: wrapperspb.Double(condition.Threshold.ValueFloat64()),
+		Timeframe: expandMetricTimeFrame(condition.TimeWindow.ValueString()),
+		GroupBy:   typeStringSliceToWrappedStringSlice(condition.GroupBy.Elements()),
+		MetricAlertParameters: &alerts.MetricAlertConditionParameters{
+			MetricSource:               alerts.MetricAlertConditionParameters_METRIC_SOURCE_LOGS2METRICS_OR_UNSPECIFIED,
+			MetricField:                typeStringToWrapperspbString(condition.MetricField),
+			ArithmeticOperator:         expandArithmeticOperator(condition.ArithmeticOperator.ValueString()),
+			ArithmeticOperatorModifier: wrapperspb.UInt32(uint32(condition.ArithmeticOperatorModifier.ValueInt64())),
+			SampleThresholdPercentage:  wrapperspb.UInt32(uint32(condition.SampleThresholdPercentage.ValueInt64())),
+			NonNullPercentage:          wrapperspb.UInt32(uint32(condition.MinNonNullValuesPercentage.ValueInt64())),
+			SwapNullValues:             wrapperspb.Bool(condition.ReplaceMissingValueWithZero.ValueBool()),
+		},
 	}
 
-	return hours
+	return expandLessThanOrMoreThanAlertCondition(ctx, condition.LessThan, condition.MoreThan, condition.ManageUndetectedValues, parameters)
 }
 
-func convertGmtToUtc(hours, utc int32) int32 {
-	hours += utc
-	if hours < 0 {
-		hours += 24
-	} else if hours >= 24 {
-		hours -= 24
-	}
+func expandArithmeticOperator(s string) alerts.MetricAlertConditionParameters_ArithmeticOperator {
+	arithmeticStr := alertSchemaArithmeticOperatorToProtoArithmetic[s]
+	arithmeticValue := alerts.MetricAlertConditionParameters_ArithmeticOperator_value[arithmeticStr]
+	return alerts.MetricAlertConditionParameters_ArithmeticOperator(arithmeticValue)
+}
 
-	return hours
+func expandFlow(flow *FlowAlertModel) *alertParams {
+	return &alertParams{
+		Condition: &alerts.AlertCondition{
+			Condition: &alerts.AlertCondition_Flow{
+				Flow: &alerts.FlowCondition{
+					Stages: expandFlowStages(flow.Stages),
+				},
+			},
+		},
+		Filters: &alerts.AlertFilters{
+			FilterType: alerts.AlertFilters_FILTER_TYPE_FLOW,
+		},
+	}
 }
 
-func expandDaysOfWeek(v interface{}) []alerts.DayOfWeek {
-	l := v.(*schema.Set).List()
-	result := make([]alerts.DayOfWeek, 0, len(l))
-	for _, v := range l {
-		dayOfWeekStr := alertSchemaDayOfWeekToProtoDayOfWeek[v.(string)]
-		dayOfWeekVal := alerts.DayOfWeek_value[dayOfWeekStr]
-		result = append(result, alerts.DayOfWeek(dayOfWeekVal))
+func expandFlowStages(stages []FlowStageModel) []*alerts.FlowStage {
+	result := make([]*alerts.FlowStage, 0, len(stages))
+	for _, stage := range stages {
+		result = append(result, &alerts.FlowStage{
+			Groups:    expandFlowGroups(stage.Groups),
+			Timeframe: expandFlowTimeFrame(stage.TimeWindow),
+		})
 	}
 	return result
 }
 
-func expandRange(activityStarts, activityEnds interface{}) *alerts.TimeRange {
-	start := expandTimeInDay(activityStarts)
-	end := expandTimeInDay(activityEnds)
-
-	return &alerts.TimeRange{
-		Start: start,
-		End:   end,
+func expandFlowGroups(groups []FlowGroupModel) []*alerts.FlowGroup {
+	result := make([]*alerts.FlowGroup, 0, len(groups))
+	for _, group := range groups {
+		result = append(result, &alerts.FlowGroup{
+			Alerts: expandSubAlerts(group.SubAlerts),
+			NextOp: expandFlowOperator(group.NextOperator),
+		})
 	}
+	return result
 }
 
-func expandAlertType(d *schema.ResourceData) (alertTypeParams *alertParams, tracingAlert *alerts.TracingAlert, diags diag.Diagnostics) {
-	alertTypeStr := From(validAlertTypes).FirstWith(func(key interface{}) bool {
-		return len(d.Get(key.(string)).([]interface{})) > 0
-	}).(string)
+func expandSubAlerts(subAlerts *FlowSubAlertsModel) *alerts.FlowAlerts {
+	if subAlerts == nil {
+		return nil
+	}
 
-	alertType := d.Get(alertTypeStr).([]interface{})[0].(map[string]interface{})
+	values := make([]*alerts.FlowAlert, 0, len(subAlerts.FlowAlerts))
+	for _, flowAlert := range subAlerts.FlowAlerts {
+		values = append(values, &alerts.FlowAlert{
+			Id:  typeStringToWrapperspbString(flowAlert.UserAlertID),
+			Not: wrapperspb.Bool(flowAlert.Not.ValueBool()),
+		})
+	}
 
-	switch alertTypeStr {
-	case "standard":
-		alertTypeParams, diags = expandStandard(alertType)
-	case "ratio":
-		alertTypeParams, diags = expandRatio(alertType)
-	case "new_value":
-		alertTypeParams = expandNewValue(alertType)
-	case "unique_count":
-		alertTypeParams = expandUniqueCount(alertType)
-	case "time_relative":
-		alertTypeParams, diags = expandTimeRelative(alertType)
-	case "metric":
-		alertTypeParams, diags = expandMetric(alertType)
-	case "tracing":
-		alertTypeParams, tracingAlert = expandTracing(alertType)
-	case "flow":
-		alertTypeParams = expandFlow(alertType)
+	return &alerts.FlowAlerts{
+		Op:     expandFlowOperator(subAlerts.Operator),
+		Values: values,
 	}
+}
 
-	return
+func expandFlowOperator(operator types.String) alerts.FlowOperator {
+	return alerts.FlowOperator(alerts.FlowOperator_value[operator.ValueString()])
 }
 
-func expandStandard(m map[string]interface{}) (*alertParams, diag.Diagnostics) {
-	conditionMap := extractConditionMap(m)
-	condition, err := expandStandardCondition(conditionMap)
-	if err != nil {
-		return nil, diag.FromErr(err)
+func expandFlowTimeFrame(timeWindow *FlowTimeWindowModel) *alerts.FlowTimeframe {
+	var timeMS int64
+	if timeWindow != nil {
+		timeMS = int64(msInHour)*timeWindow.Hours.ValueInt64() +
+			int64(msInMinute)*timeWindow.Minutes.ValueInt64() +
+			int64(msInSecond)*timeWindow.Seconds.ValueInt64()
+	}
+	return &alerts.FlowTimeframe{
+		Ms: wrapperspb.UInt32(uint32(timeMS)),
+	}
+}
+
+func expandTracing(tracing *TracingAlertModel) (*alertParams, *alerts.TracingAlert, diag.Diagnostics) {
+	condition, diags := expandTracingCondition(tracing.Condition)
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	conditionLatency := uint32(tracing.LatencyThresholdMilliseconds.ValueFloat64() * float64(time.Millisecond.Microseconds()))
+	fieldFilters := make([]*alerts.FilterData, 0)
+	if applications := tracing.Applications.Elements(); len(applications) != 0 {
+		fieldFilters = append(fieldFilters, expandSpecificFilter("applicationName", applications))
+	}
+	if subsystems := tracing.Subsystems.Elements(); len(subsystems) != 0 {
+		fieldFilters = append(fieldFilters, expandSpecificFilter("subsystemName", subsystems))
+	}
+	if services := tracing.Services.Elements(); len(services) != 0 {
+		fieldFilters = append(fieldFilters, expandSpecificFilter("serviceName", services))
+	}
+	tagFilters := make([]*alerts.FilterData, 0, len(tracing.TagFilters))
+	for _, tagFilter := range tracing.TagFilters {
+		tagFilters = append(tagFilters, expandSpecificFilter(tagFilter.Field.ValueString(), tagFilter.Values.Elements()))
 	}
-	filters := expandStandardFilter(m)
+
 	return &alertParams{
 		Condition: condition,
-		Filters:   filters,
+		Filters: &alerts.AlertFilters{
+			FilterType: alerts.AlertFilters_FILTER_TYPE_TRACING,
+		},
+	}, &alerts.TracingAlert{
+		ConditionLatency: conditionLatency,
+		FieldFilters:     fieldFilters,
+		TagFilters:       tagFilters,
 	}, nil
 }
 
-func expandStandardCondition(m map[string]interface{}) (*alerts.AlertCondition, error) {
-	if immediately := m["immediately"]; immediately != nil && immediately.(bool) {
+func expandTracingCondition(condition *TracingConditionModel) (*alerts.AlertCondition, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	switch {
+	case condition.Immediately.ValueBool():
 		return &alerts.AlertCondition{
 			Condition: &alerts.AlertCondition_Immediate{},
 		}, nil
-	} else if moreThenUsual := m["more_than_usual"]; moreThenUsual != nil && moreThenUsual.(bool) {
-		threshold := wrapperspb.Double(float64(m["threshold"].(int)))
-		groupBy := []*wrapperspb.StringValue{wrapperspb.String(m["group_by_key"].(string))}
+	case condition.MoreThan.ValueBool():
 		parameters := &alerts.ConditionParameters{
-			Threshold: threshold,
-			GroupBy:   groupBy,
+			Threshold: wrapperspb.Double(float64(condition.Threshold.ValueInt64())),
+			Timeframe: expandTimeFrame(condition.TimeWindow.ValueString()),
+			GroupBy:   typeStringSliceToWrappedStringSlice(condition.GroupBy.Elements()),
 		}
 		return &alerts.AlertCondition{
-			Condition: &alerts.AlertCondition_MoreThanUsual{
-				MoreThanUsual: &alerts.MoreThanUsualCondition{Parameters: parameters},
+			Condition: &alerts.AlertCondition_MoreThan{
+				MoreThan: &alerts.MoreThanCondition{Parameters: parameters},
 			},
 		}, nil
-	} else {
-		parameters, err := expandStandardConditionParameters(m)
-		if err != nil {
-			return nil, err
-		}
-		if lessThan := m["less_than"]; lessThan != nil && lessThan.(bool) {
-			return &alerts.AlertCondition{
-				Condition: &alerts.AlertCondition_LessThan{
-					LessThan: &alerts.LessThanCondition{Parameters: parameters},
-				},
-			}, nil
-		} else if moreThan := m["more_than"]; moreThan != nil && moreThan.(bool) {
-			evaluationWindow := expandEvaluationWindow(m)
-			return &alerts.AlertCondition{
-				Condition: &alerts.AlertCondition_MoreThan{
-					MoreThan: &alerts.MoreThanCondition{
-						Parameters:       parameters,
-						EvaluationWindow: evaluationWindow,
-					},
-				},
-			}, nil
-		}
 	}
 
-	return nil, fmt.Errorf("immediately, less_than, more_than or more_than_usual have to be true")
+	diags.AddError("Invalid tracing alert condition", "immediately or more_than have to be true")
+	return nil, diags
 }
 
-func expandEvaluationWindow(m map[string]interface{}) *alerts.EvaluationWindow {
-	var evaluationWindow *alerts.EvaluationWindow
-	if evaluationWindowStr, ok := m["evaluation_window"].(string); ok && evaluationWindowStr != "" {
-		evaluationWindow = new(alerts.EvaluationWindow)
-		*evaluationWindow = alertSchemaToProtoEvaluationWindow[evaluationWindowStr]
+func expandSpecificFilter(filterName string, values []attr.Value) *alerts.FilterData {
+	operatorToFilterValues := make(map[string]*alerts.Filters)
+	var operators []string
+	for _, val := range values {
+		operator, filterValue := expandFilter(val.(types.String).ValueString())
+		if _, ok := operatorToFilterValues[operator]; !ok {
+			operatorToFilterValues[operator] = &alerts.Filters{Operator: operator, Values: make([]string, 0)}
+			operators = append(operators, operator)
+		}
+		operatorToFilterValues[operator].Values = append(operatorToFilterValues[operator].Values, filterValue)
 	}
-	return evaluationWindow
-}
 
-func expandRelatedExtendedData(m map[string]interface{}) (*alerts.RelatedExtendedData, error) {
-	if v, ok := m["less_than"]; !(ok && v.(bool)) {
-		return nil, nil
+	filterResult := make([]*alerts.Filters, 0, len(operatorToFilterValues))
+	for _, operator := range operators {
+		filterResult = append(filterResult, operatorToFilterValues[operator])
 	}
 
-	if v, ok := m["manage_undetected_values"]; ok {
-		if manageUndetectedValues, ok := v.([]interface{}); ok && len(manageUndetectedValues) != 0 {
-			raw := manageUndetectedValues[0].(map[string]interface{})
-			if enable, autoRetireRatio := raw["enable_triggering_on_undetected_values"], raw["auto_retire_ratio"]; enable.(bool) {
-				if autoRetireRatio == nil || autoRetireRatio.(string) == "" {
-					return nil, fmt.Errorf("auto_retire_ratio is required when enable_triggering_on_undetected_values = true")
-				}
-				cleanupDeadmanDurationStr := alertSchemaDeadmanRatiosToProtoDeadmanRatios[autoRetireRatio.(string)]
-				cleanupDeadmanDuration := alerts.CleanupDeadmanDuration(alerts.CleanupDeadmanDuration_value[cleanupDeadmanDurationStr])
-				return &alerts.RelatedExtendedData{
-					CleanupDeadmanDuration: &cleanupDeadmanDuration,
-					ShouldTriggerDeadman:   wrapperspb.Bool(true),
-				}, nil
-			} else {
-				if autoRetireRatio != nil && autoRetireRatio.(string) != "" {
-					return nil, fmt.Errorf("auto_retire_ratio is not allowed when enable_triggering_on_undetected_values = false")
-				}
-				return &alerts.RelatedExtendedData{
-					ShouldTriggerDeadman: wrapperspb.Bool(false),
-				}, nil
-			}
-		}
+	return &alerts.FilterData{
+		Field:   filterName,
+		Filters: filterResult,
 	}
-
-	return nil, nil
 }
 
-func expandStandardConditionParameters(m map[string]interface{}) (*alerts.ConditionParameters, error) {
-	timeFrame := expandTimeFrame(m["time_window"].(string))
-	groupBy := interfaceSliceToWrappedStringSlice(m["group_by"].([]interface{}))
-	threshold := wrapperspb.Double(float64(m["threshold"].(int)))
-	relatedExtendedData, err := expandRelatedExtendedData(m)
-	if err != nil {
-		return nil, err
+func expandFilter(filterString string) (operator, filterValue string) {
+	operator, filterValue = "equals", filterString
+	if strings.HasPrefix(filterValue, "filter:") {
+		arr := strings.SplitN(filterValue, ":", 3)
+		if len(arr) == 3 {
+			operator, filterValue = arr[1], arr[2]
+		}
 	}
 
-	return &alerts.ConditionParameters{
-		Threshold:           threshold,
-		Timeframe:           timeFrame,
-		GroupBy:             groupBy,
-		RelatedExtendedData: relatedExtendedData,
-	}, nil
+	return
 }
 
-func expandTracingConditionParameters(m map[string]interface{}) *alerts.ConditionParameters {
-	timeFrame := expandTimeFrame(m["time_window"].(string))
-	groupBy := interfaceSliceToWrappedStringSlice(m["group_by"].([]interface{}))
-	threshold := wrapperspb.Double(float64(m["threshold"].(int)))
-
-	return &alerts.ConditionParameters{
-		Threshold: threshold,
-		Timeframe: timeFrame,
-		GroupBy:   groupBy,
-	}
+func expandTimeFrame(s string) alerts.Timeframe {
+	protoTimeFrame := alertSchemaTimeFrameToProtoTimeFrame[s]
+	return alerts.Timeframe(alerts.Timeframe_value[protoTimeFrame])
 }
 
-func expandStandardFilter(m map[string]interface{}) *alerts.AlertFilters {
-	filters := expandCommonAlertFilter(m)
-	filters.FilterType = alerts.AlertFilters_FILTER_TYPE_TEXT_OR_UNSPECIFIED
-	return filters
+func expandMetricTimeFrame(s string) alerts.Timeframe {
+	protoTimeFrame := alertSchemaMetricTimeFrameToMetricProtoTimeFrame[s]
+	return alerts.Timeframe(alerts.Timeframe_value[protoTimeFrame])
 }
 
-func expandRatio(m map[string]interface{}) (*alertParams, diag.Diagnostics) {
-	conditionMap := extractConditionMap(m)
-	groupBy := interfaceSliceToWrappedStringSlice(conditionMap["group_by"].([]interface{}))
-	var groupByQ1, groupByQ2 []*wrapperspb.StringValue
-	if len(groupBy) > 0 {
-		if conditionMap["group_by_q1"].(bool) {
-			groupByQ1 = groupBy
-		} else if conditionMap["group_by_q2"].(bool) {
-			groupByQ2 = groupBy
-		} else if conditionMap["group_by_both"].(bool) {
-			groupByQ1 = groupBy
-			groupByQ2 = groupBy
-		} else {
-			return nil, diag.Errorf("group_by is required with one of - group_by_q1/group_by_q1/group_by_both")
-		}
-	}
-
-	condition, err := expandRatioCondition(conditionMap, groupByQ1)
-	if err != nil {
-		return nil, diag.FromErr(err)
+func expandAlertFiltersSeverities(severities types.Set) []alerts.AlertFilters_LogSeverity {
+	elements := severities.Elements()
+	result := make([]alerts.AlertFilters_LogSeverity, 0, len(elements))
+	for _, v := range elements {
+		logSeverityStr := alertSchemaLogSeverityToProtoLogSeverity[v.(types.String).ValueString()]
+		result = append(result, alerts.AlertFilters_LogSeverity(
+			alerts.AlertFilters_LogSeverity_value[logSeverityStr]))
 	}
-	filters := expandRatioFilters(m, groupByQ2)
 
-	return &alertParams{
-		Condition: condition,
-		Filters:   filters,
-	}, nil
+	return result
 }
 
-func expandRatioFilters(m map[string]interface{}, groupBy []*wrapperspb.StringValue) *alerts.AlertFilters {
-	query1 := m["query_1"].([]interface{})[0].(map[string]interface{})
-	filters := expandCommonAlertFilter(query1)
-	filters.FilterType = alerts.AlertFilters_FILTER_TYPE_RATIO
-	filters.Alias = wrapperspb.String(query1["alias"].(string))
-	query2 := expandQuery2(m["query_2"], groupBy)
-	filters.RatioAlerts = []*alerts.AlertFilters_RatioAlert{query2}
-	return filters
+func expandNewValueTimeFrame(s string) alerts.Timeframe {
+	protoTimeFrame := alertSchemaNewValueTimeFrameToProtoTimeFrame[s]
+	return alerts.Timeframe(alerts.Timeframe_value[protoTimeFrame])
 }
 
-func expandRatioCondition(m map[string]interface{}, groupBy []*wrapperspb.StringValue) (*alerts.AlertCondition, error) {
-	parameters, err := expandRatioParams(m, groupBy)
-	if err != nil {
-		return nil, err
-	}
-
-	return expandLessThanOrMoreThanAlertCondition(m, parameters)
+func expandUniqueValueTimeFrame(s string) alerts.Timeframe {
+	protoTimeFrame := alertSchemaUniqueCountTimeFrameToProtoTimeFrame[s]
+	return alerts.Timeframe(alerts.Timeframe_value[protoTimeFrame])
 }
 
-func expandRatioParams(m map[string]interface{}, groupBy []*wrapperspb.StringValue) (*alerts.ConditionParameters, error) {
-	threshold := wrapperspb.Double(m["ratio_threshold"].(float64))
-	timeFrame := expandTimeFrame(m["time_window"].(string))
-	ignoreInfinity := wrapperspb.Bool(m["ignore_infinity"].(bool))
-	relatedExtendedData, err := expandRelatedExtendedData(m)
-	if err != nil {
-		return nil, err
+func flattenAlert(ctx context.Context, alert *alerts.Alert, timeZone types.String) (AlertResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	showInInsights, dgs := flattenShowInInsight(ctx, alert.GetShowInInsight())
+	diags.Append(dgs...)
+	scheduling, dgs := flattenScheduling(alert.GetActiveWhen(), timeZone)
+	diags.Append(dgs...)
+
+	alertResourceModel := AlertResourceModel{
+		ID:                  types.StringValue(alert.GetUniqueIdentifier().GetValue()),
+		Enabled:             types.BoolValue(alert.GetIsActive().GetValue()),
+		Name:                types.StringValue(alert.GetName().GetValue()),
+		Description:         flattenAlertDescription(alert.GetDescription()),
+		Severity:            types.StringValue(alertProtoSeverityToSchemaSeverity[alert.GetSeverity().String()]),
+		MetaLabels:          flattenMetaLabels(alert.GetMetaLabels()),
+		ExpirationDate:      flattenExpirationDate(alert.GetExpiration()),
+		NotificationsGroups: flattenNotificationGroups(alert.GetNotificationGroups()),
+		PayloadFilters:      wrappedStringSliceToTypeStringSlice(alert.GetNotificationPayloadFilters()),
+		ShowInInsights:      showInInsights,
+		Scheduling:          scheduling,
 	}
+	diags.Append(flattenAlertType(ctx, alert, &alertResourceModel)...)
 
-	return &alerts.ConditionParameters{
-		Threshold:           threshold,
-		Timeframe:           timeFrame,
-		GroupBy:             groupBy,
-		IgnoreInfinity:      ignoreInfinity,
-		RelatedExtendedData: relatedExtendedData,
-	}, nil
+	return alertResourceModel, diags
 }
 
-func expandQuery2(v interface{}, groupBy []*wrapperspb.StringValue) *alerts.AlertFilters_RatioAlert {
-	m := v.([]interface{})[0].(map[string]interface{})
-	alias := wrapperspb.String(m["alias"].(string))
-	text := wrapperspb.String(m["search_query"].(string))
-	severities := expandAlertFiltersSeverities(m["severities"].(*schema.Set).List())
-	applications := interfaceSliceToWrappedStringSlice(m["applications"].(*schema.Set).List())
-	subsystems := interfaceSliceToWrappedStringSlice(m["subsystems"].(*schema.Set).List())
-	return &alerts.AlertFilters_RatioAlert{
-		Alias:        alias,
-		Text:         text,
-		Severities:   severities,
-		Applications: applications,
-		Subsystems:   subsystems,
-		GroupBy:      groupBy,
+func flattenAlertDescription(description *wrapperspb.StringValue) types.String {
+	if description.GetValue() == "" {
+		return types.StringNull()
 	}
+	return types.StringValue(description.GetValue())
 }
 
-func expandNewValue(m map[string]interface{}) *alertParams {
-	conditionMap := extractConditionMap(m)
-	condition := expandNewValueCondition(conditionMap)
-	filters := expandNewValueFilters(m)
-
-	return &alertParams{
-		Condition: condition,
-		Filters:   filters,
+func flattenMetaLabels(labels []*alerts.MetaLabel) types.Map {
+	if len(labels) == 0 {
+		return types.MapNull(types.StringType)
 	}
-}
-
-func expandNewValueCondition(m map[string]interface{}) *alerts.AlertCondition {
-	parameters := expandNewValueConditionParameters(m)
-	condition := &alerts.AlertCondition{
-		Condition: &alerts.AlertCondition_NewValue{
-			NewValue: &alerts.NewValueCondition{
-				Parameters: parameters,
-			},
-		},
+	elements := make(map[string]attr.Value, len(labels))
+	for _, l := range labels {
+		elements[l.GetKey().GetValue()] = types.StringValue(l.GetValue().GetValue())
 	}
-	return condition
+	return types.MapValueMust(types.StringType, elements)
 }
 
-func expandNewValueConditionParameters(m map[string]interface{}) *alerts.ConditionParameters {
-	timeFrame := expandNewValueTimeFrame(m["time_window"].(string))
-	groupBy := []*wrapperspb.StringValue{wrapperspb.String(m["key_to_track"].(string))}
-	parameters := &alerts.ConditionParameters{
-		Timeframe: timeFrame,
-		GroupBy:   groupBy,
+func flattenExpirationDate(expiration *alerts.Date) *AlertExpirationDateModel {
+	if expiration == nil {
+		return nil
 	}
-	return parameters
-}
-
-func expandNewValueFilters(m map[string]interface{}) *alerts.AlertFilters {
-	filters := expandCommonAlertFilter(m)
-	filters.FilterType = alerts.AlertFilters_FILTER_TYPE_TEXT_OR_UNSPECIFIED
-	return filters
-}
-
-func expandUniqueCount(m map[string]interface{}) *alertParams {
-	conditionMap := extractConditionMap(m)
-	condition := expandUniqueCountCondition(conditionMap)
-	filters := expandUniqueCountFilters(m)
-
-	return &alertParams{
-		Condition: condition,
-		Filters:   filters,
+	return &AlertExpirationDateModel{
+		Day:   types.Int64Value(int64(expiration.GetDay())),
+		Month: types.Int64Value(int64(expiration.GetMonth())),
+		Year:  types.Int64Value(int64(expiration.GetYear())),
 	}
 }
 
-func expandUniqueCountCondition(m map[string]interface{}) *alerts.AlertCondition {
-	parameters := expandUniqueCountConditionParameters(m)
-	return &alerts.AlertCondition{
-		Condition: &alerts.AlertCondition_UniqueCount{
-			UniqueCount: &alerts.UniqueCountCondition{
-				Parameters: parameters,
-			},
-		},
+func flattenShowInInsight(ctx context.Context, showInInsight *alerts.ShowInInsight) (types.Object, diag.Diagnostics) {
+	if showInInsight == nil {
+		return types.ObjectNull(showInInsightsModelAttr()), nil
 	}
+	return types.ObjectValueFrom(ctx, showInInsightsModelAttr(), AlertShowInInsightsModel{
+		RetriggeringPeriodMinutes: types.Int64Value(int64(showInInsight.GetRetriggeringPeriodSeconds().GetValue() / 60)),
+		NotifyOn:                  types.StringValue(alertProtoNotifyOnToSchemaNotifyOn[showInInsight.GetNotifyOn()]),
+	})
 }
 
-func expandUniqueCountConditionParameters(m map[string]interface{}) *alerts.ConditionParameters {
-	uniqueCountKey := []*wrapperspb.StringValue{wrapperspb.String(m["unique_count_key"].(string))}
-	threshold := wrapperspb.Double(float64(m["max_unique_values"].(int)))
-	timeFrame := expandUniqueValueTimeFrame(m["time_window"].(string))
-	groupBy := []*wrapperspb.StringValue{wrapperspb.String(m["group_by_key"].(string))}
-	groupByThreshold := wrapperspb.UInt32(uint32(m["max_unique_values_for_group_by"].(int)))
-
-	return &alerts.ConditionParameters{
-		CardinalityFields:                 uniqueCountKey,
-		Threshold:                         threshold,
-		Timeframe:                         timeFrame,
-		GroupBy:                           groupBy,
-		MaxUniqueCountValuesForGroupByKey: groupByThreshold,
+func flattenNotificationGroups(notificationGroups []*alerts.AlertNotificationGroups) []AlertNotificationGroupModel {
+	if len(notificationGroups) == 0 {
+		return nil
 	}
-}
-
-func expandUniqueCountFilters(m map[string]interface{}) *alerts.AlertFilters {
-	filters := expandCommonAlertFilter(m)
-	filters.FilterType = alerts.AlertFilters_FILTER_TYPE_UNIQUE_COUNT
-	return filters
-}
-
-func expandCommonAlertFilter(m map[string]interface{}) *alerts.AlertFilters {
-	severities := expandAlertFiltersSeverities(m["severities"].(*schema.Set).List())
-	metadata := expandMetadata(m)
-	text := wrapperspb.String(m["search_query"].(string))
-
-	return &alerts.AlertFilters{
-		Severities: severities,
-		Metadata:   metadata,
-		Text:       text,
+	result := make([]AlertNotificationGroupModel, 0, len(notificationGroups))
+	for _, group := range notificationGroups {
+		result = append(result, AlertNotificationGroupModel{
+			GroupByFields: wrappedStringSliceToTypeStringList(group.GetGroupByFields()),
+			Notifications: flattenNotifications(group.GetNotifications()),
+		})
 	}
+	return result
 }
 
-func expandTimeRelative(m map[string]interface{}) (*alertParams, diag.Diagnostics) {
-	conditionMap := extractConditionMap(m)
-	condition, err := expandTimeRelativeCondition(conditionMap)
-	if err != nil {
-		return nil, diag.FromErr(err)
+func flattenNotifications(notifications []*alerts.AlertNotification) []AlertNotificationModel {
+	if len(notifications) == 0 {
+		return nil
 	}
-	filters := expandTimeRelativeFilters(m)
-
-	return &alertParams{
-		Condition: condition,
-		Filters:   filters,
-	}, nil
+	result := make([]AlertNotificationModel, 0, len(notifications))
+	for _, notification := range notifications {
+		notificationModel := AlertNotificationModel{
+			RetriggeringPeriodMinutes: types.Int64Value(int64(notification.GetRetriggeringPeriodSeconds().GetValue() / 60)),
+			NotifyOn:                  types.StringValue(alertProtoNotifyOnToSchemaNotifyOn[notification.GetNotifyOn()]),
+			IntegrationID:             types.StringNull(),
+			EmailRecipients:           types.SetNull(types.StringType),
+		}
+		switch integration := notification.GetIntegrationType().(type) {
+		case *alerts.AlertNotification_IntegrationId:
+			notificationModel.IntegrationID = types.StringValue(strconv.Itoa(int(integration.IntegrationId.GetValue())))
+		case *alerts.AlertNotification_Recipients:
+			notificationModel.EmailRecipients = wrappedStringSliceToTypeStringSlice(integration.Recipients.GetEmails())
+		}
+		result = append(result, notificationModel)
+	}
+	return result
 }
 
-func expandTimeRelativeCondition(m map[string]interface{}) (*alerts.AlertCondition, error) {
-	parameters, err := expandTimeRelativeConditionParameters(m)
-	if err != nil {
-		return nil, err
+// flattenScheduling converts the time frames, which the backend keeps in GMT, back to the configured time zone.
+func flattenScheduling(activeWhen *alerts.AlertActiveWhen, timeZone types.String) (*AlertSchedulingModel, diag.Diagnostics) {
+	if len(activeWhen.GetTimeframes()) == 0 {
+		return nil, nil
 	}
 
-	return expandLessThanOrMoreThanAlertCondition(m, parameters)
-}
-
-func expandLessThanOrMoreThanAlertCondition(
-	m map[string]interface{}, parameters *alerts.ConditionParameters) (*alerts.AlertCondition, error) {
-	lessThan, err := trueIfIsLessThanFalseIfMoreThanAndErrorOtherwise(m)
+	var diags diag.Diagnostics
+	if timeZone.IsNull() || timeZone.IsUnknown() {
+		timeZone = types.StringValue("UTC+0")
+	}
+	offset, err := alertTimeZoneOffsetMinutes(timeZone.ValueString(), time.Now())
 	if err != nil {
-		return nil, err
+		diags.AddAttributeError(path.Root("scheduling").AtName("time_zone"), "Invalid time zone", err.Error())
+		return nil, diags
 	}
 
-	if lessThan {
-		return &alerts.AlertCondition{
-			Condition: &alerts.AlertCondition_LessThan{
-				LessThan: &alerts.LessThanCondition{Parameters: parameters},
-			},
-		}, nil
+	timeFrames := make([]AlertTimeFrameModel, 0, len(activeWhen.GetTimeframes()))
+	for _, tf := range activeWhen.GetTimeframes() {
+		timeFrames = append(timeFrames, flattenTimeFrame(tf, offset))
 	}
 
-	return &alerts.AlertCondition{
-		Condition: &alerts.AlertCondition_MoreThan{
-			MoreThan: &alerts.MoreThanCondition{Parameters: parameters},
-		},
+	return &AlertSchedulingModel{
+		TimeZone:   timeZone,
+		TimeFrames: timeFrames,
 	}, nil
 }
 
-func trueIfIsLessThanFalseIfMoreThanAndErrorOtherwise(m map[string]interface{}) (bool, error) {
-	if lessThan := m["less_than"]; lessThan != nil && lessThan.(bool) {
-		return true, nil
-	} else if moreThan := m["more_than"]; moreThan != nil && moreThan.(bool) {
-		return false, nil
+func flattenTimeFrame(tf *alerts.AlertActiveTimeframe, offset int32) AlertTimeFrameModel {
+	tr := tf.GetRange()
+	activityStart, daysOffset := shiftTimeInDay(tr.GetStart(), offset)
+	activityEnd, _ := shiftTimeInDay(tr.GetEnd(), offset)
+
+	return AlertTimeFrameModel{
+		DaysEnabled: flattenDaysOfWeek(shiftDaysOfWeek(tf.GetDaysOfWeek(), daysOffset)),
+		StartTime:   types.StringValue(flattenTimeInDay(activityStart)),
+		EndTime:     types.StringValue(flattenTimeInDay(activityEnd)),
 	}
-	return false, fmt.Errorf("less_than or more_than have to be true")
 }
 
-func expandLessThanMoreThanOrMoreThanUsualAlertCondition(
-	m map[string]interface{}, parameters *alerts.ConditionParameters) (*alerts.AlertCondition, error) {
-	conditionsStr, err := returnAlertConditionString(m)
-	if err != nil {
-		return nil, err
-	}
+func flattenTimeInDay(t *alerts.Time) string {
+	hoursStr := toTwoDigitsFormat(t.GetHours())
+	minStr := toTwoDigitsFormat(t.GetMinutes())
+	return fmt.Sprintf("%s:%s", hoursStr, minStr)
+}
 
-	switch conditionsStr {
-	case "less_than":
-		return &alerts.AlertCondition{
-			Condition: &alerts.AlertCondition_LessThan{
-				LessThan: &alerts.LessThanCondition{Parameters: parameters},
-			},
-		}, nil
-	case "more_than":
-		return &alerts.AlertCondition{
-			Condition: &alerts.AlertCondition_MoreThan{
-				MoreThan: &alerts.MoreThanCondition{Parameters: parameters},
-			},
-		}, nil
-	case "more_than_usual":
-		return &alerts.AlertCondition{
-			Condition: &alerts.AlertCondition_MoreThanUsual{
-				MoreThanUsual: &alerts.MoreThanUsualCondition{Parameters: parameters},
-			},
-		}, nil
+func flattenDaysOfWeek(daysOfWeek []alerts.DayOfWeek) types.Set {
+	days := make([]string, 0, len(daysOfWeek))
+	for _, d := range daysOfWeek {
+		days = append(days, alertProtoDayOfWeekToSchemaDayOfWeek[d.String()])
 	}
-
-	return nil, fmt.Errorf("less_than or more_than or more_than_usual must be true")
+	return stringSliceToTypeStringSet(days)
 }
 
-func returnAlertConditionString(m map[string]interface{}) (string, error) {
-	if lessThan := m["less_than"]; lessThan != nil && lessThan.(bool) {
-		return "less_than", nil
-	} else if moreThan := m["more_than"]; moreThan != nil && moreThan.(bool) {
-		return "more_than", nil
-	} else if moreThanUsual := m["more_than_usual"]; moreThanUsual != nil && moreThanUsual.(bool) {
-		return "more_than_usual", nil
+func flattenAlertType(ctx context.Context, alert *alerts.Alert, alertResourceModel *AlertResourceModel) diag.Diagnostics {
+	filters := alert.GetFilters()
+	condition := alert.GetCondition().GetCondition()
+
+	var diags diag.Diagnostics
+	switch filters.GetFilterType() {
+	case alerts.AlertFilters_FILTER_TYPE_TEXT_OR_UNSPECIFIED:
+		if _, ok := condition.(*alerts.AlertCondition_NewValue); ok {
+			alertResourceModel.NewValue = flattenNewValueAlert(filters, condition)
+		} else {
+			alertResourceModel.Standard, diags = flattenStandardAlert(ctx, filters, condition)
+		}
+	case alerts.AlertFilters_FILTER_TYPE_RATIO:
+		alertResourceModel.Ratio, diags = flattenRatioAlert(ctx, filters, condition)
+	case alerts.AlertFilters_FILTER_TYPE_UNIQUE_COUNT:
+		alertResourceModel.UniqueCount = flattenUniqueCountAlert(filters, condition)
+	case alerts.AlertFilters_FILTER_TYPE_TIME_RELATIVE:
+		alertResourceModel.TimeRelative, diags = flattenTimeRelativeAlert(ctx, filters, condition)
+	case alerts.AlertFilters_FILTER_TYPE_METRIC:
+		alertResourceModel.Metric, diags = flattenMetricAlert(ctx, filters, condition)
+	case alerts.AlertFilters_FILTER_TYPE_TRACING:
+		alertResourceModel.Tracing = flattenTracingAlert(condition, alert.GetTracingAlert())
+	case alerts.AlertFilters_FILTER_TYPE_FLOW:
+		alertResourceModel.Flow = flattenFlowAlert(condition)
 	}
-	return "", fmt.Errorf("less_than or more_than or more_than_usual must be true")
+
+	return diags
 }
 
-func expandTimeRelativeConditionParameters(m map[string]interface{}) (*alerts.ConditionParameters, error) {
-	timeFrame, relativeTimeframe := expandTimeFrameAndRelativeTimeframe(m["relative_time_window"].(string))
-	ignoreInfinity := wrapperspb.Bool(m["ignore_infinity"].(bool))
-	groupBy := interfaceSliceToWrappedStringSlice(m["group_by"].([]interface{}))
-	threshold := wrapperspb.Double(m["ratio_threshold"].(float64))
-	relatedExtendedData, err := expandRelatedExtendedData(m)
-	if err != nil {
-		return nil, err
+func flattenCommonAlert(filters *alerts.AlertFilters) alertLogsFilters {
+	metadata := filters.GetMetadata()
+	searchQuery := types.StringNull()
+	if text := filters.GetText().GetValue(); text != "" {
+		searchQuery = types.StringValue(text)
+	}
+	return alertLogsFilters{
+		SearchQuery:  searchQuery,
+		Severities:   flattenAlertFiltersSeverities(filters.GetSeverities()),
+		Applications: wrappedStringSliceToTypeStringSlice(metadata.GetApplications()),
+		Subsystems:   wrappedStringSliceToTypeStringSlice(metadata.GetSubsystems()),
+		Categories:   wrappedStringSliceToTypeStringSlice(metadata.GetCategories()),
+		Computers:    wrappedStringSliceToTypeStringSlice(metadata.GetComputers()),
+		Classes:      wrappedStringSliceToTypeStringSlice(metadata.GetClasses()),
+		Methods:      wrappedStringSliceToTypeStringSlice(metadata.GetMethods()),
+		IPAddresses:  wrappedStringSliceToTypeStringSlice(metadata.GetIpAddresses()),
 	}
-
-	return &alerts.ConditionParameters{
-		Timeframe:           timeFrame,
-		RelativeTimeframe:   relativeTimeframe,
-		GroupBy:             groupBy,
-		Threshold:           threshold,
-		IgnoreInfinity:      ignoreInfinity,
-		RelatedExtendedData: relatedExtendedData,
-	}, nil
 }
 
-func expandTimeFrameAndRelativeTimeframe(relativeTimeframeStr string) (alerts.Timeframe, alerts.RelativeTimeframe) {
-	p := alertSchemaRelativeTimeFrameToProtoTimeFrameAndRelativeTimeFrame[relativeTimeframeStr]
-	return p.timeFrame, p.relativeTimeFrame
+func flattenAlertFiltersSeverities(severities []alerts.AlertFilters_LogSeverity) types.Set {
+	result := make([]string, 0, len(severities))
+	for _, s := range severities {
+		result = append(result, alertProtoLogSeverityToSchemaLogSeverity[s.String()])
+	}
+	return stringSliceToTypeStringSet(result)
 }
 
-func expandTimeRelativeFilters(m map[string]interface{}) *alerts.AlertFilters {
-	filters := expandCommonAlertFilter(m)
-	filters.FilterType = alerts.AlertFilters_FILTER_TYPE_TIME_RELATIVE
-	return filters
+func flattenNewValueAlert(filters *alerts.AlertFilters, condition interface{}) *NewValueAlertModel {
+	commonFilters := flattenCommonAlert(filters)
+	conditionParams := condition.(*alerts.AlertCondition_NewValue).NewValue.GetParameters()
+	var keyToTrack string
+	if groupBy := conditionParams.GetGroupBy(); len(groupBy) > 0 {
+		keyToTrack = groupBy[0].GetValue()
+	}
+
+	return &NewValueAlertModel{
+		SearchQuery:  commonFilters.SearchQuery,
+		Severities:   commonFilters.Severities,
+		Applications: commonFilters.Applications,
+		Subsystems:   commonFilters.Subsystems,
+		Categories:   commonFilters.Categories,
+		Computers:    commonFilters.Computers,
+		Classes:      commonFilters.Classes,
+		Methods:      commonFilters.Methods,
+		IPAddresses:  commonFilters.IPAddresses,
+		Condition: &NewValueConditionModel{
+			KeyToTrack: types.StringValue(keyToTrack),
+			TimeWindow: types.StringValue(alertProtoNewValueTimeFrameToSchemaTimeFrame[conditionParams.GetTimeframe().String()]),
+		},
+	}
 }
 
-func expandMetric(m map[string]interface{}) (*alertParams, diag.Diagnostics) {
-	condition, err := expandMetricCondition(m)
-	if err != nil {
-		return nil, diag.FromErr(err)
+func flattenStandardAlert(ctx context.Context, filters *alerts.AlertFilters, condition interface{}) (*StandardAlertModel, diag.Diagnostics) {
+	conditionModel, diags := flattenStandardCondition(ctx, condition)
+	if diags.HasError() {
+		return nil, diags
 	}
-	filters := expandMetricFilters(m)
 
-	return &alertParams{
-		Condition: condition,
-		Filters:   filters,
+	commonFilters := flattenCommonAlert(filters)
+	return &StandardAlertModel{
+		SearchQuery:  commonFilters.SearchQuery,
+		Severities:   commonFilters.Severities,
+		Applications: commonFilters.Applications,
+		Subsystems:   commonFilters.Subsystems,
+		Categories:   commonFilters.Categories,
+		Computers:    commonFilters.Computers,
+		Classes:      commonFilters.Classes,
+		Methods:      commonFilters.Methods,
+		IPAddresses:  commonFilters.IPAddresses,
+		Condition:    conditionModel,
 	}, nil
 }
 
-func expandMetricCondition(m map[string]interface{}) (*alerts.AlertCondition, error) {
-	isPromQL := len(m["promql"].([]interface{})) > 0
-	var metricType string
-	if isPromQL {
-		metricType = "promql"
-	} else {
-		metricType = "lucene"
-	}
-
-	metricMap := (m[metricType].([]interface{}))[0].(map[string]interface{})
-	text := wrapperspb.String(metricMap["search_query"].(string))
-	conditionMap := extractConditionMap(metricMap)
-	threshold := wrapperspb.Double(conditionMap["threshold"].(float64))
-	sampleThresholdPercentage := wrapperspb.UInt32(uint32(conditionMap["sample_threshold_percentage"].(int)))
-	nonNullPercentage := wrapperspb.UInt32(uint32(conditionMap["min_non_null_values_percentage"].(int)))
-	swapNullValues := wrapperspb.Bool(conditionMap["replace_missing_value_with_zero"].(bool))
-	timeFrame := expandMetricTimeFrame(conditionMap["time_window"].(string))
-	relatedExtendedData, err := expandRelatedExtendedData(conditionMap)
-	if err != nil {
-		return nil, err
+func flattenStandardCondition(ctx context.Context, condition interface{}) (*StandardConditionModel, diag.Diagnostics) {
+	conditionModel := &StandardConditionModel{
+		Immediately:            types.BoolNull(),
+		LessThan:               types.BoolNull(),
+		MoreThan:               types.BoolNull(),
+		MoreThanUsual:          types.BoolNull(),
+		Threshold:              types.Int64Value(0),
+		TimeWindow:             types.StringNull(),
+		GroupBy:                types.ListNull(types.StringType),
+		GroupByKey:             types.StringNull(),
+		ManageUndetectedValues: types.ObjectNull(manageUndetectedValuesModelAttr()),
+		EvaluationWindow:       types.StringNull(),
 	}
 
-	parameters := &alerts.ConditionParameters{
-		Threshold:           threshold,
-		Timeframe:           timeFrame,
-		RelatedExtendedData: relatedExtendedData,
+	var diags diag.Diagnostics
+	switch condition := condition.(type) {
+	case *alerts.AlertCondition_Immediate:
+		conditionModel.Immediately = types.BoolValue(true)
+	case *alerts.AlertCondition_LessThan:
+		conditionParams := condition.LessThan.GetParameters()
+		conditionModel.LessThan = types.BoolValue(true)
+		conditionModel.Threshold = types.Int64Value(int64(conditionParams.GetThreshold().GetValue()))
+		conditionModel.TimeWindow = types.StringValue(alertProtoTimeFrameToSchemaTimeFrame[conditionParams.GetTimeframe().String()])
+		conditionModel.GroupBy = wrappedStringSliceToTypeStringList(conditionParams.GetGroupBy())
+		if len(conditionParams.GetGroupBy()) > 0 {
+			conditionModel.ManageUndetectedValues, diags = flattenManageUndetectedValues(ctx, conditionParams.GetRelatedExtendedData())
+		}
+	case *alerts.AlertCondition_MoreThan:
+		conditionParams := condition.MoreThan.GetParameters()
+		conditionModel.MoreThan = types.BoolValue(true)
+		conditionModel.Threshold = types.Int64Value(int64(conditionParams.GetThreshold().GetValue()))
+		conditionModel.TimeWindow = types.StringValue(alertProtoTimeFrameToSchemaTimeFrame[conditionParams.GetTimeframe().String()])
+		conditionModel.GroupBy = wrappedStringSliceToTypeStringList(conditionParams.GetGroupBy())
+		conditionModel.EvaluationWindow = types.StringValue(alertProtoToSchemaEvaluationWindow[condition.MoreThan.GetEvaluationWindow()])
+	case *alerts.AlertCondition_MoreThanUsual:
+		conditionParams := condition.MoreThanUsual.GetParameters()
+		conditionModel.MoreThanUsual = types.BoolValue(true)
+		conditionModel.Threshold = types.Int64Value(int64(conditionParams.GetThreshold().GetValue()))
+		if groupBy := conditionParams.GetGroupBy(); len(groupBy) > 0 && groupBy[0].GetValue() != "" {
+			conditionModel.GroupByKey = types.StringValue(groupBy[0].GetValue())
+		}
 	}
 
-	if isPromQL {
-		parameters.MetricAlertPromqlParameters = &alerts.MetricAlertPromqlConditionParameters{
-			PromqlText:                text,
-			SampleThresholdPercentage: sampleThresholdPercentage,
-			NonNullPercentage:         nonNullPercentage,
-			SwapNullValues:            swapNullValues,
-		}
-	} else {
-		metricField := wrapperspb.String(conditionMap["metric_field"].(string))
-		arithmeticOperator := expandArithmeticOperator(conditionMap["arithmetic_operator"].(string))
-		arithmeticOperatorModifier := wrapperspb.UInt32(uint32(conditionMap["arithmetic_operator_modifier"].(int)))
-		groupBy := interfaceSliceToWrappedStringSlice(conditionMap["group_by"].([]interface{}))
-		parameters.GroupBy = groupBy
-		parameters.MetricAlertParameters = &alerts.MetricAlertConditionParameters{
-			MetricSource:               alerts.MetricAlertConditionParameters_METRIC_SOURCE_LOGS2METRICS_OR_UNSPECIFIED,
-			MetricField:                metricField,
-			ArithmeticOperator:         arithmeticOperator,
-			ArithmeticOperatorModifier: arithmeticOperatorModifier,
-			SampleThresholdPercentage:  sampleThresholdPercentage,
-			NonNullPercentage:          nonNullPercentage,
-			SwapNullValues:             swapNullValues,
+	return conditionModel, diags
+}
+
+func flattenManageUndetectedValues(ctx context.Context, data *alerts.RelatedExtendedData) (types.Object, diag.Diagnostics) {
+	manageUndetectedValues := ManageUndetectedValuesModel{
+		EnableTriggeringOnUndetectedValues: types.BoolValue(true),
+		AutoRetireRatio:                    types.StringValue(flattenDeadmanRatio(alerts.CleanupDeadmanDuration_CLEANUP_DEADMAN_DURATION_NEVER_OR_UNSPECIFIED)),
+	}
+	if data != nil {
+		if data.GetShouldTriggerDeadman().GetValue() {
+			manageUndetectedValues.AutoRetireRatio = types.StringValue(flattenDeadmanRatio(data.GetCleanupDeadmanDuration()))
+		} else {
+			manageUndetectedValues.EnableTriggeringOnUndetectedValues = types.BoolValue(false)
+			manageUndetectedValues.AutoRetireRatio = types.StringNull()
 		}
 	}
 
-	return expandLessThanMoreThanOrMoreThanUsualAlertCondition(conditionMap, parameters)
+	return types.ObjectValueFrom(ctx, manageUndetectedValuesModelAttr(), manageUndetectedValues)
 }
 
-func expandArithmeticOperator(s string) alerts.MetricAlertConditionParameters_ArithmeticOperator {
-	arithmeticStr := alertSchemaArithmeticOperatorToProtoArithmetic[s]
-	arithmeticValue := alerts.MetricAlertConditionParameters_ArithmeticOperator_value[arithmeticStr]
-	return alerts.MetricAlertConditionParameters_ArithmeticOperator(arithmeticValue)
+func flattenDeadmanRatio(cleanupDeadmanDuration alerts.CleanupDeadmanDuration) string {
+	deadmanRatioStr := alerts.CleanupDeadmanDuration_name[int32(cleanupDeadmanDuration)]
+	return alertProtoDeadmanRatiosToSchemaDeadmanRatios[deadmanRatioStr]
 }
 
-func expandMetricFilters(m map[string]interface{}) *alerts.AlertFilters {
-	var text *wrapperspb.StringValue
-	if len(m["promql"].([]interface{})) == 0 {
-		luceneArr := m["lucene"].([]interface{})
-		lucene := luceneArr[0].(map[string]interface{})
-		text = wrapperspb.String(lucene["search_query"].(string))
+func flattenRatioAlert(ctx context.Context, filters *alerts.AlertFilters, condition interface{}) (*RatioAlertModel, diag.Diagnostics) {
+	var query2 *alerts.AlertFilters_RatioAlert
+	if ratioAlerts := filters.GetRatioAlerts(); len(ratioAlerts) > 0 {
+		query2 = ratioAlerts[0]
 	}
-
-	return &alerts.AlertFilters{
-		FilterType: alerts.AlertFilters_FILTER_TYPE_METRIC,
-		Text:       text,
+	conditionModel, diags := flattenRatioCondition(ctx, condition, query2)
+	if diags.HasError() {
+		return nil, diags
 	}
-}
 
-func expandFlow(m map[string]interface{}) *alertParams {
-	stages := expandFlowStages(m["stage"])
-	return &alertParams{
-		Condition: &alerts.AlertCondition{
-			Condition: &alerts.AlertCondition_Flow{
-				Flow: &alerts.FlowCondition{
-					Stages: stages,
-				},
-			},
-		},
-		Filters: &alerts.AlertFilters{
-			FilterType: alerts.AlertFilters_FILTER_TYPE_FLOW,
+	commonFilters := flattenCommonAlert(filters)
+	return &RatioAlertModel{
+		Query1: &RatioQuery1Model{
+			Alias:        types.StringValue(filters.GetAlias().GetValue()),
+			SearchQuery:  commonFilters.SearchQuery,
+			Severities:   commonFilters.Severities,
+			Applications: commonFilters.Applications,
+			Subsystems:   commonFilters.Subsystems,
+			Categories:   commonFilters.Categories,
+			Computers:    commonFilters.Computers,
+			Classes:      commonFilters.Classes,
+			Methods:      commonFilters.Methods,
+			IPAddresses:  commonFilters.IPAddresses,
 		},
-	}
+		Query2:    flattenQuery2(query2),
+		Condition: conditionModel,
+	}, nil
 }
 
-func expandFlowStages(i interface{}) []*alerts.FlowStage {
-	l := i.([]interface{})
-	result := make([]*alerts.FlowStage, 0, len(l))
-	for _, v := range l {
-		stage := expandFlowStage(v)
-		result = append(result, stage)
+func flattenQuery2(query2 *alerts.AlertFilters_RatioAlert) *RatioQuery2Model {
+	searchQuery := types.StringNull()
+	if text := query2.GetText().GetValue(); text != "" {
+		searchQuery = types.StringValue(text)
+	}
+	return &RatioQuery2Model{
+		Alias:        types.StringValue(query2.GetAlias().GetValue()),
+		SearchQuery:  searchQuery,
+		Severities:   flattenAlertFiltersSeverities(query2.GetSeverities()),
+		Applications: wrappedStringSliceToTypeStringSlice(query2.GetApplications()),
+		Subsystems:   wrappedStringSliceToTypeStringSlice(query2.GetSubsystems()),
 	}
-
-	return result
 }
 
-func expandFlowStage(i interface{}) *alerts.FlowStage {
-	m := i.(map[string]interface{})
-	groups := expandGroups(m["group"])
-	timeFrame := expandFlowTimeFrame(m["time_window"])
-	return &alerts.FlowStage{Groups: groups, Timeframe: timeFrame}
-}
+func flattenRatioCondition(ctx context.Context, condition interface{}, query2 *alerts.AlertFilters_RatioAlert) (*RatioConditionModel, diag.Diagnostics) {
+	conditionModel := &RatioConditionModel{
+		MoreThan:               types.BoolNull(),
+		LessThan:               types.BoolNull(),
+		GroupByQ1:              types.BoolNull(),
+		GroupByQ2:              types.BoolNull(),
+		GroupByBoth:            types.BoolNull(),
+		ManageUndetectedValues: types.ObjectNull(manageUndetectedValuesModelAttr()),
+	}
 
-func expandGroups(v interface{}) []*alerts.FlowGroup {
-	groups := v.([]interface{})
-	result := make([]*alerts.FlowGroup, 0, len(groups))
-	for _, g := range groups {
-		group := expandFlowGroup(g)
-		result = append(result, group)
+	var conditionParams *alerts.ConditionParameters
+	switch condition := condition.(type) {
+	case *alerts.AlertCondition_LessThan:
+		conditionParams = condition.LessThan.GetParameters()
+		conditionModel.LessThan = types.BoolValue(true)
+	case *alerts.AlertCondition_MoreThan:
+		conditionParams = condition.MoreThan.GetParameters()
+		conditionModel.MoreThan = types.BoolValue(true)
 	}
 
-	return result
-}
+	conditionModel.RatioThreshold = types.Float64Value(conditionParams.GetThreshold().GetValue())
+	conditionModel.TimeWindow = types.StringValue(alertProtoTimeFrameToSchemaTimeFrame[conditionParams.GetTimeframe().String()])
+	conditionModel.IgnoreInfinity = types.BoolValue(conditionParams.GetIgnoreInfinity().GetValue())
 
-func expandFlowGroup(v interface{}) *alerts.FlowGroup {
-	m := v.(map[string]interface{})
-	subAlerts := expandSubAlerts(m["sub_alerts"])
-	operator := expandOperator(m["next_operator"])
-	return &alerts.FlowGroup{
-		Alerts: subAlerts,
-		NextOp: operator,
+	groupByQ1 := conditionParams.GetGroupBy()
+	groupByQ2 := query2.GetGroupBy()
+	var groupBy []*wrapperspb.StringValue
+	switch {
+	case len(groupByQ1) > 0 && len(groupByQ2) > 0:
+		groupBy = groupByQ1
+		conditionModel.GroupByBoth = types.BoolValue(true)
+	case len(groupByQ1) > 0:
+		groupBy = groupByQ1
+		conditionModel.GroupByQ1 = types.BoolValue(true)
+	case len(groupByQ2) > 0:
+		groupBy = groupByQ2
+		conditionModel.GroupByQ2 = types.BoolValue(true)
+	}
+	conditionModel.GroupBy = wrappedStringSliceToTypeStringList(groupBy)
+
+	var diags diag.Diagnostics
+	if len(groupBy) > 0 && conditionModel.LessThan.ValueBool() {
+		conditionModel.ManageUndetectedValues, diags = flattenManageUndetectedValues(ctx, conditionParams.GetRelatedExtendedData())
 	}
+
+	return conditionModel, diags
 }
 
-func expandSubAlerts(v interface{}) *alerts.FlowAlerts {
-	l := v.([]interface{})
-	if len(l) == 0 || l[0] == nil {
-		return nil
+func flattenUniqueCountAlert(filters *alerts.AlertFilters, condition interface{}) *UniqueCountAlertModel {
+	conditionParams := condition.(*alerts.AlertCondition_UniqueCount).UniqueCount.GetParameters()
+	conditionModel := &UniqueCountConditionModel{
+		MaxUniqueValues:           types.Int64Value(int64(conditionParams.GetThreshold().GetValue())),
+		TimeWindow:                types.StringValue(alertProtoUniqueCountTimeFrameToSchemaTimeFrame[conditionParams.GetTimeframe().String()]),
+		GroupByKey:                types.StringNull(),
+		MaxUniqueValuesForGroupBy: types.Int64Null(),
+	}
+	if cardinalityFields := conditionParams.GetCardinalityFields(); len(cardinalityFields) > 0 {
+		conditionModel.UniqueCountKey = types.StringValue(cardinalityFields[0].GetValue())
+	}
+	if groupBy := conditionParams.GetGroupBy(); len(groupBy) > 0 && groupBy[0].GetValue() != "" {
+		conditionModel.GroupByKey = types.StringValue(groupBy[0].GetValue())
+		conditionModel.MaxUniqueValuesForGroupBy = types.Int64Value(int64(conditionParams.GetMaxUniqueCountValuesForGroupByKey().GetValue()))
 	}
-	raw := l[0]
-	m := raw.(map[string]interface{})
-
-	operator := expandOperator(m["operator"])
-	values := expandInnerFlowAlerts(m["flow_alert"])
 
-	return &alerts.FlowAlerts{
-		Op:     operator,
-		Values: values,
+	commonFilters := flattenCommonAlert(filters)
+	return &UniqueCountAlertModel{
+		SearchQuery:  commonFilters.SearchQuery,
+		Severities:   commonFilters.Severities,
+		Applications: commonFilters.Applications,
+		Subsystems:   commonFilters.Subsystems,
+		Categories:   commonFilters.Categories,
+		Computers:    commonFilters.Computers,
+		Classes:      commonFilters.Classes,
+		Methods:      commonFilters.Methods,
+		IPAddresses:  commonFilters.IPAddresses,
+		Condition:    conditionModel,
 	}
 }
 
-func expandInnerFlowAlerts(v interface{}) []*alerts.FlowAlert {
-	flowAlerts := v.([]interface{})
-	result := make([]*alerts.FlowAlert, 0, len(flowAlerts))
-	for _, fa := range flowAlerts {
-		flowAlert := expandInnerFlowAlert(fa)
-		result = append(result, flowAlert)
+func flattenTimeRelativeAlert(ctx context.Context, filters *alerts.AlertFilters, condition interface{}) (*TimeRelativeAlertModel, diag.Diagnostics) {
+	conditionModel := &TimeRelativeConditionModel{
+		LessThan:               types.BoolNull(),
+		MoreThan:               types.BoolNull(),
+		ManageUndetectedValues: types.ObjectNull(manageUndetectedValuesModelAttr()),
 	}
-	return result
-}
 
-func expandInnerFlowAlert(v interface{}) *alerts.FlowAlert {
-	m := v.(map[string]interface{})
-	return &alerts.FlowAlert{
-		Id:  wrapperspb.String(m["user_alert_id"].(string)),
-		Not: wrapperspb.Bool(m["not"].(bool)),
+	var diags diag.Diagnostics
+	var conditionParams *alerts.ConditionParameters
+	switch condition := condition.(type) {
+	case *alerts.AlertCondition_LessThan:
+		conditionParams = condition.LessThan.GetParameters()
+		conditionModel.LessThan = types.BoolValue(true)
+		if len(conditionParams.GetGroupBy()) > 0 {
+			conditionModel.ManageUndetectedValues, diags = flattenManageUndetectedValues(ctx, conditionParams.GetRelatedExtendedData())
+		}
+	case *alerts.AlertCondition_MoreThan:
+		conditionParams = condition.MoreThan.GetParameters()
+		conditionModel.MoreThan = types.BoolValue(true)
+	}
+	if diags.HasError() {
+		return nil, diags
 	}
-}
 
-func expandOperator(i interface{}) alerts.FlowOperator {
-	operatorStr := i.(string)
-	return alerts.FlowOperator(alerts.FlowOperator_value[operatorStr])
+	conditionModel.IgnoreInfinity = types.BoolValue(conditionParams.GetIgnoreInfinity().GetValue())
+	conditionModel.RatioThreshold = types.Float64Value(conditionParams.GetThreshold().GetValue())
+	conditionModel.GroupBy = wrappedStringSliceToTypeStringList(conditionParams.GetGroupBy())
+	conditionModel.RelativeTimeWindow = types.StringValue(flattenRelativeTimeWindow(conditionParams.GetTimeframe(), conditionParams.GetRelativeTimeframe()))
+
+	commonFilters := flattenCommonAlert(filters)
+	return &TimeRelativeAlertModel{
+		SearchQuery:  commonFilters.SearchQuery,
+		Severities:   commonFilters.Severities,
+		Applications: commonFilters.Applications,
+		Subsystems:   commonFilters.Subsystems,
+		Categories:   commonFilters.Categories,
+		Computers:    commonFilters.Computers,
+		Classes:      commonFilters.Classes,
+		Methods:      commonFilters.Methods,
+		IPAddresses:  commonFilters.IPAddresses,
+		Condition:    conditionModel,
+	}, nil
 }
 
-func expandFlowTimeFrame(i interface{}) *alerts.FlowTimeframe {
-	return &alerts.FlowTimeframe{
-		Ms: wrapperspb.UInt32(uint32(expandTimeToMS(i))),
-	}
+func flattenRelativeTimeWindow(timeFrame alerts.Timeframe, relativeTimeFrame alerts.RelativeTimeframe) string {
+	p := protoTimeFrameAndRelativeTimeFrame{timeFrame: timeFrame, relativeTimeFrame: relativeTimeFrame}
+	return alertProtoTimeFrameAndRelativeTimeFrameToSchemaRelativeTimeFrame[p]
 }
 
-func expandTracing(m map[string]interface{}) (*alertParams, *alerts.TracingAlert) {
-	tracingParams, _ := expandTracingParams(m)
-	tracingAlert := expandTracingAlert(m)
-
-	return tracingParams, tracingAlert
-}
+func flattenMetricAlert(ctx context.Context, filters *alerts.AlertFilters, condition interface{}) (*MetricAlertModel, diag.Diagnostics) {
+	var conditionParams *alerts.ConditionParameters
+	lessThan, moreThan, moreThanUsual := types.BoolNull(), types.BoolNull(), types.BoolNull()
+	evaluationWindow := types.StringNull()
+	switch condition := condition.(type) {
+	case *alerts.AlertCondition_LessThan:
+		conditionParams = condition.LessThan.GetParameters()
+		lessThan = types.BoolValue(true)
+	case *alerts.AlertCondition_MoreThan:
+		conditionParams = condition.MoreThan.GetParameters()
+		moreThan = types.BoolValue(true)
+		evaluationWindow = types.StringValue(alertProtoToSchemaEvaluationWindow[condition.MoreThan.GetEvaluationWindow()])
+	case *alerts.AlertCondition_MoreThanUsual:
+		conditionParams = condition.MoreThanUsual.GetParameters()
+		moreThanUsual = types.BoolValue(true)
+	}
 
-func expandTracingParams(m map[string]interface{}) (*alertParams, error) {
-	conditionMap := extractConditionMap(m)
-	condition, err := expandTracingCondition(conditionMap)
-	if err != nil {
-		return nil, err
+	manageUndetectedValues := types.ObjectNull(manageUndetectedValuesModelAttr())
+	var diags diag.Diagnostics
+	if lessThan.ValueBool() {
+		manageUndetectedValues, diags = flattenManageUndetectedValues(ctx, conditionParams.GetRelatedExtendedData())
+		if diags.HasError() {
+			return nil, diags
+		}
 	}
-	filters := expandTracingFilter()
-	return &alertParams{
-		Condition: condition,
-		Filters:   filters,
-	}, nil
-}
 
-func expandTracingCondition(m map[string]interface{}) (*alerts.AlertCondition, error) {
-	if immediately := m["immediately"]; immediately != nil && immediately.(bool) {
-		return &alerts.AlertCondition{
-			Condition: &alerts.AlertCondition_Immediate{},
-		}, nil
-	} else if moreThan := m["more_than"]; moreThan != nil && moreThan.(bool) {
-		parameters := expandTracingConditionParameters(m)
-		return &alerts.AlertCondition{
-			Condition: &alerts.AlertCondition_MoreThan{
-				MoreThan: &alerts.MoreThanCondition{Parameters: parameters},
+	if promqlParams := conditionParams.GetMetricAlertPromqlParameters(); promqlParams != nil {
+		return &MetricAlertModel{
+			Promql: &PromqlMetricAlertModel{
+				SearchQuery: types.StringValue(promqlParams.GetPromqlText().GetValue()),
+				Condition: &PromqlMetricConditionModel{
+					LessThan:                    lessThan,
+					MoreThan:                    moreThan,
+					MoreThanUsual:               moreThanUsual,
+					Threshold:                   types.Float64Value(conditionParams.GetThreshold().GetValue()),
+					TimeWindow:                  types.StringValue(alertProtoMetricTimeFrameToMetricSchemaTimeFrame[conditionParams.GetTimeframe().String()]),
+					SampleThresholdPercentage:   types.Int64Value(int64(promqlParams.GetSampleThresholdPercentage().GetValue())),
+					GroupBy:                     wrappedStringSliceToTypeStringList(conditionParams.GetGroupBy()),
+					ReplaceMissingValueWithZero: types.BoolValue(promqlParams.GetSwapNullValues().GetValue()),
+					MinNonNullValuesPercentage:  types.Int64Value(int64(promqlParams.GetNonNullPercentage().GetValue())),
+					ManageUndetectedValues:      manageUndetectedValues,
+					EvaluationWindow:            evaluationWindow,
+				},
 			},
 		}, nil
 	}
 
-	return nil, fmt.Errorf("immediately or more_than have to be true")
-}
-
-func expandTracingFilter() *alerts.AlertFilters {
-	return &alerts.AlertFilters{
-		FilterType: alerts.AlertFilters_FILTER_TYPE_TRACING,
-	}
+	metricParams := conditionParams.GetMetricAlertParameters()
+	return &MetricAlertModel{
+		Lucene: &LuceneMetricAlertModel{
+			SearchQuery: types.StringValue(filters.GetText().GetValue()),
+			Condition: &LuceneMetricConditionModel{
+				MetricField:                 types.StringValue(metricParams.GetMetricField().GetValue()),
+				ArithmeticOperator:          types.StringValue(alertProtoArithmeticOperatorToSchemaArithmetic[metricParams.GetArithmeticOperator().String()]),
+				ArithmeticOperatorModifier:  types.Int64Value(int64(metricParams.GetArithmeticOperatorModifier().GetValue())),
+				LessThan:                    lessThan,
+				MoreThan:                    moreThan,
+				Threshold:                   types.Float64Value(conditionParams.GetThreshold().GetValue()),
+				SampleThresholdPercentage:   types.Int64Value(int64(metricParams.GetSampleThresholdPercentage().GetValue())),
+				TimeWindow:                  types.StringValue(alertProtoMetricTimeFrameToMetricSchemaTimeFrame[conditionParams.GetTimeframe().String()]),
+				GroupBy:                     wrappedStringSliceToTypeStringList(conditionParams.GetGroupBy()),
+				ReplaceMissingValueWithZero: types.BoolValue(metricParams.GetSwapNullValues().GetValue()),
+				MinNonNullValuesPercentage:  types.Int64Value(int64(metricParams.GetNonNullPercentage().GetValue())),
+				ManageUndetectedValues:      manageUndetectedValues,
+			},
+		},
+	}, nil
 }
 
-func expandTracingAlert(m map[string]interface{}) *alerts.TracingAlert {
-	conditionLatency := uint32(m["latency_threshold_milliseconds"].(float64) * (float64)(time.Millisecond.Microseconds()))
-	applications := m["applications"].(*schema.Set).List()
-	subsystems := m["subsystems"].(*schema.Set).List()
-	services := m["services"].(*schema.Set).List()
-	fieldFilters := expandFiltersData(applications, subsystems, services)
-	tagFilters := expandTagFilters(m["tag_filter"])
-	return &alerts.TracingAlert{
-		ConditionLatency: conditionLatency,
-		FieldFilters:     fieldFilters,
-		TagFilters:       tagFilters,
+func flattenTracingAlert(condition interface{}, tracingAlert *alerts.TracingAlert) *TracingAlertModel {
+	fieldFilters := flattenFiltersData(tracingAlert.GetFieldFilters())
+	return &TracingAlertModel{
+		Applications:                 stringSliceToTypeStringSet(fieldFilters["applicationName"]),
+		Subsystems:                   stringSliceToTypeStringSet(fieldFilters["subsystemName"]),
+		Services:                     stringSliceToTypeStringSet(fieldFilters["serviceName"]),
+		TagFilters:                   flattenTagFiltersData(tracingAlert.GetTagFilters()),
+		LatencyThresholdMilliseconds: types.Float64Value(float64(tracingAlert.GetConditionLatency()) / float64(time.Millisecond.Microseconds())),
+		Condition:                    flattenTracingCondition(condition),
 	}
 }
 
-func expandFiltersData(applications, subsystems, services []interface{}) []*alerts.FilterData {
-	result := make([]*alerts.FilterData, 0)
-	if len(applications) != 0 {
-		result = append(result, expandSpecificFilter("applicationName", applications))
-	}
-	if len(subsystems) != 0 {
-		result = append(result, expandSpecificFilter("subsystemName", subsystems))
-	}
-	if len(services) != 0 {
-		result = append(result, expandSpecificFilter("serviceName", services))
+func flattenFiltersData(filtersData []*alerts.FilterData) map[string][]string {
+	result := make(map[string][]string, len(filtersData))
+	for _, filter := range filtersData {
+		result[filter.GetField()] = flattenFilters(filter.GetFilters())
 	}
-
 	return result
 }
 
-func expandTagFilters(i interface{}) []*alerts.FilterData {
-	if i == nil {
+func flattenTagFiltersData(filtersData []*alerts.FilterData) []TracingTagFilterModel {
+	if len(filtersData) == 0 {
 		return nil
 	}
-	l := i.(*schema.Set).List()
-
-	result := make([]*alerts.FilterData, 0, len(l))
-	for _, v := range l {
-		m := v.(map[string]interface{})
-		field := m["field"].(string)
-		values := m["values"].(*schema.Set).List()
-		result = append(result, expandSpecificFilter(field, values))
+	result := make([]TracingTagFilterModel, 0, len(filtersData))
+	for _, filter := range filtersData {
+		result = append(result, TracingTagFilterModel{
+			Field:  types.StringValue(filter.GetField()),
+			Values: stringSliceToTypeStringSet(flattenFilters(filter.GetFilters())),
+		})
 	}
 	return result
 }
 
-func expandSpecificFilter(filterName string, values []interface{}) *alerts.FilterData {
-	operatorToFilterValues := make(map[string]*alerts.Filters)
-	for _, val := range values {
-		operator, filterValue := expandFilter(val.(string))
-		if _, ok := operatorToFilterValues[operator]; !ok {
-			operatorToFilterValues[operator] = new(alerts.Filters)
-			operatorToFilterValues[operator].Operator = operator
-			operatorToFilterValues[operator].Values = make([]string, 0)
+func flattenFilters(filters []*alerts.Filters) []string {
+	result := make([]string, 0)
+	for _, f := range filters {
+		values := f.GetValues()
+		switch operator := f.GetOperator(); operator {
+		case "notEquals", "contains", "startsWith", "endsWith":
+			for _, val := range values {
+				result = append(result, fmt.Sprintf("filter:%s:%s", operator, val))
+			}
+		default:
+			result = append(result, values...)
 		}
-		operatorToFilterValues[operator].Values = append(operatorToFilterValues[operator].Values, filterValue)
-	}
-
-	filterResult := make([]*alerts.Filters, 0, len(operatorToFilterValues))
-	for _, filters := range operatorToFilterValues {
-		filterResult = append(filterResult, filters)
-	}
-
-	return &alerts.FilterData{
-		Field:   filterName,
-		Filters: filterResult,
 	}
+	return result
 }
 
-func expandFilter(filterString string) (operator, filterValue string) {
-	operator, filterValue = "equals", filterString
-	if strings.HasPrefix(filterValue, "filter:") {
-		arr := strings.SplitN(filterValue, ":", 3)
-		operator, filterValue = arr[1], arr[2]
+func flattenTracingCondition(condition interface{}) *TracingConditionModel {
+	conditionModel := &TracingConditionModel{
+		Immediately: types.BoolNull(),
+		MoreThan:    types.BoolNull(),
+		Threshold:   types.Int64Value(0),
+		TimeWindow:  types.StringNull(),
+		GroupBy:     types.ListNull(types.StringType),
 	}
 
-	return
-}
-
-func extractConditionMap(m map[string]interface{}) map[string]interface{} {
-	return m["condition"].([]interface{})[0].(map[string]interface{})
-}
-
-func expandTimeFrame(s string) alerts.Timeframe {
-	protoTimeFrame := alertSchemaTimeFrameToProtoTimeFrame[s]
-	return alerts.Timeframe(alerts.Timeframe_value[protoTimeFrame])
-}
+	switch condition := condition.(type) {
+	case *alerts.AlertCondition_Immediate:
+		conditionModel.Immediately = types.BoolValue(true)
+	case *alerts.AlertCondition_MoreThan:
+		conditionParams := condition.MoreThan.GetParameters()
+		conditionModel.MoreThan = types.BoolValue(true)
+		conditionModel.Threshold = types.Int64Value(int64(conditionParams.GetThreshold().GetValue()))
+		conditionModel.TimeWindow = types.StringValue(alertProtoTimeFrameToSchemaTimeFrame[conditionParams.GetTimeframe().String()])
+		conditionModel.GroupBy = wrappedStringSliceToTypeStringList(conditionParams.GetGroupBy())
+	}
 
-func expandMetricTimeFrame(s string) alerts.Timeframe {
-	protoTimeFrame := alertSchemaMetricTimeFrameToMetricProtoTimeFrame[s]
-	return alerts.Timeframe(alerts.Timeframe_value[protoTimeFrame])
+	return conditionModel
 }
 
-func expandMetadata(m map[string]interface{}) *alerts.AlertFilters_MetadataFilters {
-	categories := interfaceSliceToWrappedStringSlice(m["categories"].(*schema.Set).List())
-	applications := interfaceSliceToWrappedStringSlice(m["applications"].(*schema.Set).List())
-	subsystems := interfaceSliceToWrappedStringSlice(m["subsystems"].(*schema.Set).List())
-	computers := interfaceSliceToWrappedStringSlice(m["computers"].(*schema.Set).List())
-	classes := interfaceSliceToWrappedStringSlice(m["classes"].(*schema.Set).List())
-	methods := interfaceSliceToWrappedStringSlice(m["methods"].(*schema.Set).List())
-	ipAddresses := interfaceSliceToWrappedStringSlice(m["ip_addresses"].(*schema.Set).List())
+func flattenFlowAlert(condition interface{}) *FlowAlertModel {
+	flowCondition, ok := condition.(*alerts.AlertCondition_Flow)
+	if !ok {
+		return nil
+	}
 
-	return &alerts.AlertFilters_MetadataFilters{
-		Categories:   categories,
-		Applications: applications,
-		Subsystems:   subsystems,
-		Computers:    computers,
-		Classes:      classes,
-		Methods:      methods,
-		IpAddresses:  ipAddresses,
+	stages := make([]FlowStageModel, 0, len(flowCondition.Flow.GetStages()))
+	for _, stage := range flowCondition.Flow.GetStages() {
+		stages = append(stages, FlowStageModel{
+			Groups:     flattenFlowGroups(stage.GetGroups()),
+			TimeWindow: flattenFlowTimeFrame(stage.GetTimeframe()),
+		})
 	}
+	return &FlowAlertModel{Stages: stages}
 }
 
-func expandAlertFiltersSeverities(v interface{}) []alerts.AlertFilters_LogSeverity {
-	s := interfaceSliceToStringSlice(v.([]interface{}))
-	result := make([]alerts.AlertFilters_LogSeverity, 0, len(s))
-	for _, v := range s {
-		logSeverityStr := alertSchemaLogSeverityToProtoLogSeverity[v]
-		result = append(result, alerts.AlertFilters_LogSeverity(
-			alerts.AlertFilters_LogSeverity_value[logSeverityStr]))
+func flattenFlowGroups(groups []*alerts.FlowGroup) []FlowGroupModel {
+	result := make([]FlowGroupModel, 0, len(groups))
+	for _, group := range groups {
+		result = append(result, FlowGroupModel{
+			SubAlerts:    flattenSubAlerts(group.GetAlerts()),
+			NextOperator: types.StringValue(group.GetNextOp().String()),
+		})
 	}
-
 	return result
 }
 
-func expandNewValueTimeFrame(s string) alerts.Timeframe {
-	protoTimeFrame := alertSchemaNewValueTimeFrameToProtoTimeFrame[s]
-	return alerts.Timeframe(alerts.Timeframe_value[protoTimeFrame])
-}
+func flattenSubAlerts(subAlerts *alerts.FlowAlerts) *FlowSubAlertsModel {
+	flowAlerts := make([]FlowInnerAlertModel, 0, len(subAlerts.GetValues()))
+	for _, flowAlert := range subAlerts.GetValues() {
+		flowAlerts = append(flowAlerts, FlowInnerAlertModel{
+			Not:         types.BoolValue(flowAlert.GetNot().GetValue()),
+			UserAlertID: types.StringValue(flowAlert.GetId().GetValue()),
+		})
+	}
 
-func expandUniqueValueTimeFrame(s string) alerts.Timeframe {
-	protoTimeFrame := alertSchemaUniqueCountTimeFrameToProtoTimeFrame[s]
-	return alerts.Timeframe(alerts.Timeframe_value[protoTimeFrame])
+	return &FlowSubAlertsModel{
+		Operator:   types.StringValue(subAlerts.GetOp().String()),
+		FlowAlerts: flowAlerts,
+	}
 }
 
-func expandTimeInDay(v interface{}) *alerts.Time {
-	timeArr := strings.Split(v.(string), ":")
-	hours, _ := strconv.Atoi(timeArr[0])
-	minutes, _ := strconv.Atoi(timeArr[1])
-	return &alerts.Time{
-		Hours:   int32(hours),
-		Minutes: int32(minutes),
+func flattenFlowTimeFrame(timeFrame *alerts.FlowTimeframe) *FlowTimeWindowModel {
+	timeMS := int64(timeFrame.GetMs().GetValue())
+	if timeMS == 0 {
+		return nil
+	}
+
+	return &FlowTimeWindowModel{
+		Hours:   types.Int64Value(timeMS / int64(msInHour)),
+		Minutes: types.Int64Value(timeMS % int64(msInHour) / int64(msInMinute)),
+		Seconds: types.Int64Value(timeMS % int64(msInMinute) / int64(msInSecond)),
 	}
 }
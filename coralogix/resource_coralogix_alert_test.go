@@ -1,18 +1,34 @@
 package coralogix
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"math"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
 	"strconv"
+	"strings"
 	"testing"
 
 	"terraform-provider-coralogix/coralogix/clientset"
 	alertsv1 "terraform-provider-coralogix/coralogix/clientset/grpc/alerts/v2"
 
+	"github.com/google/go-cmp/cmp"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -38,9 +54,9 @@ func TestAccCoralogixResourceAlert_standard(t *testing.T) {
 	updatedAlertChecks := extractStandardAlertChecks(updatedAlert)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckAlertDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAlertDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCoralogixResourceAlertStandard(&alert),
@@ -58,6 +74,69 @@ func TestAccCoralogixResourceAlert_standard(t *testing.T) {
 	})
 }
 
+func TestAccCoralogixResourceAlert_standardWithIANATimeZone(t *testing.T) {
+	alert := standardAlertTestParams{
+		alertCommonTestParams: *getRandomAlert(),
+		groupBy:               []string{"EventType"},
+		occurrencesThreshold:  acctest.RandIntRange(1, 1000),
+		timeWindow:            selectRandomlyFromSlice(alertValidTimeFrames),
+		deadmanRatio:          selectRandomlyFromSlice(alertValidDeadmanRatioValues),
+	}
+	alert.timeZone = "Asia/Kolkata"
+	alert.daysOfWeek = []string{"Monday", "Sunday"}
+	alert.activityStarts = "01:00"
+	alert.activityEnds = "09:15"
+	checks := extractStandardAlertChecks(alert)
+	checks = append(checks, resource.TestCheckResourceAttr(alertResourceName, "scheduling.time_zone", "Asia/Kolkata"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAlertDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoralogixResourceAlertStandard(&alert),
+				Check:  resource.ComposeAggregateTestCheckFunc(checks...),
+			},
+		},
+	})
+}
+
+func TestAccCoralogixResourceAlert_multipleTimeFrames(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc-test")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAlertDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoralogixResourceAlertWithTimeFrames(name, `["Saturday", "Sunday"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(alertResourceName, "scheduling.time_frame.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(alertResourceName, "scheduling.time_frame.*",
+						map[string]string{
+							"days_enabled.#": "5",
+							"start_time":     "08:00",
+							"end_time":       "20:00",
+						}),
+					resource.TestCheckTypeSetElemNestedAttrs(alertResourceName, "scheduling.time_frame.*",
+						map[string]string{
+							"days_enabled.#": "2",
+							"start_time":     "10:00",
+							"end_time":       "16:00",
+						}),
+				),
+			},
+			{
+				ResourceName:            alertResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"scheduling"},
+			},
+		},
+	})
+}
+
 func TestAccCoralogixResourceAlert_ratio(t *testing.T) {
 	alert := ratioAlertTestParams{
 		alertCommonTestParams: *getRandomAlert(),
@@ -82,9 +161,9 @@ func TestAccCoralogixResourceAlert_ratio(t *testing.T) {
 	updatedAlertChecks := extractRatioAlertChecks(updatedAlert)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckAlertDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAlertDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCoralogixResourceAlertRatio(&alert),
@@ -118,9 +197,9 @@ func TestAccCoralogixResourceAlert_newValue(t *testing.T) {
 	updatedAlertChecks := extractNewValueChecks(updatedAlert)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckAlertDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAlertDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCoralogixResourceAlertNewValue(&alert),
@@ -160,9 +239,9 @@ func TestAccCoralogixResourceAlert_uniqueCount(t *testing.T) {
 	updatedAlertChecks := extractUniqueCountAlertChecks(updatedAlert)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckAlertDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAlertDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCoralogixResourceAlertUniqueCount(&alert),
@@ -200,9 +279,9 @@ func TestAccCoralogixResourceAlert_timeRelative(t *testing.T) {
 	updatedAlertChecks := extractTimeRelativeChecks(updatedAlert)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckAlertDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAlertDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCoralogixResourceAlertTimeRelative(&alert),
@@ -248,9 +327,9 @@ func TestAccCoralogixResourceAlert_metricLucene(t *testing.T) {
 	updatedAlertChecks := extractLuceneMetricChecks(updatedAlert)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckAlertDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAlertDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCoralogixResourceAlertMetricLucene(&alert),
@@ -284,13 +363,24 @@ func TestAccCoralogixResourceAlert_metricPromql(t *testing.T) {
 		nonNullPercentage:     10 * acctest.RandIntRange(0, 10),
 		timeWindow:            selectRandomlyFromSlice(alertValidMetricTimeFrames),
 		condition:             "more_than",
+		groupBy:               []string{"pod"},
 	}
 	updatedAlertChecks := extractMetricPromqlAlertChecks(updatedAlert)
 
+	anomalyAlert := metricPromqlAlertTestParams{
+		alertCommonTestParams: *getRandomAlert(),
+		threshold:             acctest.RandIntRange(0, 1000),
+		nonNullPercentage:     10 * acctest.RandIntRange(0, 10),
+		timeWindow:            selectRandomlyFromSlice(alertValidMetricTimeFrames),
+		condition:             "more_than_usual",
+		groupBy:               []string{"pod", "namespace"},
+	}
+	anomalyAlertChecks := extractMetricPromqlAlertChecks(anomalyAlert)
+
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckAlertDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAlertDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCoralogixResourceAlertMetricPromql(&alert),
@@ -304,6 +394,74 @@ func TestAccCoralogixResourceAlert_metricPromql(t *testing.T) {
 				Config: testAccCoralogixResourceAlertMetricPromql(&updatedAlert),
 				Check:  resource.ComposeAggregateTestCheckFunc(updatedAlertChecks...),
 			},
+			{
+				Config: testAccCoralogixResourceAlertMetricPromql(&anomalyAlert),
+				Check:  resource.ComposeAggregateTestCheckFunc(anomalyAlertChecks...),
+			},
+		},
+	})
+}
+
+func TestAccCoralogixResourceAlert_invalidQueries(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCoralogixResourceAlertWithPromqlQuery(`sum(rate(http_requests_total[5m])`),
+				ExpectError: regexp.MustCompile(`PromQL syntax error at line 1, column 34`),
+			},
+			{
+				Config:      testAccCoralogixResourceAlertWithLuceneQuery(`level:ERROR AND`),
+				ExpectError: regexp.MustCompile(`Lucene syntax error at line 1, column 13: AND is missing a right operand`),
+			},
+		},
+	})
+}
+
+func TestAccCoralogixResourceAlert_invalidNotifications(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCoralogixResourceAlertWithNotification(`integration_id = "999999999"`),
+				ExpectError: regexp.MustCompile(`Webhook "999999999" doesn't exist`),
+			},
+			{
+				Config:      testAccCoralogixResourceAlertWithNotification(`email_recipients = ["not-an-email"]`),
+				ExpectError: regexp.MustCompile(`is not a valid email address`),
+			},
+		},
+	})
+}
+
+func TestAccCoralogixResourceAlert_invalidScheduling(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCoralogixResourceAlertWithTimeFrames(acctest.RandomWithPrefix("tf-acc-test"), `["Friday", "Saturday"]`),
+				ExpectError: regexp.MustCompile(`Overlapping scheduling time frames`),
+			},
+		},
+	})
+}
+
+func TestAccCoralogixResourceAlert_invalidFlow(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCoralogixResourceAlertWithFlowGroups(`"00000000-0000-0000-0000-000000000000"`, "AND", "AND"),
+				ExpectError: regexp.MustCompile(`Alert "00000000-0000-0000-0000-000000000000" doesn't exist`),
+			},
+			{
+				Config:      testAccCoralogixResourceAlertWithFlowGroups("coralogix_alert.standard.id", "AND", "OR"),
+				ExpectError: regexp.MustCompile(`Flow operators mismatch`),
+			},
 		},
 	})
 }
@@ -328,9 +486,9 @@ func TestAccCoralogixResourceAlert_tracing(t *testing.T) {
 	updatedAlertChecks := extractTracingAlertChecks(updatedAlert)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckAlertDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAlertDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCoralogixResourceAlertTracing(&alert),
@@ -358,7 +516,7 @@ func TestAccCoralogixResourceAlert_flow(t *testing.T) {
 		webhookID:       "5676",
 		severity:        selectRandomlyFromSlice(alertValidSeverities),
 		activeWhen:      randActiveWhen(),
-		notifyEveryMin:  acctest.RandIntRange(1500 /*to avoid notify_every < condition.0.time_window*/, 3600),
+		notifyEveryMin:  acctest.RandIntRange(1500 /*to avoid notify_every < condition.time_window*/, 3600),
 	}
 	checks := extractFlowAlertChecks(alert)
 
@@ -369,14 +527,14 @@ func TestAccCoralogixResourceAlert_flow(t *testing.T) {
 		webhookID:       "5676",
 		severity:        selectRandomlyFromSlice(alertValidSeverities),
 		activeWhen:      randActiveWhen(),
-		notifyEveryMin:  acctest.RandIntRange(1500 /*to avoid notify_every < condition.0.time_window*/, 3600),
+		notifyEveryMin:  acctest.RandIntRange(1500 /*to avoid notify_every < condition.time_window*/, 3600),
 	}
 	updatedAlertChecks := extractFlowAlertChecks(updatedAlert)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckAlertDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAlertDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCoralogixResourceAlertFLow(&alert),
@@ -403,7 +561,7 @@ func getRandomAlert() *alertCommonTestParams {
 		searchQuery:     "remote_addr_enriched:/.*/",
 		severity:        selectRandomlyFromSlice(alertValidSeverities),
 		activeWhen:      randActiveWhen(),
-		notifyEveryMin:  acctest.RandIntRange(2160 /*to avoid notify_every < condition.0.time_window*/, 3600),
+		notifyEveryMin:  acctest.RandIntRange(2160 /*to avoid notify_every < condition.time_window*/, 3600),
 		alertFilters: alertFilters{
 			severities: selectManyRandomlyFromSlice(alertValidLogSeverities),
 		},
@@ -426,18 +584,18 @@ func extractStandardAlertChecks(alert standardAlertTestParams) []resource.TestCh
 			}),
 		resource.TestCheckResourceAttr(alertResourceName, "meta_labels.alert_type", "security"),
 		resource.TestCheckResourceAttr(alertResourceName, "meta_labels.security_severity", "high"),
-		resource.TestCheckResourceAttr(alertResourceName, "standard.0.condition.0.threshold", strconv.Itoa(alert.occurrencesThreshold)),
-		resource.TestCheckResourceAttr(alertResourceName, "standard.0.condition.0.time_window", alert.timeWindow),
-		resource.TestCheckResourceAttr(alertResourceName, "standard.0.condition.0.group_by.0", alert.groupBy[0]),
-		resource.TestCheckResourceAttr(alertResourceName, "standard.0.condition.0.less_than", "true"),
-		resource.TestCheckResourceAttr(alertResourceName, "standard.0.condition.0.manage_undetected_values.0.enable_triggering_on_undetected_values", "true"),
-		resource.TestCheckResourceAttr(alertResourceName, "standard.0.condition.0.manage_undetected_values.0.auto_retire_ratio", alert.deadmanRatio),
+		resource.TestCheckResourceAttr(alertResourceName, "standard.condition.threshold", strconv.Itoa(alert.occurrencesThreshold)),
+		resource.TestCheckResourceAttr(alertResourceName, "standard.condition.time_window", alert.timeWindow),
+		resource.TestCheckResourceAttr(alertResourceName, "standard.condition.group_by.0", alert.groupBy[0]),
+		resource.TestCheckResourceAttr(alertResourceName, "standard.condition.less_than", "true"),
+		resource.TestCheckResourceAttr(alertResourceName, "standard.condition.manage_undetected_values.enable_triggering_on_undetected_values", "true"),
+		resource.TestCheckResourceAttr(alertResourceName, "standard.condition.manage_undetected_values.auto_retire_ratio", alert.deadmanRatio),
 	)
 	return checks
 }
 
 func extractRatioAlertChecks(alert ratioAlertTestParams) []resource.TestCheckFunc {
-	checks := extractCommonChecks(&alert.alertCommonTestParams, "ratio.0.query_1")
+	checks := extractCommonChecks(&alert.alertCommonTestParams, "ratio.query_1")
 	checks = append(checks,
 		resource.TestCheckTypeSetElemNestedAttrs(alertResourceName, "notifications_group.0.notification.*",
 			map[string]string{
@@ -450,16 +608,16 @@ func extractRatioAlertChecks(alert ratioAlertTestParams) []resource.TestCheckFun
 				"notify_on":                   "Triggered_and_resolved",
 				"retriggering_period_minutes": fmt.Sprintf("%d", alert.notifyEveryMin),
 			}),
-		resource.TestCheckResourceAttr(alertResourceName, "ratio.0.query_2.0.search_query", alert.q2SearchQuery),
-		resource.TestCheckResourceAttr(alertResourceName, "ratio.0.condition.0.more_than", "true"),
-		resource.TestCheckResourceAttr(alertResourceName, "ratio.0.condition.0.ratio_threshold", fmt.Sprintf("%f", alert.ratio)),
-		resource.TestCheckResourceAttr(alertResourceName, "ratio.0.condition.0.time_window", alert.timeWindow),
-		resource.TestCheckResourceAttr(alertResourceName, "ratio.0.condition.0.group_by.0", alert.groupBy[0]),
-		resource.TestCheckResourceAttr(alertResourceName, "ratio.0.condition.0.group_by_q1", "true"),
-		resource.TestCheckResourceAttr(alertResourceName, "ratio.0.condition.0.ignore_infinity", fmt.Sprintf("%t", alert.ignoreInfinity)),
+		resource.TestCheckResourceAttr(alertResourceName, "ratio.query_2.search_query", alert.q2SearchQuery),
+		resource.TestCheckResourceAttr(alertResourceName, "ratio.condition.more_than", "true"),
+		resource.TestCheckResourceAttr(alertResourceName, "ratio.condition.ratio_threshold", fmt.Sprintf("%f", alert.ratio)),
+		resource.TestCheckResourceAttr(alertResourceName, "ratio.condition.time_window", alert.timeWindow),
+		resource.TestCheckResourceAttr(alertResourceName, "ratio.condition.group_by.0", alert.groupBy[0]),
+		resource.TestCheckResourceAttr(alertResourceName, "ratio.condition.group_by_q1", "true"),
+		resource.TestCheckResourceAttr(alertResourceName, "ratio.condition.ignore_infinity", fmt.Sprintf("%t", alert.ignoreInfinity)),
 	)
 
-	checks = appendSeveritiesCheck(checks, alert.alertFilters.severities, "ratio.0.query_2")
+	checks = appendSeveritiesCheck(checks, alert.alertFilters.severities, "ratio.query_2")
 
 	return checks
 }
@@ -467,8 +625,8 @@ func extractRatioAlertChecks(alert ratioAlertTestParams) []resource.TestCheckFun
 func extractNewValueChecks(alert newValueAlertTestParams) []resource.TestCheckFunc {
 	checks := extractCommonChecks(&alert.alertCommonTestParams, "new_value")
 	checks = append(checks,
-		resource.TestCheckResourceAttr(alertResourceName, "new_value.0.condition.0.key_to_track", alert.keyToTrack),
-		resource.TestCheckResourceAttr(alertResourceName, "new_value.0.condition.0.time_window", alert.timeWindow),
+		resource.TestCheckResourceAttr(alertResourceName, "new_value.condition.key_to_track", alert.keyToTrack),
+		resource.TestCheckResourceAttr(alertResourceName, "new_value.condition.time_window", alert.timeWindow),
 	)
 	return checks
 }
@@ -476,11 +634,11 @@ func extractNewValueChecks(alert newValueAlertTestParams) []resource.TestCheckFu
 func extractUniqueCountAlertChecks(alert uniqueCountAlertTestParams) []resource.TestCheckFunc {
 	checks := extractCommonChecks(&alert.alertCommonTestParams, "unique_count")
 	checks = append(checks,
-		resource.TestCheckResourceAttr(alertResourceName, "unique_count.0.condition.0.unique_count_key", alert.uniqueCountKey),
-		resource.TestCheckResourceAttr(alertResourceName, "unique_count.0.condition.0.unique_count_key", alert.uniqueCountKey),
-		resource.TestCheckResourceAttr(alertResourceName, "unique_count.0.condition.0.time_window", alert.timeWindow),
-		resource.TestCheckResourceAttr(alertResourceName, "unique_count.0.condition.0.max_unique_values", strconv.Itoa(alert.maxUniqueValues)),
-		resource.TestCheckResourceAttr(alertResourceName, "unique_count.0.condition.0.max_unique_values_for_group_by", strconv.Itoa(alert.maxUniqueValuesForGroupBy)),
+		resource.TestCheckResourceAttr(alertResourceName, "unique_count.condition.unique_count_key", alert.uniqueCountKey),
+		resource.TestCheckResourceAttr(alertResourceName, "unique_count.condition.unique_count_key", alert.uniqueCountKey),
+		resource.TestCheckResourceAttr(alertResourceName, "unique_count.condition.time_window", alert.timeWindow),
+		resource.TestCheckResourceAttr(alertResourceName, "unique_count.condition.max_unique_values", strconv.Itoa(alert.maxUniqueValues)),
+		resource.TestCheckResourceAttr(alertResourceName, "unique_count.condition.max_unique_values_for_group_by", strconv.Itoa(alert.maxUniqueValuesForGroupBy)),
 	)
 	return checks
 }
@@ -499,10 +657,10 @@ func extractTimeRelativeChecks(alert timeRelativeAlertTestParams) []resource.Tes
 				"notify_on":                   "Triggered_and_resolved",
 				"retriggering_period_minutes": fmt.Sprintf("%d", alert.notifyEveryMin),
 			}),
-		resource.TestCheckResourceAttr(alertResourceName, "time_relative.0.condition.0.ratio_threshold", strconv.Itoa(alert.ratioThreshold)),
-		resource.TestCheckResourceAttr(alertResourceName, "time_relative.0.condition.0.relative_time_window", alert.relativeTimeWindow),
-		resource.TestCheckResourceAttr(alertResourceName, "time_relative.0.condition.0.group_by.0", alert.groupBy[0]),
-		resource.TestCheckResourceAttr(alertResourceName, "time_relative.0.condition.0.ignore_infinity", fmt.Sprintf("%t", alert.ignoreInfinity)),
+		resource.TestCheckResourceAttr(alertResourceName, "time_relative.condition.ratio_threshold", strconv.Itoa(alert.ratioThreshold)),
+		resource.TestCheckResourceAttr(alertResourceName, "time_relative.condition.relative_time_window", alert.relativeTimeWindow),
+		resource.TestCheckResourceAttr(alertResourceName, "time_relative.condition.group_by.0", alert.groupBy[0]),
+		resource.TestCheckResourceAttr(alertResourceName, "time_relative.condition.ignore_infinity", fmt.Sprintf("%t", alert.ignoreInfinity)),
 	)
 
 	return checks
@@ -526,16 +684,16 @@ func extractLuceneMetricChecks(alert metricLuceneAlertTestParams) []resource.Tes
 				"notify_on":                   "Triggered_and_resolved",
 				"retriggering_period_minutes": fmt.Sprintf("%d", alert.notifyEveryMin),
 			}),
-		resource.TestCheckResourceAttr(alertResourceName, "metric.0.lucene.0.search_query", alert.searchQuery),
-		resource.TestCheckResourceAttr(alertResourceName, "metric.0.lucene.0.condition.0.metric_field", alert.metricField),
-		resource.TestCheckResourceAttr(alertResourceName, "metric.0.lucene.0.condition.0.arithmetic_operator", alert.arithmeticOperator),
-		resource.TestCheckResourceAttr(alertResourceName, "metric.0.lucene.0.condition.0.less_than", "true"),
-		resource.TestCheckResourceAttr(alertResourceName, "metric.0.lucene.0.condition.0.threshold", strconv.Itoa(alert.threshold)),
-		resource.TestCheckResourceAttr(alertResourceName, "metric.0.lucene.0.condition.0.arithmetic_operator_modifier", strconv.Itoa(alert.arithmeticOperatorModifier)),
-		resource.TestCheckResourceAttr(alertResourceName, "metric.0.lucene.0.condition.0.sample_threshold_percentage", strconv.Itoa(alert.sampleThresholdPercentage)),
-		resource.TestCheckResourceAttr(alertResourceName, "metric.0.lucene.0.condition.0.time_window", alert.timeWindow),
-		resource.TestCheckResourceAttr(alertResourceName, "metric.0.lucene.0.condition.0.group_by.0", alert.groupBy[0]),
-		resource.TestCheckResourceAttr(alertResourceName, "metric.0.lucene.0.condition.0.manage_undetected_values.0.enable_triggering_on_undetected_values", "false"),
+		resource.TestCheckResourceAttr(alertResourceName, "metric.lucene.search_query", alert.searchQuery),
+		resource.TestCheckResourceAttr(alertResourceName, "metric.lucene.condition.metric_field", alert.metricField),
+		resource.TestCheckResourceAttr(alertResourceName, "metric.lucene.condition.arithmetic_operator", alert.arithmeticOperator),
+		resource.TestCheckResourceAttr(alertResourceName, "metric.lucene.condition.less_than", "true"),
+		resource.TestCheckResourceAttr(alertResourceName, "metric.lucene.condition.threshold", strconv.Itoa(alert.threshold)),
+		resource.TestCheckResourceAttr(alertResourceName, "metric.lucene.condition.arithmetic_operator_modifier", strconv.Itoa(alert.arithmeticOperatorModifier)),
+		resource.TestCheckResourceAttr(alertResourceName, "metric.lucene.condition.sample_threshold_percentage", strconv.Itoa(alert.sampleThresholdPercentage)),
+		resource.TestCheckResourceAttr(alertResourceName, "metric.lucene.condition.time_window", alert.timeWindow),
+		resource.TestCheckResourceAttr(alertResourceName, "metric.lucene.condition.group_by.0", alert.groupBy[0]),
+		resource.TestCheckResourceAttr(alertResourceName, "metric.lucene.condition.manage_undetected_values.enable_triggering_on_undetected_values", "false"),
 	}
 	checks = appendSchedulingChecks(checks, alert.daysOfWeek, alert.activityStarts, alert.activityEnds)
 	return checks
@@ -559,22 +717,34 @@ func extractMetricPromqlAlertChecks(alert metricPromqlAlertTestParams) []resourc
 				"notify_on":                   "Triggered_and_resolved",
 				"retriggering_period_minutes": fmt.Sprintf("%d", alert.notifyEveryMin),
 			}),
-		resource.TestCheckResourceAttr(alertResourceName, "metric.0.promql.0.search_query", "http_requests_total{status!~\"4..\"}"),
-		resource.TestCheckResourceAttr(alertResourceName, "metric.0.promql.0.condition.0.threshold", strconv.Itoa(alert.threshold)),
-		resource.TestCheckResourceAttr(alertResourceName, "metric.0.promql.0.condition.0.sample_threshold_percentage", strconv.Itoa(alert.sampleThresholdPercentage)),
-		resource.TestCheckResourceAttr(alertResourceName, "metric.0.promql.0.condition.0.min_non_null_values_percentage", strconv.Itoa(alert.nonNullPercentage)),
-		resource.TestCheckResourceAttr(alertResourceName, "metric.0.promql.0.condition.0.time_window", alert.timeWindow),
+		resource.TestCheckResourceAttr(alertResourceName, "metric.promql.search_query", "http_requests_total{status!~\"4..\"}"),
+		resource.TestCheckResourceAttr(alertResourceName, "metric.promql.condition.threshold", strconv.Itoa(alert.threshold)),
+		resource.TestCheckResourceAttr(alertResourceName, "metric.promql.condition.sample_threshold_percentage", strconv.Itoa(alert.sampleThresholdPercentage)),
+		resource.TestCheckResourceAttr(alertResourceName, "metric.promql.condition.min_non_null_values_percentage", strconv.Itoa(alert.nonNullPercentage)),
+		resource.TestCheckResourceAttr(alertResourceName, "metric.promql.condition.time_window", alert.timeWindow),
+		resource.TestCheckResourceAttr(alertResourceName, "metric.promql.condition.group_by.#", strconv.Itoa(len(alert.groupBy))),
 	}
-	if alert.condition == "less_than" {
+	switch alert.condition {
+	case "less_than":
 		checks = append(checks,
-			resource.TestCheckResourceAttr(alertResourceName, "metric.0.promql.0.condition.0.less_than", "true"),
-			resource.TestCheckResourceAttr(alertResourceName, "metric.0.promql.0.condition.0.manage_undetected_values.0.enable_triggering_on_undetected_values", "true"),
-			resource.TestCheckResourceAttr(alertResourceName, "metric.0.promql.0.condition.0.manage_undetected_values.0.auto_retire_ratio", "Never"),
+			resource.TestCheckResourceAttr(alertResourceName, "metric.promql.condition.less_than", "true"),
+			resource.TestCheckResourceAttr(alertResourceName, "metric.promql.condition.manage_undetected_values.enable_triggering_on_undetected_values", "true"),
+			resource.TestCheckResourceAttr(alertResourceName, "metric.promql.condition.manage_undetected_values.auto_retire_ratio", "Never"),
 		)
-	} else {
+	case "more_than":
 		checks = append(checks,
-			resource.TestCheckResourceAttr(alertResourceName, "metric.0.promql.0.condition.0.more_than", "true"),
+			resource.TestCheckResourceAttr(alertResourceName, "metric.promql.condition.more_than", "true"),
+			resource.TestCheckResourceAttr(alertResourceName, "metric.promql.condition.evaluation_window", "Rolling"),
 		)
+	case "more_than_usual":
+		checks = append(checks,
+			resource.TestCheckResourceAttr(alertResourceName, "metric.promql.condition.more_than_usual", "true"),
+			resource.TestCheckNoResourceAttr(alertResourceName, "metric.promql.condition.evaluation_window"),
+		)
+	}
+	for i, groupBy := range alert.groupBy {
+		checks = append(checks,
+			resource.TestCheckResourceAttr(alertResourceName, fmt.Sprintf("metric.promql.condition.group_by.%d", i), groupBy))
 	}
 	checks = appendSchedulingChecks(checks, alert.daysOfWeek, alert.activityStarts, alert.activityEnds)
 	return checks
@@ -598,15 +768,15 @@ func extractTracingAlertChecks(alert tracingAlertTestParams) []resource.TestChec
 				"notify_on":                   "Triggered_and_resolved",
 				"retriggering_period_minutes": fmt.Sprintf("%d", alert.notifyEveryMin),
 			}),
-		resource.TestCheckResourceAttr(alertResourceName, "tracing.0.latency_threshold_milliseconds", fmt.Sprintf("%.3f", alert.conditionLatencyMs)),
-		resource.TestCheckResourceAttr(alertResourceName, "tracing.0.condition.0.more_than", "true"),
-		resource.TestCheckResourceAttr(alertResourceName, "tracing.0.condition.0.time_window", alert.timeWindow),
-		resource.TestCheckResourceAttr(alertResourceName, "tracing.0.condition.0.threshold", strconv.Itoa(alert.occurrencesThreshold)),
-		resource.TestCheckResourceAttr(alertResourceName, "tracing.0.applications.0", "nginx"),
-		resource.TestCheckResourceAttr(alertResourceName, "tracing.0.subsystems.0", "subsystem-name"),
-		resource.TestCheckResourceAttr(alertResourceName, "tracing.0.tag_filter.0.field", "Status"),
-		resource.TestCheckTypeSetElemAttr(alertResourceName, "tracing.0.tag_filter.0.values.*", "filter:contains:400"),
-		resource.TestCheckTypeSetElemAttr(alertResourceName, "tracing.0.tag_filter.0.values.*", "500"),
+		resource.TestCheckResourceAttr(alertResourceName, "tracing.latency_threshold_milliseconds", fmt.Sprintf("%.3f", alert.conditionLatencyMs)),
+		resource.TestCheckResourceAttr(alertResourceName, "tracing.condition.more_than", "true"),
+		resource.TestCheckResourceAttr(alertResourceName, "tracing.condition.time_window", alert.timeWindow),
+		resource.TestCheckResourceAttr(alertResourceName, "tracing.condition.threshold", strconv.Itoa(alert.occurrencesThreshold)),
+		resource.TestCheckResourceAttr(alertResourceName, "tracing.applications.0", "nginx"),
+		resource.TestCheckResourceAttr(alertResourceName, "tracing.subsystems.0", "subsystem-name"),
+		resource.TestCheckResourceAttr(alertResourceName, "tracing.tag_filter.0.field", "Status"),
+		resource.TestCheckTypeSetElemAttr(alertResourceName, "tracing.tag_filter.0.values.*", "filter:contains:400"),
+		resource.TestCheckTypeSetElemAttr(alertResourceName, "tracing.tag_filter.0.values.*", "500"),
 	}
 	checks = appendSchedulingChecks(checks, alert.daysOfWeek, alert.activityStarts, alert.activityEnds)
 	return checks
@@ -630,12 +800,12 @@ func extractFlowAlertChecks(alert flowAlertTestParams) []resource.TestCheckFunc
 				"notify_on":                   "Triggered_and_resolved",
 				"retriggering_period_minutes": fmt.Sprintf("%d", alert.notifyEveryMin),
 			}),
-		resource.TestCheckResourceAttr(alertResourceName, "flow.0.stage.0.group.0.sub_alerts.0.operator", "OR"),
-		resource.TestCheckResourceAttr(alertResourceName, "flow.0.stage.0.group.0.next_operator", "OR"),
-		resource.TestCheckResourceAttr(alertResourceName, "flow.0.stage.0.group.1.sub_alerts.0.operator", "AND"),
-		resource.TestCheckResourceAttr(alertResourceName, "flow.0.stage.0.group.1.sub_alerts.0.flow_alert.0.not", "true"),
-		resource.TestCheckResourceAttr(alertResourceName, "flow.0.stage.0.group.1.next_operator", "AND"),
-		resource.TestCheckResourceAttr(alertResourceName, "flow.0.stage.0.time_window.0.minutes", "20"),
+		resource.TestCheckResourceAttr(alertResourceName, "flow.stage.0.group.0.sub_alerts.operator", "OR"),
+		resource.TestCheckResourceAttr(alertResourceName, "flow.stage.0.group.0.next_operator", "OR"),
+		resource.TestCheckResourceAttr(alertResourceName, "flow.stage.0.group.1.sub_alerts.operator", "AND"),
+		resource.TestCheckResourceAttr(alertResourceName, "flow.stage.0.group.1.sub_alerts.flow_alert.0.not", "true"),
+		resource.TestCheckResourceAttr(alertResourceName, "flow.stage.0.group.1.next_operator", "AND"),
+		resource.TestCheckResourceAttr(alertResourceName, "flow.stage.0.time_window.minutes", "20"),
 	}
 	checks = appendSchedulingChecks(checks, alert.daysOfWeek, alert.activityStarts, alert.activityEnds)
 	return checks
@@ -659,7 +829,7 @@ func extractCommonChecks(alert *alertCommonTestParams, alertType string) []resou
 				"notify_on":                   "Triggered_and_resolved",
 				"retriggering_period_minutes": fmt.Sprintf("%d", alert.notifyEveryMin),
 			}),
-		resource.TestCheckResourceAttr(alertResourceName, fmt.Sprintf("%s.0.search_query", alertType), alert.searchQuery),
+		resource.TestCheckResourceAttr(alertResourceName, fmt.Sprintf("%s.search_query", alertType), alert.searchQuery),
 	}
 
 	checks = appendSchedulingChecks(checks, alert.daysOfWeek, alert.activityStarts, alert.activityEnds)
@@ -672,17 +842,17 @@ func extractCommonChecks(alert *alertCommonTestParams, alertType string) []resou
 func appendSeveritiesCheck(checks []resource.TestCheckFunc, severities []string, alertType string) []resource.TestCheckFunc {
 	for _, s := range severities {
 		checks = append(checks,
-			resource.TestCheckTypeSetElemAttr(alertResourceName, fmt.Sprintf("%s.0.severities.*", alertType), s))
+			resource.TestCheckTypeSetElemAttr(alertResourceName, fmt.Sprintf("%s.severities.*", alertType), s))
 	}
 	return checks
 }
 
 func appendSchedulingChecks(checks []resource.TestCheckFunc, daysOfWeek []string, startTime, endTime string) []resource.TestCheckFunc {
 	for _, d := range daysOfWeek {
-		checks = append(checks, resource.TestCheckTypeSetElemAttr(alertResourceName, "scheduling.0.time_frame.0.days_enabled.*", d))
+		checks = append(checks, resource.TestCheckTypeSetElemAttr(alertResourceName, "scheduling.time_frame.0.days_enabled.*", d))
 	}
-	checks = append(checks, resource.TestCheckResourceAttr(alertResourceName, "scheduling.0.time_frame.0.start_time", startTime))
-	checks = append(checks, resource.TestCheckResourceAttr(alertResourceName, "scheduling.0.time_frame.0.end_time", endTime))
+	checks = append(checks, resource.TestCheckResourceAttr(alertResourceName, "scheduling.time_frame.0.start_time", startTime))
+	checks = append(checks, resource.TestCheckResourceAttr(alertResourceName, "scheduling.time_frame.0.end_time", endTime))
 	return checks
 }
 
@@ -713,48 +883,54 @@ func testAccCheckAlertDestroy(s *terraform.State) error {
 
 func testAccCoralogixResourceAlertStandard(a *standardAlertTestParams) string {
 	return fmt.Sprintf(`resource "coralogix_alert" "test" {
-  name               = "%s"
-  description        = "%s"
-  severity           = "%s"
-
-  notifications_group {
-	notification {
-		integration_id       = "%s"
-        retriggering_period_minutes = %d
-	}
-    notification {
-        email_recipients             = %s
-        notify_on          = "Triggered_and_resolved"
-        retriggering_period_minutes   = %d
-    }
+  name        = "%s"
+  description = "%s"
+  severity    = "%s"
+
+  notifications_group = [
+    {
+      notification = [
+        {
+          integration_id              = "%s"
+          retriggering_period_minutes = %d
+        },
+        {
+          email_recipients            = %s
+          notify_on                   = "Triggered_and_resolved"
+          retriggering_period_minutes = %d
+        },
+      ]
+    },
+  ]
+
+  scheduling = {
+    time_zone = "%s"
+    time_frame = [
+      {
+        days_enabled = %s
+        start_time   = "%s"
+        end_time     = "%s"
+      },
+    ]
   }
 
-  scheduling {
-    time_zone =  "%s"
-	time_frame {
-    	days_enabled = %s
-    	start_time = "%s"
-    	end_time = "%s"
-  	}
+  meta_labels = {
+    alert_type        = "security"
+    security_severity = "high"
   }
 
-   meta_labels = {
-   	    alert_type        = "security"
-    	security_severity = "high"
-   }
-
-  standard {
-    severities = %s
+  standard = {
+    severities   = %s
     search_query = "%s"
-    condition {
-	  group_by = %s
-      less_than = true
-      threshold = %d
+    condition = {
+      group_by    = %s
+      less_than   = true
+      threshold   = %d
       time_window = "%s"
-      manage_undetected_values {
-			enable_triggering_on_undetected_values = true
-			auto_retire_ratio = "%s"
-		}
+      manage_undetected_values = {
+        enable_triggering_on_undetected_values = true
+        auto_retire_ratio                      = "%s"
+      }
     }
   }
 }
@@ -764,50 +940,87 @@ func testAccCoralogixResourceAlertStandard(a *standardAlertTestParams) string {
 		sliceToString(a.severities), a.searchQuery, sliceToString(a.groupBy), a.occurrencesThreshold, a.timeWindow, a.deadmanRatio)
 }
 
-func testAccCoralogixResourceAlertRatio(a *ratioAlertTestParams) string {
+// testAccCoralogixResourceAlertWithTimeFrames schedules the alert on weekdays from 08:00 to 20:00, and on the given
+// days from 10:00 to 16:00.
+func testAccCoralogixResourceAlertWithTimeFrames(name, days string) string {
 	return fmt.Sprintf(`resource "coralogix_alert" "test" {
-  name               = "%s"
-  description        = "%s"
-  severity           = "%s"
-
-  notifications_group {
-  	notification {
-        integration_id       = "%s"
-        retriggering_period_minutes = %d
-	}
-	notification {
-		email_recipients             = %s
-        notify_on          = "Triggered_and_resolved"
-        retriggering_period_minutes   = %d
-	}
+  name     = "%s"
+  severity = "Info"
+
+  scheduling = {
+    time_zone = "Europe/Berlin"
+    time_frame = [
+      {
+        days_enabled = ["Monday", "Tuesday", "Wednesday", "Thursday", "Friday"]
+        start_time   = "08:00"
+        end_time     = "20:00"
+      },
+      {
+        days_enabled = %s
+        start_time   = "10:00"
+        end_time     = "16:00"
+      },
+    ]
+  }
+
+  standard = {
+    condition = {
+      immediately = true
+    }
   }
+}
+`, name, days)
+}
 
-  scheduling {
-    time_zone =  "%s"
-	
-	time_frame {
-    	days_enabled = %s
-    	start_time = "%s"
-    	end_time = "%s"
-  	}
+func testAccCoralogixResourceAlertRatio(a *ratioAlertTestParams) string {
+	return fmt.Sprintf(`resource "coralogix_alert" "test" {
+  name        = "%s"
+  description = "%s"
+  severity    = "%s"
+
+  notifications_group = [
+    {
+      notification = [
+        {
+          integration_id              = "%s"
+          retriggering_period_minutes = %d
+        },
+        {
+          email_recipients            = %s
+          notify_on                   = "Triggered_and_resolved"
+          retriggering_period_minutes = %d
+        },
+      ]
+    },
+  ]
+
+  scheduling = {
+    time_zone = "%s"
+    time_frame = [
+      {
+        days_enabled = %s
+        start_time   = "%s"
+        end_time     = "%s"
+      },
+    ]
   }
 
-  ratio {
-    query_1 {
-		severities   = %s
-		search_query = "%s"
+  ratio = {
+    query_1 = {
+      severities   = %s
+      search_query = "%s"
     }
-    query_2 {
+    query_2 = {
       severities   = %s
       search_query = "%s"
     }
-    condition {
-      more_than     = true
+    condition = {
+      more_than       = true
       ratio_threshold = %f
-      time_window   = "%s"
-      group_by      = %s
-      group_by_q1   = true
-	  ignore_infinity = %t
+      time_window     = "%s"
+      group_by        = %s
+      group_by_q1     = true
+      ignore_infinity = %t
     }
   }
 }`,
@@ -819,36 +1032,41 @@ func testAccCoralogixResourceAlertRatio(a *ratioAlertTestParams) string {
 
 func testAccCoralogixResourceAlertNewValue(a *newValueAlertTestParams) string {
 	return fmt.Sprintf(`resource "coralogix_alert" "test" {
-  name               = "%s"
-  description        = "%s"
-  severity           = "%s"
-  
-  notifications_group {
-		notification {
-        	integration_id       = "%s"
-        	retriggering_period_minutes = %d
-		}
-		notification{
-     		email_recipients             = %s
-			notify_on          = "Triggered_and_resolved"
-       	 	retriggering_period_minutes   = %d
-     	}
-	}
-
-  scheduling {
-    time_zone =  "%s"
-	
-	time_frame {
-    	days_enabled = %s
-    	start_time = "%s"
-    	end_time = "%s"
-  	}
+  name        = "%s"
+  description = "%s"
+  severity    = "%s"
+
+  notifications_group = [
+    {
+      notification = [
+        {
+          integration_id              = "%s"
+          retriggering_period_minutes = %d
+        },
+        {
+          email_recipients            = %s
+          notify_on                   = "Triggered_and_resolved"
+          retriggering_period_minutes = %d
+        },
+      ]
+    },
+  ]
+
+  scheduling = {
+    time_zone = "%s"
+    time_frame = [
+      {
+        days_enabled = %s
+        start_time   = "%s"
+        end_time     = "%s"
+      },
+    ]
   }
 
-  new_value {
-    severities = %s
-	search_query = "%s"
-    condition {
+  new_value = {
+    severities   = %s
+    search_query = "%s"
+    condition = {
       key_to_track = "%s"
       time_window  = "%s"
     }
@@ -861,39 +1079,45 @@ func testAccCoralogixResourceAlertNewValue(a *newValueAlertTestParams) string {
 
 func testAccCoralogixResourceAlertUniqueCount(a *uniqueCountAlertTestParams) string {
 	return fmt.Sprintf(`resource "coralogix_alert" "test" {
-  name               = "%s"
-  description        = "%s"
-  severity           = "%s"
-  
-  notifications_group {
-  		group_by_fields = %s
-		notification {
-        	integration_id       = "%s"
-        	retriggering_period_minutes = %d
-		}
-		notification{
-     		email_recipients             = %s
-			notify_on          = "Triggered_and_resolved"
-       	 	retriggering_period_minutes   = %d
-     	}
-	}
-
-  scheduling {
-    time_zone =  "%s"
-	time_frame {
-    	days_enabled = %s
-    	start_time = "%s"
-    	end_time = "%s"
-  	}
+  name        = "%s"
+  description = "%s"
+  severity    = "%s"
+
+  notifications_group = [
+    {
+      group_by_fields = %s
+      notification = [
+        {
+          integration_id              = "%s"
+          retriggering_period_minutes = %d
+        },
+        {
+          email_recipients            = %s
+          notify_on                   = "Triggered_and_resolved"
+          retriggering_period_minutes = %d
+        },
+      ]
+    },
+  ]
+
+  scheduling = {
+    time_zone = "%s"
+    time_frame = [
+      {
+        days_enabled = %s
+        start_time   = "%s"
+        end_time     = "%s"
+      },
+    ]
   }
 
-  unique_count {
-    severities = %s
+  unique_count = {
+    severities   = %s
     search_query = "%s"
-    condition {
-      unique_count_key  = "%s"
-      max_unique_values = %d
-      time_window       = "%s"
+    condition = {
+      unique_count_key               = "%s"
+      max_unique_values              = %d
+      time_window                    = "%s"
       group_by_key                   = "%s"
       max_unique_values_for_group_by = %d
     }
@@ -906,41 +1130,46 @@ func testAccCoralogixResourceAlertUniqueCount(a *uniqueCountAlertTestParams) str
 
 func testAccCoralogixResourceAlertTimeRelative(a *timeRelativeAlertTestParams) string {
 	return fmt.Sprintf(`resource "coralogix_alert" "test" {
-  name               = "%s"
-  description        = "%s"
-  severity           = "%s"
-  
-  notifications_group {
-		notification {
-        	integration_id       = "%s"
-        	retriggering_period_minutes = %d
-		}
-		notification{
-     		email_recipients             = %s
-			notify_on          = "Triggered_and_resolved"
-       	 	retriggering_period_minutes   = %d
-     	}
-	}
-
-  scheduling {
-    time_zone =  "%s"
-	
-	time_frame {
-    	days_enabled = %s
-    	start_time = "%s"
-    	end_time = "%s"
-  	}
+  name        = "%s"
+  description = "%s"
+  severity    = "%s"
+
+  notifications_group = [
+    {
+      notification = [
+        {
+          integration_id              = "%s"
+          retriggering_period_minutes = %d
+        },
+        {
+          email_recipients            = %s
+          notify_on                   = "Triggered_and_resolved"
+          retriggering_period_minutes = %d
+        },
+      ]
+    },
+  ]
+
+  scheduling = {
+    time_zone = "%s"
+    time_frame = [
+      {
+        days_enabled = %s
+        start_time   = "%s"
+        end_time     = "%s"
+      },
+    ]
   }
 
-  time_relative {
-    severities = %s
+  time_relative = {
+    severities   = %s
     search_query = "%s"
-    condition {
+    condition = {
       more_than            = true
       group_by             = %s
       ratio_threshold      = %d
       relative_time_window = "%s"
-      ignore_infinity = %t
+      ignore_infinity      = %t
     }
   }
 }`,
@@ -951,36 +1180,41 @@ func testAccCoralogixResourceAlertTimeRelative(a *timeRelativeAlertTestParams) s
 
 func testAccCoralogixResourceAlertMetricLucene(a *metricLuceneAlertTestParams) string {
 	return fmt.Sprintf(`resource "coralogix_alert" "test" {
-  name               = "%s"
-  description        = "%s"
-  severity           = "%s"
-  
-  notifications_group {
-		notification {
-        	integration_id       = "%s"
-        	retriggering_period_minutes = %d
-		}
-		notification{
-     		email_recipients             = %s
-			notify_on          = "Triggered_and_resolved"
-       	 	retriggering_period_minutes   = %d
-     	}
-	}
-
-  scheduling {
-    time_zone =  "%s"
-	
-	time_frame {
-    	days_enabled = %s
-    	start_time = "%s"
-    	end_time = "%s"
-  	}
+  name        = "%s"
+  description = "%s"
+  severity    = "%s"
+
+  notifications_group = [
+    {
+      notification = [
+        {
+          integration_id              = "%s"
+          retriggering_period_minutes = %d
+        },
+        {
+          email_recipients            = %s
+          notify_on                   = "Triggered_and_resolved"
+          retriggering_period_minutes = %d
+        },
+      ]
+    },
+  ]
+
+  scheduling = {
+    time_zone = "%s"
+    time_frame = [
+      {
+        days_enabled = %s
+        start_time   = "%s"
+        end_time     = "%s"
+      },
+    ]
   }
 
-  metric {
-    lucene {
+  metric = {
+    lucene = {
       search_query = "%s"
-      condition {
+      condition = {
         metric_field                 = "%s"
         arithmetic_operator          = "%s"
         less_than                    = true
@@ -988,10 +1222,10 @@ func testAccCoralogixResourceAlertMetricLucene(a *metricLuceneAlertTestParams) s
         arithmetic_operator_modifier = %d
         sample_threshold_percentage  = %d
         time_window                  = "%s"
-		group_by = %s
-		manage_undetected_values{
-			enable_triggering_on_undetected_values = false
-		}
+        group_by                     = %s
+        manage_undetected_values = {
+          enable_triggering_on_undetected_values = false
+        }
       }
     }
   }
@@ -1003,89 +1237,211 @@ func testAccCoralogixResourceAlertMetricLucene(a *metricLuceneAlertTestParams) s
 
 func testAccCoralogixResourceAlertMetricPromql(a *metricPromqlAlertTestParams) string {
 	return fmt.Sprintf(`resource "coralogix_alert" "test" {
-  name               = "%s"
-  description        = "%s"
-  severity           = "%s"
-  
-  notifications_group {
-		notification {
-        	integration_id       = "%s"
-        	retriggering_period_minutes = %d
-		}
-		notification{
-     		email_recipients             = %s
-			notify_on          = "Triggered_and_resolved"
-       	 	retriggering_period_minutes   = %d
-     	}
-	}
-
-  scheduling {
-    time_zone =  "%s"
-	time_frame {
-    	days_enabled = %s
-    	start_time = "%s"
-    	end_time = "%s"
-  	}
+  name        = "%s"
+  description = "%s"
+  severity    = "%s"
+
+  notifications_group = [
+    {
+      notification = [
+        {
+          integration_id              = "%s"
+          retriggering_period_minutes = %d
+        },
+        {
+          email_recipients            = %s
+          notify_on                   = "Triggered_and_resolved"
+          retriggering_period_minutes = %d
+        },
+      ]
+    },
+  ]
+
+  scheduling = {
+    time_zone = "%s"
+    time_frame = [
+      {
+        days_enabled = %s
+        start_time   = "%s"
+        end_time     = "%s"
+      },
+    ]
   }
 
-  metric {
-    promql {
+  metric = {
+    promql = {
       search_query = "http_requests_total{status!~\"4..\"}"
-      condition {
-        %s                    	     = true
-        threshold                    = %d
-        sample_threshold_percentage  = %d
-        time_window                  = "%s"
+      condition = {
+        %s                             = true
+        threshold                      = %d
+        sample_threshold_percentage    = %d
+        time_window                    = "%s"
         min_non_null_values_percentage = %d
+        group_by                       = %s
       }
     }
   }
 }`,
 		a.name, a.description, a.severity, a.webhookID, a.notifyEveryMin, sliceToString(a.emailRecipients), a.notifyEveryMin, a.timeZone,
 		sliceToString(a.daysOfWeek), a.activityStarts, a.activityEnds, a.condition, a.threshold, a.sampleThresholdPercentage,
-		a.timeWindow, a.nonNullPercentage)
+		a.timeWindow, a.nonNullPercentage, sliceToString(a.groupBy))
 }
 
-func testAccCoralogixResourceAlertTracing(a *tracingAlertTestParams) string {
+func testAccCoralogixResourceAlertWithPromqlQuery(query string) string {
 	return fmt.Sprintf(`resource "coralogix_alert" "test" {
-  name               = "%s"
-  description        = "%s"
-  severity           = "%s"
-  
-	notifications_group {
-		notification {
-        	integration_id       = "%s"
-        	retriggering_period_minutes = %d
-		}
-		notification{
-     		email_recipients             = %s
-			notify_on          = "Triggered_and_resolved"
-       	 	retriggering_period_minutes   = %d
-     	}
-	}
+  name     = "invalid promql"
+  severity = "Info"
+
+  metric = {
+    promql = {
+      search_query = %q
+      condition = {
+        more_than                   = true
+        threshold                   = 3
+        sample_threshold_percentage = 50
+        time_window                 = "12H"
+      }
+    }
+  }
+}
+`, query)
+}
+
+func testAccCoralogixResourceAlertWithLuceneQuery(query string) string {
+	return fmt.Sprintf(`resource "coralogix_alert" "test" {
+  name     = "invalid lucene"
+  severity = "Info"
 
-  scheduling {
-    time_zone =  "%s"
-	time_frame {
-    	days_enabled = %s
-    	start_time = "%s"
-    	end_time = "%s"
-  	}
+  standard = {
+    search_query = %q
+    condition = {
+      immediately = true
+    }
   }
+}
+`, query)
+}
 
-  tracing {
-    latency_threshold_milliseconds = %f
-    applications = ["nginx"]
-    subsystems = ["subsystem-name"]
-	tag_filter {
-      field = "Status"
-      values = ["filter:contains:400", "500"]
+func testAccCoralogixResourceAlertWithNotification(notification string) string {
+	return fmt.Sprintf(`resource "coralogix_alert" "test" {
+  name     = "invalid notification"
+  severity = "Info"
+
+  notifications_group = [
+    {
+      notification = [
+        {
+          %s
+        },
+      ]
+    },
+  ]
+
+  standard = {
+    condition = {
+      immediately = true
     }
+  }
+}
+`, notification)
+}
+
+func testAccCoralogixResourceAlertWithFlowGroups(subAlertID, firstNextOperator, secondNextOperator string) string {
+	return fmt.Sprintf(`resource "coralogix_alert" "standard" {
+  name     = "flow sub-alert"
+  severity = "Info"
+
+  standard = {
+    condition = {
+      immediately = true
+    }
+  }
+}
+
+resource "coralogix_alert" "test" {
+  name     = "invalid flow"
+  severity = "Info"
+
+  flow = {
+    stage = [
+      {
+        group = [
+          {
+            sub_alerts = {
+              operator   = "OR"
+              flow_alert = [{ user_alert_id = %[1]s }]
+            }
+            next_operator = "%[2]s"
+          },
+          {
+            sub_alerts = {
+              operator   = "OR"
+              flow_alert = [{ user_alert_id = %[1]s }]
+            }
+            next_operator = "%[3]s"
+          },
+          {
+            sub_alerts = {
+              operator   = "OR"
+              flow_alert = [{ user_alert_id = %[1]s }]
+            }
+            next_operator = "AND"
+          },
+        ]
+      },
+    ]
+  }
+}
+`, subAlertID, firstNextOperator, secondNextOperator)
+}
+
+func testAccCoralogixResourceAlertTracing(a *tracingAlertTestParams) string {
+	return fmt.Sprintf(`resource "coralogix_alert" "test" {
+  name        = "%s"
+  description = "%s"
+  severity    = "%s"
+
+  notifications_group = [
+    {
+      notification = [
+        {
+          integration_id              = "%s"
+          retriggering_period_minutes = %d
+        },
+        {
+          email_recipients            = %s
+          notify_on                   = "Triggered_and_resolved"
+          retriggering_period_minutes = %d
+        },
+      ]
+    },
+  ]
+
+  scheduling = {
+    time_zone = "%s"
+    time_frame = [
+      {
+        days_enabled = %s
+        start_time   = "%s"
+        end_time     = "%s"
+      },
+    ]
+  }
 
-    condition {
-      more_than             = true
-      time_window           = "%s"
-      threshold = %d
+  tracing = {
+    latency_threshold_milliseconds = %f
+    applications                   = ["nginx"]
+    subsystems                     = ["subsystem-name"]
+    tag_filter = [
+      {
+        field  = "Status"
+        values = ["filter:contains:400", "500"]
+      },
+    ]
+    condition = {
+      more_than   = true
+      time_window = "%s"
+      threshold   = %d
     }
   }
 }`,
@@ -1096,81 +1452,99 @@ func testAccCoralogixResourceAlertTracing(a *tracingAlertTestParams) string {
 
 func testAccCoralogixResourceAlertFLow(a *flowAlertTestParams) string {
 	return fmt.Sprintf(`resource "coralogix_alert" "standard_alert" {
-	name               = "standard"
-	severity           = "Info"
-	standard {
-		condition {
-			immediately = true
-		}
-	}
+  name     = "standard"
+  severity = "Info"
+  standard = {
+    condition = {
+      immediately = true
+    }
+  }
 }
 
-	resource "coralogix_alert" "test" {
-  		name               = "%s"
-  		description        = "%s"
-	  	severity           = "%s"
-		
-	  notifications_group {
-		notification {
-        	integration_id       = "%s"
-        	retriggering_period_minutes = %d
-		}
-		notification{
-     		email_recipients             = %s
-			notify_on          = "Triggered_and_resolved"
-       	 	retriggering_period_minutes   = %d
-     	}
-	}
-
-  	scheduling {
-    	time_zone =  "%s"
-		time_frame {
-    		days_enabled = %s
-    		start_time = "%s"
-			end_time = "%s"
-  		}
-	}
+resource "coralogix_alert" "test" {
+  name        = "%s"
+  description = "%s"
+  severity    = "%s"
+
+  notifications_group = [
+    {
+      notification = [
+        {
+          integration_id              = "%s"
+          retriggering_period_minutes = %d
+        },
+        {
+          email_recipients            = %s
+          notify_on                   = "Triggered_and_resolved"
+          retriggering_period_minutes = %d
+        },
+      ]
+    },
+  ]
+
+  scheduling = {
+    time_zone = "%s"
+    time_frame = [
+      {
+        days_enabled = %s
+        start_time   = "%s"
+        end_time     = "%s"
+      },
+    ]
+  }
 
-  	flow {
-    	stage {
-      		group {
-        		sub_alerts {
-          			operator = "OR"
-          			flow_alert{
-            			user_alert_id = coralogix_alert.standard_alert.id
-          			}
-        		}
-        next_operator = "OR"
-      }
-      group {
-        sub_alerts {
-          operator = "AND"
-          flow_alert{
-            not = true
-            user_alert_id = coralogix_alert.standard_alert.id
-          }
-        }
-        next_operator = "AND"
-      }
-      time_window {
-        minutes = 20
-      }
-    }
-    stage {
-      group {
-        sub_alerts {
-          operator = "AND"
-          flow_alert {
-            user_alert_id = coralogix_alert.standard_alert.id
-          }
-          flow_alert {
-            not = true
-            user_alert_id = coralogix_alert.standard_alert.id
-          }
+  flow = {
+    stage = [
+      {
+        group = [
+          {
+            sub_alerts = {
+              operator = "OR"
+              flow_alert = [
+                {
+                  user_alert_id = coralogix_alert.standard_alert.id
+                },
+              ]
+            }
+            next_operator = "OR"
+          },
+          {
+            sub_alerts = {
+              operator = "AND"
+              flow_alert = [
+                {
+                  not           = true
+                  user_alert_id = coralogix_alert.standard_alert.id
+                },
+              ]
+            }
+            next_operator = "AND"
+          },
+        ]
+        time_window = {
+          minutes = 20
         }
-        next_operator = "OR"
-      }
-    }
+      },
+      {
+        group = [
+          {
+            sub_alerts = {
+              operator = "AND"
+              flow_alert = [
+                {
+                  user_alert_id = coralogix_alert.standard_alert.id
+                },
+                {
+                  not           = true
+                  user_alert_id = coralogix_alert.standard_alert.id
+                },
+              ]
+            }
+            next_operator = "OR"
+          },
+        ]
+      },
+    ]
   }
 }`,
 		a.name, a.description, a.severity, a.webhookID, a.notifyEveryMin, sliceToString(a.emailRecipients), a.notifyEveryMin, a.timeZone,
@@ -1224,6 +1598,7 @@ type metricPromqlAlertTestParams struct {
 	threshold, nonNullPercentage, sampleThresholdPercentage int
 	timeWindow                                              string
 	condition                                               string
+	groupBy                                                 []string
 }
 
 type tracingAlertTestParams struct {
@@ -1275,3 +1650,416 @@ func randHourStr() string {
 		toTwoDigitsFormat(int32(acctest.RandIntRange(0, 24))),
 		toTwoDigitsFormat(int32(acctest.RandIntRange(0, 60))))
 }
+
+func TestAlertRoundTrip(t *testing.T) {
+	fixtures := loadRoundTripFixtures(t, "alert", func() *alertsv1.Alert { return &alertsv1.Alert{} })
+	assertOneofCoverage(t, fixtures, nil)
+
+	for _, name := range sortedFixtureNames(fixtures) {
+		fixture := fixtures[name]
+		t.Run(name, func(t *testing.T) {
+			// The scheduling is shifted to UTC on expand and back to the time zone on flatten. IANA zones use the
+			// current offset both ways.
+			for _, timeZone := range []types.String{types.StringNull(), types.StringValue("UTC+5:30"), types.StringValue("UTC-7"), types.StringValue("America/New_York")} {
+				assertAlertRoundTrip(t, fixture, timeZone)
+			}
+
+			generator := newAlertRoundTripGenerator(fixture)
+			for i := 0; i < roundTripIterations; i++ {
+				alert := generator.variation(fixture).(*alertsv1.Alert)
+				normalizeAlertRoundTripVariation(alert)
+				assertAlertRoundTrip(t, alert, types.StringValue("UTC+5:30"))
+			}
+		})
+	}
+}
+
+func assertAlertRoundTrip(t *testing.T, alert *alertsv1.Alert, timeZone types.String) {
+	t.Helper()
+
+	ctx := context.Background()
+	model, diags := flattenAlert(ctx, alert, timeZone)
+	if diags.HasError() {
+		t.Fatalf("flattenAlert: %v", diags)
+	}
+	model = roundTripFrameworkState(t, NewAlertResource(), model)
+	expanded, diags := extractAlert(ctx, model)
+	if diags.HasError() {
+		t.Fatalf("extractAlert: %v", diags)
+	}
+
+	assertProtoEqual(t, alert, expanded, alertRoundTripCompareOptions()...)
+}
+
+// alertRoundTripCompareOptions ignores the differences between an alert and the alert expanded from its state.
+func alertRoundTripCompareOptions() []cmp.Option {
+	return []cmp.Option{
+		// The id is the API's; the resource's id is the unique identifier.
+		protocmp.IgnoreFields(&alertsv1.Alert{}, "id"),
+		// These lists are sets or maps in the schema.
+		protocmp.SortRepeatedFields(&alertsv1.Alert{}, "meta_labels", "notification_payload_filters"),
+		protocmp.SortRepeatedFields(&alertsv1.AlertActiveTimeframe{}, "days_of_week"),
+		protocmp.SortRepeatedFields(&alertsv1.AlertFilters{}, "severities"),
+		protocmp.SortRepeatedFields(&alertsv1.AlertFilters_MetadataFilters{}, "categories", "applications", "subsystems", "computers", "classes", "methods", "ip_addresses"),
+		protocmp.SortRepeatedFields(&alertsv1.AlertFilters_RatioAlert{}, "severities", "applications", "subsystems"),
+		protocmp.SortRepeatedFields(&alertsv1.Recipients{}, "emails"),
+		protocmp.SortRepeatedFields(&alertsv1.TracingAlert{}, "field_filters"),
+		protocmp.SortRepeatedFields(&alertsv1.FilterData{}, "filters"),
+		protocmp.SortRepeatedFields(&alertsv1.Filters{}, "values"),
+	}
+}
+
+// normalizeAlertRoundTripVariation undoes the variations the schema can't represent.
+func normalizeAlertRoundTripVariation(alert *alertsv1.Alert) {
+	// A ratio alert grouped by both queries has a single group_by in the schema.
+	parameters := alert.GetCondition().GetLessThan().GetParameters()
+	if parameters == nil {
+		parameters = alert.GetCondition().GetMoreThan().GetParameters()
+	}
+	if ratioAlerts := alert.GetFilters().GetRatioAlerts(); len(ratioAlerts) != 0 && len(ratioAlerts[0].GetGroupBy()) != 0 && len(parameters.GetGroupBy()) != 0 {
+		ratioAlerts[0].GroupBy = parameters.GetGroupBy()
+	}
+}
+
+func newAlertRoundTripGenerator(fixture *alertsv1.Alert) *roundTripGenerator {
+	generator := newRoundTripGenerator(1)
+	// The filter type is the alert type, and the deadman and relative time frame are part of the condition's type.
+	generator.fixed[roundTripField(&alertsv1.AlertFilters{}, "filter_type")] = true
+	generator.fixed[roundTripField(&alertsv1.RelatedExtendedData{}, "should_trigger_deadman")] = true
+	generator.fixed[roundTripField(&alertsv1.ConditionParameters{}, "relative_timeframe")] = true
+	// Tracing fields are attributes and operators are prefixes of the values.
+	generator.fixed[roundTripField(&alertsv1.FilterData{}, "field")] = true
+	generator.fixed[roundTripField(&alertsv1.Filters{}, "operator")] = true
+	// Time frames on adjacent days may overlap, which the schema rejects.
+	generator.fixed[roundTripField(&alertsv1.AlertActiveTimeframe{}, "days_of_week")] = true
+
+	generator.generators[roundTripField(&alertsv1.Time{}, "hours")] = func(r *rand.Rand) protoreflect.Value {
+		return protoreflect.ValueOfInt32(int32(r.Intn(24)))
+	}
+	generator.generators[roundTripField(&alertsv1.Time{}, "minutes")] = func(r *rand.Rand) protoreflect.Value {
+		return protoreflect.ValueOfInt32(int32(r.Intn(60)))
+	}
+	// Periods are in minutes and flow time frames in seconds in the schema.
+	retriggeringPeriod := func(r *rand.Rand) protoreflect.Value {
+		return protoreflect.ValueOfMessage(wrapperspb.UInt32(uint32(r.Intn(1000)+1) * 60).ProtoReflect())
+	}
+	generator.generators[roundTripField(&alertsv1.AlertNotification{}, "retriggering_period_seconds")] = retriggeringPeriod
+	generator.generators[roundTripField(&alertsv1.ShowInInsight{}, "retriggering_period_seconds")] = retriggeringPeriod
+	generator.generators[roundTripField(&alertsv1.FlowTimeframe{}, "ms")] = func(r *rand.Rand) protoreflect.Value {
+		return protoreflect.ValueOfMessage(wrapperspb.UInt32(uint32(r.Intn(1000)+1) * 1000).ProtoReflect())
+	}
+
+	filterType := fixture.GetFilters().GetFilterType()
+	switch filterType {
+	case alertsv1.AlertFilters_FILTER_TYPE_TEXT_OR_UNSPECIFIED, alertsv1.AlertFilters_FILTER_TYPE_UNIQUE_COUNT, alertsv1.AlertFilters_FILTER_TYPE_TRACING:
+		// These thresholds are occurrences, which are integers in the schema.
+		generator.generators[roundTripField(&alertsv1.ConditionParameters{}, "threshold")] = func(r *rand.Rand) protoreflect.Value {
+			return protoreflect.ValueOfMessage(wrapperspb.Double(float64(r.Intn(1000) + 1)).ProtoReflect())
+		}
+	}
+
+	// Every alert type supports its own time frames, and the relative ones are pairs of time frames.
+	timeFrames := alertSchemaTimeFrameToProtoTimeFrame
+	switch {
+	case filterType == alertsv1.AlertFilters_FILTER_TYPE_TIME_RELATIVE:
+		generator.fixed[roundTripField(&alertsv1.ConditionParameters{}, "timeframe")] = true
+	case filterType == alertsv1.AlertFilters_FILTER_TYPE_UNIQUE_COUNT:
+		timeFrames = alertSchemaUniqueCountTimeFrameToProtoTimeFrame
+	case filterType == alertsv1.AlertFilters_FILTER_TYPE_METRIC:
+		timeFrames = alertSchemaMetricTimeFrameToMetricProtoTimeFrame
+	case fixture.GetCondition().GetNewValue() != nil:
+		timeFrames = alertSchemaNewValueTimeFrameToProtoTimeFrame
+	}
+	protoTimeFrames := make([]alertsv1.Timeframe, 0, len(timeFrames))
+	for _, timeFrame := range getKeysStrings(timeFrames) {
+		protoTimeFrames = append(protoTimeFrames, alertsv1.Timeframe(alertsv1.Timeframe_value[timeFrames[timeFrame]]))
+	}
+	sort.Slice(protoTimeFrames, func(i, j int) bool { return protoTimeFrames[i] < protoTimeFrames[j] })
+	generator.generators[roundTripField(&alertsv1.ConditionParameters{}, "timeframe")] = func(r *rand.Rand) protoreflect.Value {
+		return protoreflect.ValueOfEnum(protoTimeFrames[r.Intn(len(protoTimeFrames))].Enum().Number())
+	}
+
+	return generator
+}
+
+func TestUpgradeAlertStateV0ToV1(t *testing.T) {
+	fixtures := loadRoundTripFixtures(t, "alert", func() *alertsv1.Alert { return &alertsv1.Alert{} })
+	states := loadStateUpgradeFixtures(t, "alert")
+
+	for _, name := range sortedFixtureNames(states) {
+		rawState := states[name]
+		t.Run(name, func(t *testing.T) {
+			fixture, ok := fixtures[name]
+			if !ok {
+				t.Fatalf("no round-trip fixture named %s", name)
+			}
+
+			ctx := context.Background()
+			state := upgradeFrameworkState(t, NewAlertResource().(*AlertResource), rawState)
+			var model AlertResourceModel
+			if diags := state.Get(ctx, &model); diags.HasError() {
+				t.Fatalf("get state: %v", diags)
+			}
+			expanded, diags := extractAlert(ctx, model)
+			if diags.HasError() {
+				t.Fatalf("extractAlert: %v", diags)
+			}
+
+			want := proto.Clone(fixture).(*alertsv1.Alert)
+			normalizeAlertV0State(name, want)
+			opts := append(alertRoundTripCompareOptions(),
+				// These lists were sets in the SDKv2 schema, so the v0 state doesn't keep their order.
+				protocmp.SortRepeated(func(x, y *alertsv1.AlertNotification) bool { return protoLess(x, y) }),
+				protocmp.SortRepeated(func(x, y *alertsv1.FilterData) bool { return protoLess(x, y) }),
+			)
+			assertProtoEqual(t, want, expanded, opts...)
+		})
+	}
+}
+
+// normalizeAlertV0State removes from a round-trip fixture what the SDKv2 resource didn't store in the state, so
+// it's the alert the upgraded state can hold.
+func normalizeAlertV0State(name string, alert *alertsv1.Alert) {
+	// show_in_insights was always stored, with the API's defaults when the alert has none.
+	if alert.ShowInInsight == nil {
+		alert.ShowInInsight = &alertsv1.ShowInInsight{
+			RetriggeringPeriodSeconds: wrapperspb.UInt32(0),
+			NotifyOn:                  alertsv1.NotifyOn_TRIGGERED_ONLY.Enum(),
+		}
+	}
+
+	parameters := alert.GetCondition().GetMoreThan().GetParameters()
+	if parameters == nil {
+		parameters = alert.GetCondition().GetLessThan().GetParameters()
+	}
+	switch name {
+	case "metric_promql", "metric_promql_less_than":
+		// PromQL conditions had neither a group_by nor an evaluation window.
+		parameters.GroupBy = nil
+		if moreThan := alert.GetCondition().GetMoreThan(); moreThan != nil {
+			moreThan.EvaluationWindow = nil
+		}
+	case "ratio_group_by_q2":
+		// A group_by of the second query only was stored as group_by_q1.
+		parameters.GroupBy = alert.GetFilters().GetRatioAlerts()[0].GetGroupBy()
+		alert.GetFilters().GetRatioAlerts()[0].GroupBy = nil
+	case "time_relative", "time_relative_less_than":
+		// The ratio threshold of time relative conditions was truncated to an integer.
+		parameters.Threshold = wrapperspb.Double(math.Trunc(parameters.GetThreshold().GetValue()))
+	}
+}
+
+// protoLess orders messages by their deterministic wire encoding, to compare lists whose order isn't kept.
+func protoLess(x, y proto.Message) bool {
+	xb, _ := proto.MarshalOptions{Deterministic: true}.Marshal(x)
+	yb, _ := proto.MarshalOptions{Deterministic: true}.Marshal(y)
+	return string(xb) < string(yb)
+}
+
+func TestUpgradeAlertStateV0ToV1Values(t *testing.T) {
+	b, err := os.ReadFile(filepath.Join("testdata", "state_upgrade", "alert", "standard_more_than.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		// mutate changes the v0 state before the upgrade.
+		mutate func(state map[string]interface{})
+		check  func(t *testing.T, model AlertResourceModel)
+	}{
+		{
+			name: "zero scalars",
+			mutate: func(state map[string]interface{}) {
+				state["description"] = ""
+				state["enabled"] = false
+				standard := state["standard"].([]interface{})[0].(map[string]interface{})
+				standard["search_query"] = ""
+				standard["condition"].([]interface{})[0].(map[string]interface{})["group_by_key"] = ""
+			},
+			check: func(t *testing.T, model AlertResourceModel) {
+				// Unset optional values were stored as their zero value, but a computed value is kept.
+				assertAttrValue(t, "description", types.StringNull(), model.Description)
+				assertAttrValue(t, "enabled", types.BoolValue(false), model.Enabled)
+				assertAttrValue(t, "standard.search_query", types.StringNull(), model.Standard.SearchQuery)
+				assertAttrValue(t, "standard.condition.group_by_key", types.StringNull(), model.Standard.Condition.GroupByKey)
+				assertAttrValue(t, "standard.condition.more_than", types.BoolValue(true), model.Standard.Condition.MoreThan)
+			},
+		},
+		{
+			name: "null scalars and missing attributes",
+			mutate: func(state map[string]interface{}) {
+				state["description"] = nil
+				delete(state, "severity")
+				delete(state["standard"].([]interface{})[0].(map[string]interface{}), "search_query")
+			},
+			check: func(t *testing.T, model AlertResourceModel) {
+				assertAttrValue(t, "description", types.StringNull(), model.Description)
+				assertAttrValue(t, "severity", types.StringNull(), model.Severity)
+				assertAttrValue(t, "standard.search_query", types.StringNull(), model.Standard.SearchQuery)
+			},
+		},
+		{
+			name: "empty lists and maps",
+			mutate: func(state map[string]interface{}) {
+				state["meta_labels"] = map[string]interface{}{}
+				state["notifications_group"] = []interface{}{}
+				state["show_in_insights"] = []interface{}{}
+				standard := state["standard"].([]interface{})[0].(map[string]interface{})
+				standard["applications"] = []interface{}{}
+				standard["condition"].([]interface{})[0].(map[string]interface{})["group_by"] = []interface{}{}
+			},
+			check: func(t *testing.T, model AlertResourceModel) {
+				assertAttrValue(t, "meta_labels", types.MapNull(types.StringType), model.MetaLabels)
+				if model.NotificationsGroups != nil {
+					t.Errorf("notifications_group: got %v, want null", model.NotificationsGroups)
+				}
+				if !model.ShowInInsights.IsNull() {
+					t.Errorf("show_in_insights: got %v, want null", model.ShowInInsights)
+				}
+				assertAttrValue(t, "standard.applications", types.SetNull(types.StringType), model.Standard.Applications)
+				assertAttrValue(t, "standard.condition.group_by", types.ListNull(types.StringType), model.Standard.Condition.GroupBy)
+			},
+		},
+		{
+			name: "nested blocks",
+			mutate: func(state map[string]interface{}) {
+				state["expiration_date"] = []interface{}{map[string]interface{}{
+					"day": json.Number("1"), "month": json.Number("2"), "year": json.Number("2030"),
+				}}
+				state["scheduling"] = []interface{}{map[string]interface{}{
+					"time_zone": "UTC+2",
+					"time_frame": []interface{}{map[string]interface{}{
+						"days_enabled": []interface{}{"Monday"}, "start_time": "08:00", "end_time": "20:00",
+					}},
+				}}
+			},
+			check: func(t *testing.T, model AlertResourceModel) {
+				if model.ExpirationDate == nil {
+					t.Fatal("expiration_date: got null")
+				}
+				assertAttrValue(t, "expiration_date.year", types.Int64Value(2030), model.ExpirationDate.Year)
+				if model.Scheduling == nil || len(model.Scheduling.TimeFrames) != 1 {
+					t.Fatalf("scheduling: got %+v, want one time frame", model.Scheduling)
+				}
+				assertAttrValue(t, "scheduling.time_zone", types.StringValue("UTC+2"), model.Scheduling.TimeZone)
+				assertAttrValue(t, "scheduling.time_frame.0.start_time", types.StringValue("08:00"), model.Scheduling.TimeFrames[0].StartTime)
+				notifications := model.NotificationsGroups[0].Notifications
+				if len(notifications) != 2 {
+					t.Fatalf("notifications_group.0.notification: got %d notifications, want 2", len(notifications))
+				}
+				assertAttrValue(t, "notifications_group.0.notification.1.integration_id", types.StringValue("1234"), notifications[1].IntegrationID)
+				assertAttrValue(t, "notifications_group.0.notification.1.email_recipients", types.SetNull(types.StringType), notifications[1].EmailRecipients)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decoder := json.NewDecoder(bytes.NewReader(b))
+			decoder.UseNumber()
+			var state map[string]interface{}
+			if err := decoder.Decode(&state); err != nil {
+				t.Fatal(err)
+			}
+			tt.mutate(state)
+			rawState, err := json.Marshal(state)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			upgraded := upgradeFrameworkState(t, NewAlertResource().(*AlertResource), rawState)
+			var model AlertResourceModel
+			if diags := upgraded.Get(context.Background(), &model); diags.HasError() {
+				t.Fatalf("get state: %v", diags)
+			}
+			tt.check(t, model)
+		})
+	}
+}
+
+// alertSchedulingConfig builds a configuration of the alert schema with only the given scheduling set.
+func alertSchedulingConfig(t *testing.T, scheduling string) tfsdk.Config {
+	t.Helper()
+
+	ctx := context.Background()
+	var schemaResp fwresource.SchemaResponse
+	NewAlertResource().Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	value, err := tftypes.ValueFromJSON([]byte(`{"scheduling": `+scheduling+`}`), schemaResp.Schema.Type().TerraformType(ctx))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tfsdk.Config{Schema: schemaResp.Schema, Raw: value}
+}
+
+func TestAlertValidateConfigScheduling(t *testing.T) {
+	tests := []struct {
+		name       string
+		timeFrames string
+		wantErrors int
+	}{
+		{
+			name:       "disjoint days",
+			timeFrames: `[{"days_enabled": ["Monday", "Friday"], "start_time": "08:00", "end_time": "20:00"}, {"days_enabled": ["Saturday"], "start_time": "08:00", "end_time": "20:00"}]`,
+		},
+		{
+			name:       "adjacent hours",
+			timeFrames: `[{"days_enabled": ["Monday"], "start_time": "08:00", "end_time": "12:00"}, {"days_enabled": ["Monday"], "start_time": "12:00", "end_time": "16:00"}]`,
+		},
+		{
+			name:       "same day",
+			timeFrames: `[{"days_enabled": ["Monday", "Friday"], "start_time": "08:00", "end_time": "20:00"}, {"days_enabled": ["Friday", "Saturday"], "start_time": "10:00", "end_time": "16:00"}]`,
+			wantErrors: 1,
+		},
+		{
+			name:       "across midnight",
+			timeFrames: `[{"days_enabled": ["Sunday"], "start_time": "22:00", "end_time": "02:00"}, {"days_enabled": ["Monday"], "start_time": "01:00", "end_time": "03:00"}]`,
+			wantErrors: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := fwresource.ValidateConfigRequest{Config: alertSchedulingConfig(t, `{"time_zone": "UTC+0", "time_frame": `+tt.timeFrames+`}`)}
+			var resp fwresource.ValidateConfigResponse
+			NewAlertResource().(*AlertResource).ValidateConfig(context.Background(), req, &resp)
+			if got := resp.Diagnostics.ErrorsCount(); got != tt.wantErrors {
+				t.Errorf("got %d errors, want %d: %v", got, tt.wantErrors, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestValidatePlanScheduling(t *testing.T) {
+	berlin := `{"time_zone": "Europe/Berlin", "time_frame": [{"days_enabled": ["Monday"], "start_time": "08:00", "end_time": "20:00"}]}`
+	tests := []struct {
+		name         string
+		plan         string
+		state        string
+		wantWarnings int
+	}{
+		{name: "create", plan: berlin, wantWarnings: 1},
+		{name: "unchanged", plan: berlin, state: berlin},
+		{name: "changed", plan: berlin, state: strings.Replace(berlin, "20:00", "18:00", 1), wantWarnings: 1},
+		{name: "fixed offset", plan: strings.Replace(berlin, "Europe/Berlin", "UTC+1", 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			planConfig := alertSchedulingConfig(t, tt.plan)
+			plan := tfsdk.Plan{Schema: planConfig.Schema, Raw: planConfig.Raw}
+			state := tfsdk.State{Schema: planConfig.Schema, Raw: tftypes.NewValue(planConfig.Raw.Type(), nil)}
+			if tt.state != "" {
+				state.Raw = alertSchedulingConfig(t, tt.state).Raw
+			}
+
+			diags := validatePlanScheduling(context.Background(), plan, state)
+			if diags.HasError() {
+				t.Fatalf("unexpected errors: %v", diags)
+			}
+			if got := diags.WarningsCount(); got != tt.wantWarnings {
+				t.Errorf("got %d warnings, want %d: %v", got, tt.wantWarnings, diags)
+			}
+		})
+	}
+}
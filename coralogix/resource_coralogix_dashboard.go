@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strconv"
 	"time"
 
 	"github.com/gogo/protobuf/jsonpb"
@@ -21,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -61,9 +63,9 @@ var (
 	dashboardProtoAggregationToSchemaAggregation = reverseMapStrings(dashboardSchemaAggregationToProtoAggregation)
 	dashboardValidAggregation                    = getKeysStrings(dashboardSchemaAggregationToProtoAggregation)
 	dashboardSchemaGaugeUnitToProtoGaugeUnit     = map[string]string{
-		"Unspecified": "Gauge_UNIT_UNSPECIFIED",
-		"Number":      "Gauge_UNIT_NUMBER",
-		"Percent":     "Gauge_UNIT_PERCENT",
+		"Unspecified": "UNIT_UNSPECIFIED",
+		"Number":      "UNIT_NUMBER",
+		"Percent":     "UNIT_PERCENT",
 	}
 	dashboardProtoGaugeUnitToSchemaGaugeUnit = reverseMapStrings(dashboardSchemaGaugeUnitToProtoGaugeUnit)
 	dashboardValidGaugeUnit                  = getKeysStrings(dashboardSchemaGaugeUnitToProtoGaugeUnit)
@@ -95,8 +97,16 @@ var (
 		"MIBYTES":      dashboards.Unit_UNIT_MIBYTES,
 		"GIBYTES":      dashboards.Unit_UNIT_GIBYTES,
 	}
-	dashboardProtoToSchemaUnit = ReverseMap(dashboardSchemaToProtoUnit)
-	dashboardValidUnit         = GetKeys(dashboardSchemaToProtoUnit)
+	dashboardProtoToSchemaUnit      = ReverseMap(dashboardSchemaToProtoUnit)
+	dashboardValidUnit              = GetKeys(dashboardSchemaToProtoUnit)
+	dashboardRelativeTimeFrameRegex = regexp.MustCompile(`^(\d+)([smhdw])$`)
+	dashboardRelativeTimeFrameUnits = map[string]time.Duration{
+		"s": time.Second,
+		"m": time.Minute,
+		"h": time.Hour,
+		"d": 24 * time.Hour,
+		"w": 7 * 24 * time.Hour,
+	}
 )
 
 func resourceCoralogixDashboard() *schema.Resource {
@@ -238,8 +248,10 @@ func DashboardSchema() map[string]*schema.Schema {
 						Elem: &schema.Resource{
 							Schema: map[string]*schema.Schema{
 								"id": {
-									Type:     schema.TypeString,
-									Computed: true,
+									Type:        schema.TypeString,
+									Optional:    true,
+									Computed:    true,
+									Description: "Section id. When not set, an id is derived from the dashboard id and the section's position, so editing its rows keeps it.",
 								},
 								"row": {
 									Type:     schema.TypeList,
@@ -247,8 +259,10 @@ func DashboardSchema() map[string]*schema.Schema {
 									Elem: &schema.Resource{
 										Schema: map[string]*schema.Schema{
 											"id": {
-												Type:     schema.TypeString,
-												Computed: true,
+												Type:        schema.TypeString,
+												Optional:    true,
+												Computed:    true,
+												Description: "Row id. When not set, an id is derived from the dashboard id, the row's height and its position among the rows of the same height, so editing its widgets keeps it.",
 											},
 											"appearance": {
 												Type:     schema.TypeList,
@@ -268,8 +282,10 @@ func DashboardSchema() map[string]*schema.Schema {
 												Elem: &schema.Resource{
 													Schema: map[string]*schema.Schema{
 														"id": {
-															Type:     schema.TypeString,
-															Computed: true,
+															Type:        schema.TypeString,
+															Optional:    true,
+															Computed:    true,
+															Description: "Widget id. When not set, an id is derived from the dashboard id and the widget content, so it stays stable when widgets are reordered.",
 														},
 														"title": {
 															Type:     schema.TypeString,
@@ -313,8 +329,9 @@ func DashboardSchema() map[string]*schema.Schema {
 																											Elem: &schema.Resource{
 																												Schema: map[string]*schema.Schema{
 																													"lucene_query": {
-																														Type:     schema.TypeString,
-																														Optional: true,
+																														Type:         schema.TypeString,
+																														Optional:     true,
+																														ValidateFunc: validateLuceneQuery,
 																													},
 																													"group_by": {
 																														Type: schema.TypeList,
@@ -414,8 +431,9 @@ func DashboardSchema() map[string]*schema.Schema {
 																											Elem: &schema.Resource{
 																												Schema: map[string]*schema.Schema{
 																													"promql_query": {
-																														Type:     schema.TypeString,
-																														Required: true,
+																														Type:         schema.TypeString,
+																														Required:     true,
+																														ValidateFunc: validateDashboardPromqlQuery,
 																													},
 																												},
 																											},
@@ -515,8 +533,9 @@ func DashboardSchema() map[string]*schema.Schema {
 																								Elem: &schema.Resource{
 																									Schema: map[string]*schema.Schema{
 																										"lucene_query": {
-																											Type:     schema.TypeString,
-																											Optional: true,
+																											Type:         schema.TypeString,
+																											Optional:     true,
+																											ValidateFunc: validateLuceneQuery,
 																										},
 																										"filter": {
 																											Type: schema.TypeList,
@@ -640,8 +659,9 @@ func DashboardSchema() map[string]*schema.Schema {
 																								Elem: &schema.Resource{
 																									Schema: map[string]*schema.Schema{
 																										"promql_query": {
-																											Type:     schema.TypeString,
-																											Required: true,
+																											Type:         schema.TypeString,
+																											Required:     true,
+																											ValidateFunc: validateDashboardPromqlQuery,
 																										},
 																										"aggregation": {
 																											Type:         schema.TypeString,
@@ -905,11 +925,13 @@ func DashboardSchema() map[string]*schema.Schema {
 			Optional: true,
 		},
 		"relative_time_frame": {
-			Type:          schema.TypeString,
-			Optional:      true,
-			Computed:      true,
-			ValidateFunc:  validation.StringMatch(regexp.MustCompile(`^(\d+)([smhdwMy])$`), "must be a valid relative time frame (e.g. 1h, 30m, 1d, 1w, 1M)"),
-			ConflictsWith: []string{"absolute_time_frame"},
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateFunc:     validation.StringMatch(dashboardRelativeTimeFrameRegex, "must be a valid relative time frame (e.g. 30s, 15m, 1h, 1d, 1w)"),
+			DiffSuppressFunc: suppressEquivalentRelativeTimeFrames,
+			ConflictsWith:    []string{"absolute_time_frame"},
+			Description:      "The default time frame of the dashboard, relative to now. A number followed by one of s (seconds), m (minutes), h (hours), d (days) or w (weeks), e.g. 15m or 1d.",
 		},
 		"absolute_time_frame": {
 			Type:     schema.TypeList,
@@ -918,17 +940,23 @@ func DashboardSchema() map[string]*schema.Schema {
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"start": {
-						Type:         schema.TypeString,
-						Required:     true,
-						ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z$`), "must be a valid date in the format YYYY-MM-DDTHH:MM:SSZ"),
+						Type:             schema.TypeString,
+						Required:         true,
+						ValidateFunc:     validation.IsRFC3339Time,
+						DiffSuppressFunc: suppressEquivalentRFC3339Times,
+						Description:      "The start of the time frame, in RFC3339 format (e.g. 2023-06-01T08:00:00Z).",
 					},
 					"end": {
-						Type:     schema.TypeString,
-						Required: true,
+						Type:             schema.TypeString,
+						Required:         true,
+						ValidateFunc:     validation.IsRFC3339Time,
+						DiffSuppressFunc: suppressEquivalentRFC3339Times,
+						Description:      "The end of the time frame, in RFC3339 format (e.g. 2023-06-01T10:00:00Z). Must be after start.",
 					},
 				},
 			},
 			ConflictsWith: []string{"relative_time_frame"},
+			Description:   "A fixed default time frame of the dashboard.",
 		},
 		"content_json": {
 			Type:             schema.TypeString,
@@ -936,7 +964,7 @@ func DashboardSchema() map[string]*schema.Schema {
 			ConflictsWith:    []string{"layout", "name", "layout", "variable", "filter", "relative_time_frame", "absolute_time_frame"},
 			ValidateDiagFunc: dashboardContentJsonValidationFunc(),
 			Description:      "an option to set the dashboard content from a json file.",
-			DiffSuppressFunc: SuppressEquivalentJSONDiffs,
+			DiffSuppressFunc: suppressEquivalentDashboardContentJson,
 		},
 	}
 }
@@ -944,9 +972,14 @@ func DashboardSchema() map[string]*schema.Schema {
 func extractDashboard(d *schema.ResourceData) (*dashboards.Dashboard, diag.Diagnostics) {
 	if contentJson, ok := d.GetOk("content_json"); ok {
 		dashboard := new(dashboards.Dashboard)
-		err := protojson.Unmarshal([]byte(contentJson.(string)), dashboard)
-		diags := diag.FromErr(err)
-		return dashboard, diags
+		if err := protojson.Unmarshal([]byte(contentJson.(string)), dashboard); err != nil {
+			return nil, diag.FromErr(err)
+		}
+		if dashboard.GetId().GetValue() == "" {
+			dashboard.Id = wrapperspb.String(expandUUID(d.Id()))
+		}
+		fillDashboardLayoutIDs(dashboard)
+		return dashboard, nil
 	}
 
 	id := wrapperspb.String(expandUUID(d.Id()))
@@ -967,28 +1000,116 @@ func extractDashboard(d *schema.ResourceData) (*dashboards.Dashboard, diag.Diagn
 		Filters:     filters,
 	}
 
-	expandDashboardTimeFrame(dashboard, d)
+	diags = append(diags, expandDashboardTimeFrame(dashboard, d)...)
+	fillDashboardLayoutIDs(dashboard)
 
 	return dashboard, diags
 }
 
-func expandDashboardTimeFrame(dashboard *dashboards.Dashboard, d *schema.ResourceData) {
+// fillDashboardLayoutIDs sets an id on every section, row and widget that has none.
+// The ids are derived from the dashboard id and the element's own fields (see deriveDashboardLayoutID), so applying
+// the same configuration twice sends the same ids instead of fresh random ones, reordering widgets keeps their ids,
+// and editing a widget doesn't change the ids of its row and section.
+func fillDashboardLayoutIDs(dashboard *dashboards.Dashboard) {
+	occurrences := make(map[string]int)
+	for _, section := range dashboard.GetLayout().GetSections() {
+		if section.GetId().GetValue() == "" {
+			section.Id = &dashboards.UUID{Value: deriveDashboardLayoutID(dashboard.GetId().GetValue(), section, occurrences)}
+		}
+		for _, row := range section.GetRows() {
+			if row.GetId().GetValue() == "" {
+				row.Id = &dashboards.UUID{Value: deriveDashboardLayoutID(dashboard.GetId().GetValue(), row, occurrences)}
+			}
+			for _, widget := range row.GetWidgets() {
+				if widget.GetId().GetValue() == "" {
+					widget.Id = &dashboards.UUID{Value: deriveDashboardLayoutID(dashboard.GetId().GetValue(), widget, occurrences)}
+				}
+			}
+		}
+	}
+}
+
+// deriveDashboardLayoutID derives the id of a section, row or widget from the dashboard id and the element's own
+// fields, without its ids and the elements nested in it. Identical elements are told apart by the number of identical
+// elements before them, which is counted in occurrences.
+func deriveDashboardLayoutID(dashboardID string, element proto.Message, occurrences map[string]int) string {
+	content := proto.Clone(element)
+	switch e := content.(type) {
+	case *dashboards.Section:
+		e.Id, e.Rows = nil, nil
+	case *dashboards.Row:
+		e.Id, e.Widgets = nil, nil
+	case *dashboards.Widget:
+		clearDashboardWidgetIDs(e)
+	}
+	// Marshalling a message that was unmarshalled or built from the schema doesn't fail.
+	contentJson, _ := canonicalProtoJson(content)
+
+	seed := fmt.Sprintf("%s/%s/%s", dashboardID, content.ProtoReflect().Descriptor().Name(), contentJson)
+	occurrence := occurrences[seed]
+	occurrences[seed]++
+	return dashboardIDFromSeed(fmt.Sprintf("%s/%d", seed, occurrence))
+}
+
+func expandDashboardTimeFrame(dashboard *dashboards.Dashboard, d *schema.ResourceData) diag.Diagnostics {
 	if val, ok := d.GetOk("absolute_time_frame"); ok && val != nil {
-		absoluteTimeFrame := val.([]interface{})[0].(map[string]interface{})
-		start, _ := time.Parse(time.RFC3339, absoluteTimeFrame["start"].(string))
-		end, _ := time.Parse(time.RFC3339, absoluteTimeFrame["end"].(string))
+		absoluteTimeFrame, err := expandDashboardAbsoluteTimeFrame(val.([]interface{})[0])
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		dashboard.TimeFrame = &dashboards.Dashboard_AbsoluteTimeFrame{
-			AbsoluteTimeFrame: &dashboards.TimeFrame{
-				From: timestamppb.New(start),
-				To:   timestamppb.New(end),
-			},
+			AbsoluteTimeFrame: absoluteTimeFrame,
 		}
 	} else if val, ok := d.GetOk("relative_time_frame"); ok && val != nil {
-		relativeTimeFrame, _ := time.ParseDuration(val.(string))
+		relativeTimeFrame, err := expandDashboardRelativeTimeFrame(val.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		dashboard.TimeFrame = &dashboards.Dashboard_RelativeTimeFrame{
 			RelativeTimeFrame: durationpb.New(relativeTimeFrame),
 		}
 	}
+
+	return nil
+}
+
+func expandDashboardAbsoluteTimeFrame(v interface{}) (*dashboards.TimeFrame, error) {
+	m := v.(map[string]interface{})
+	start, err := time.Parse(time.RFC3339, m["start"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("absolute_time_frame.start is not a valid RFC3339 time - %s", err)
+	}
+	end, err := time.Parse(time.RFC3339, m["end"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("absolute_time_frame.end is not a valid RFC3339 time - %s", err)
+	}
+	if !end.After(start) {
+		return nil, fmt.Errorf("absolute_time_frame.end (%s) must be after absolute_time_frame.start (%s)", m["end"], m["start"])
+	}
+
+	return &dashboards.TimeFrame{
+		From: timestamppb.New(start),
+		To:   timestamppb.New(end),
+	}, nil
+}
+
+func expandDashboardRelativeTimeFrame(s string) (time.Duration, error) {
+	match := dashboardRelativeTimeFrameRegex.FindStringSubmatch(s)
+	if match == nil {
+		return 0, fmt.Errorf("%q is not a valid relative time frame (e.g. 30s, 15m, 1h, 1d, 1w)", s)
+	}
+	amount, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(amount) * dashboardRelativeTimeFrameUnits[match[2]], nil
+}
+
+func expandDashboardElementUUID(v interface{}) *dashboards.UUID {
+	if v == nil || v.(string) == "" {
+		return nil
+	}
+	return &dashboards.UUID{Value: v.(string)}
 }
 
 func expandUUID(v interface{}) string {
@@ -1172,7 +1293,7 @@ func expandSections(v interface{}) ([]*dashboards.Section, diag.Diagnostics) {
 
 func expandSection(v interface{}) (*dashboards.Section, diag.Diagnostics) {
 	m := v.(map[string]interface{})
-	uuid := &dashboards.UUID{Value: expandUUID(m["id"])}
+	uuid := expandDashboardElementUUID(m["id"])
 	rows, diags := expandRows(m["row"])
 	return &dashboards.Section{
 		Id:   uuid,
@@ -1199,7 +1320,7 @@ func expandRows(v interface{}) ([]*dashboards.Row, diag.Diagnostics) {
 
 func expandRow(v interface{}) (*dashboards.Row, diag.Diagnostics) {
 	m := v.(map[string]interface{})
-	uuid := &dashboards.UUID{Value: expandUUID(m["id"])}
+	uuid := expandDashboardElementUUID(m["id"])
 	appearance := expandRowAppearance(m["appearance"])
 	widgets, diags := expandWidgets(m["widget"])
 	return &dashboards.Row{
@@ -1245,7 +1366,7 @@ func expandWidgets(v interface{}) ([]*dashboards.Widget, diag.Diagnostics) {
 
 func expandWidget(v interface{}) (*dashboards.Widget, error) {
 	m := v.(map[string]interface{})
-	id := &dashboards.UUID{Value: expandUUID(m["id"])}
+	id := expandDashboardElementUUID(m["id"])
 	title := wrapperspb.String(m["title"].(string))
 	description := wrapperspb.String(m["description"].(string))
 	definition, err := expandWidgetDefinition(m["definition"])
@@ -1854,10 +1975,14 @@ func expandVariable(v interface{}) (*dashboards.Variable, diag.Diagnostics) {
 	m := v.(map[string]interface{})
 	name := wrapperspb.String(m["name"].(string))
 	definition, diags := expandVariableDefinition(m["definition"])
-	return &dashboards.Variable{
+	variable := &dashboards.Variable{
 		Name:       name,
 		Definition: definition,
-	}, diags
+	}
+	if displayName := m["display_name"].(string); displayName != "" {
+		variable.DisplayName = wrapperspb.String(displayName)
+	}
+	return variable, diags
 }
 
 func expandVariableDefinition(v interface{}) (*dashboards.Variable_Definition, diag.Diagnostics) {
@@ -1983,12 +2108,16 @@ func expandSource(v interface{}) (*dashboards.MultiSelect_Source, diag.Diagnosti
 }
 
 func setDashboard(d *schema.ResourceData, dashboard *dashboards.Dashboard) diag.Diagnostics {
-	if _, ok := d.GetOk("content_json"); ok {
+	if currentContentJson, ok := d.GetOk("content_json"); ok {
 		contentJson, err := protojson.Marshal(dashboard)
 		if err != nil {
 			return diag.FromErr(err)
 		}
 
+		if dashboardContentJsonEqual(currentContentJson.(string), string(contentJson), d.Id()) {
+			return nil
+		}
+
 		if err = d.Set("content_json", string(contentJson)); err != nil {
 			return diag.FromErr(err)
 		}
@@ -2026,31 +2155,70 @@ func setDashboard(d *schema.ResourceData, dashboard *dashboards.Dashboard) diag.
 func setDashboardTimeFrame(d *schema.ResourceData, dashboard *dashboards.Dashboard) error {
 	switch timeFrame := dashboard.TimeFrame.(type) {
 	case *dashboards.Dashboard_AbsoluteTimeFrame:
-		absoluteTimeFrame := timeFrame.AbsoluteTimeFrame
-		absoluteTimeFrameMap := make(map[string]interface{})
-		absoluteTimeFrameMap["start"] = absoluteTimeFrame.GetFrom().String()
-		absoluteTimeFrameMap["end"] = absoluteTimeFrame.GetTo().String()
-		if err := d.Set("absolut_time_frame", []interface{}{absoluteTimeFrameMap}); err != nil {
+		absoluteTimeFrame := flattenDashboardAbsoluteTimeFrame(timeFrame.AbsoluteTimeFrame)
+		if err := d.Set("absolute_time_frame", absoluteTimeFrame); err != nil {
 			return err
 		}
-	case *dashboards.Dashboard_RelativeTimeFrame:
-		relativeTimeFrame := timeFrame.RelativeTimeFrame.String()
-		if planedRelativeTimeFrame, ok := d.GetOk("relative_time_frame"); ok && planedRelativeTimeFrame.(string) != "" {
-			planedRelativeTimeFrameDuration, _ := time.ParseDuration(planedRelativeTimeFrame.(string))
-			actualRelativeTimeFrameDuration, _ := time.ParseDuration(relativeTimeFrame)
-			if planedRelativeTimeFrameDuration == actualRelativeTimeFrameDuration {
-				relativeTimeFrame = planedRelativeTimeFrame.(string)
-			}
+		if err := d.Set("relative_time_frame", ""); err != nil {
+			return err
 		}
-
+	case *dashboards.Dashboard_RelativeTimeFrame:
+		relativeTimeFrame := flattenDashboardRelativeTimeFrame(timeFrame.RelativeTimeFrame.AsDuration())
 		if err := d.Set("relative_time_frame", relativeTimeFrame); err != nil {
 			return err
 		}
+		if err := d.Set("absolute_time_frame", nil); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
+func flattenDashboardAbsoluteTimeFrame(timeFrame *dashboards.TimeFrame) interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"start": timeFrame.GetFrom().AsTime().Format(time.RFC3339),
+			"end":   timeFrame.GetTo().AsTime().Format(time.RFC3339),
+		},
+	}
+}
+
+// flattenDashboardRelativeTimeFrame returns the relative time frame in the largest unit that represents it exactly.
+func flattenDashboardRelativeTimeFrame(duration time.Duration) string {
+	for _, unit := range []string{"w", "d", "h", "m"} {
+		unitDuration := dashboardRelativeTimeFrameUnits[unit]
+		if duration >= unitDuration && duration%unitDuration == 0 {
+			return fmt.Sprintf("%d%s", duration/unitDuration, unit)
+		}
+	}
+	return fmt.Sprintf("%ds", duration/time.Second)
+}
+
+func suppressEquivalentRelativeTimeFrames(_, old, new string, _ *schema.ResourceData) bool {
+	oldDuration, err := expandDashboardRelativeTimeFrame(old)
+	if err != nil {
+		return false
+	}
+	newDuration, err := expandDashboardRelativeTimeFrame(new)
+	if err != nil {
+		return false
+	}
+	return oldDuration == newDuration
+}
+
+func suppressEquivalentRFC3339Times(_, old, new string, _ *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
+
 func flattenLayout(layout *dashboards.Layout) interface{} {
 	sections := flattenSections(layout.GetSections())
 	return []interface{}{
@@ -2390,6 +2558,10 @@ func flattenLineChartMetricsQuery(metrics *dashboards.LineChart_MetricsQuery) in
 }
 
 func flattenLegend(legend *dashboards.Legend) interface{} {
+	if legend == nil {
+		return nil
+	}
+
 	isVisible := legend.IsVisible.GetValue()
 	columns := flattenLegendColumns(legend.GetColumns())
 	return []interface{}{
@@ -2544,6 +2716,10 @@ func flattenSelection(selection *dashboards.Filter_Selection) interface{} {
 }
 
 func flattenWidgetAppearance(appearance *dashboards.Widget_Appearance) interface{} {
+	if appearance == nil {
+		return nil
+	}
+
 	return []interface{}{
 		map[string]interface{}{
 			"width": appearance.GetWidth().GetValue(),
@@ -2553,10 +2729,12 @@ func flattenWidgetAppearance(appearance *dashboards.Widget_Appearance) interface
 
 func flattenVariable(variable *dashboards.Variable) interface{} {
 	name := variable.GetName().GetValue()
+	displayName := variable.GetDisplayName().GetValue()
 	definition := flattenVariableDefinition(variable.GetDefinition())
 	return map[string]interface{}{
-		"name":       name,
-		"definition": definition,
+		"name":         name,
+		"display_name": displayName,
+		"definition":   definition,
 	}
 }
 
@@ -2580,20 +2758,18 @@ func flattenVariableDefinition(definition *dashboards.Variable_Definition) inter
 }
 
 func flattenConstant(constant *dashboards.Constant) interface{} {
-	return []interface{}{
-		map[string]interface{}{
-			"value": constant.GetValue().GetValue(),
-		},
-	}
+	return constant.GetValue().GetValue()
 }
 
 func flattenMultiSelect(multiSelect *dashboards.MultiSelect) interface{} {
 	selection := flattenMultiSelectSelection(multiSelect.GetSelection())
 	source := flattenMultiSelectSource(multiSelect.GetSource())
+	valuesOrderDirection := dashboardProtoOrderDirectionToSchemaOrderDirection[multiSelect.GetValuesOrderDirection().String()]
 	return []interface{}{
 		map[string]interface{}{
-			"selection": selection,
-			"source":    source,
+			"selection":              selection,
+			"source":                 source,
+			"values_order_direction": valuesOrderDirection,
 		},
 	}
 }
@@ -2602,9 +2778,8 @@ func flattenMultiSelectSource(source *dashboards.MultiSelect_Source) interface{}
 	var sourceMap map[string]interface{}
 	switch sourceValue := source.GetValue().(type) {
 	case *dashboards.MultiSelect_Source_LogsPath:
-		logsPath := flattenLogPathSource(sourceValue.LogsPath)
 		sourceMap = map[string]interface{}{
-			"log_path": logsPath,
+			"logs_path": sourceValue.LogsPath.GetValue().GetValue(),
 		}
 	case *dashboards.MultiSelect_Source_MetricLabel:
 		metricLabel := flattenMetricLabelSource(sourceValue.MetricLabel)
@@ -2642,15 +2817,6 @@ func flattenMultiSelectSelection(selection *dashboards.MultiSelect_Selection) in
 	return nil
 }
 
-func flattenLogPathSource(logPath *dashboards.MultiSelect_LogsPathSource) interface{} {
-	value := logPath.GetValue().GetValue()
-	return []interface{}{
-		map[string]interface{}{
-			"value": value,
-		},
-	}
-}
-
 func flattenMetricLabelSource(metricLabel *dashboards.MultiSelect_MetricLabelSource) interface{} {
 	metricName := metricLabel.GetMetricName().GetValue()
 	label := metricLabel.GetLabel().GetValue()
@@ -2703,6 +2869,52 @@ func flattenLogsFilterOperator(operator *dashboards.Filter_LogsFilter_Operator)
 	}
 }
 
+func suppressEquivalentDashboardContentJson(_, old, new string, d *schema.ResourceData) bool {
+	return dashboardContentJsonEqual(old, new, d.Id())
+}
+
+// dashboardContentJsonEqual compares two dashboards json semantically.
+// Ids that are missing are filled the way extractDashboard fills them, the dashboard id with dashboardID and the
+// layout ids from the content, so only explicit id changes are reported. Line chart query ids are generated by the
+// server, so they are ignored.
+func dashboardContentJsonEqual(s1, s2, dashboardID string) bool {
+	if JSONStringsEqual(s1, s2) {
+		return true
+	}
+
+	d1, d2 := new(dashboards.Dashboard), new(dashboards.Dashboard)
+	if err := protojson.Unmarshal([]byte(s1), d1); err != nil {
+		return false
+	}
+	if err := protojson.Unmarshal([]byte(s2), d2); err != nil {
+		return false
+	}
+	for _, dashboard := range []*dashboards.Dashboard{d1, d2} {
+		if dashboard.GetId().GetValue() == "" && dashboardID != "" {
+			dashboard.Id = wrapperspb.String(dashboardID)
+		}
+		fillDashboardLayoutIDs(dashboard)
+		for _, section := range dashboard.GetLayout().GetSections() {
+			for _, row := range section.GetRows() {
+				for _, widget := range row.GetWidgets() {
+					for _, queryDefinition := range widget.GetDefinition().GetLineChart().GetQueryDefinitions() {
+						queryDefinition.Id = nil
+					}
+				}
+			}
+		}
+	}
+
+	return proto.Equal(d1, d2)
+}
+
+func clearDashboardWidgetIDs(widget *dashboards.Widget) {
+	widget.Id = nil
+	for _, queryDefinition := range widget.GetDefinition().GetLineChart().GetQueryDefinitions() {
+		queryDefinition.Id = nil
+	}
+}
+
 func dashboardContentJsonValidationFunc() schema.SchemaValidateDiagFunc {
 	return func(v interface{}, _ cty.Path) diag.Diagnostics {
 		err := protojson.Unmarshal([]byte(v.(string)), &dashboards.Dashboard{})
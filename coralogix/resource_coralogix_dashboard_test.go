@@ -3,10 +3,16 @@ package coralogix
 import (
 	"context"
 	"fmt"
+	"math/rand"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	"terraform-provider-coralogix/coralogix/clientset"
 	dashboard "terraform-provider-coralogix/coralogix/clientset/grpc/coralogix-dashboards/v1"
@@ -109,6 +115,69 @@ func TestAccCoralogixResourceDashboardFromJson(t *testing.T) {
 	})
 }
 
+func TestAccCoralogixResourceDashboardFromJsonWithoutLayoutIDs(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDashboardDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoralogixResourceDashboardFromJsonWithoutLayoutIDs(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dashboardResourceName, "id"),
+				),
+			},
+			{
+				Config:   testAccCoralogixResourceDashboardFromJsonWithoutLayoutIDs(),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccCoralogixResourceDashboardTimeFrames(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDashboardDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoralogixResourceDashboardWithTimeFrame(`relative_time_frame = "1d"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dashboardResourceName, "relative_time_frame", "1d"),
+					resource.TestCheckResourceAttr(dashboardResourceName, "absolute_time_frame.#", "0"),
+				),
+			},
+			{
+				Config:   testAccCoralogixResourceDashboardWithTimeFrame(`relative_time_frame = "24h"`),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccCoralogixResourceDashboardWithTimeFrame(`absolute_time_frame {
+    start = "2023-06-01T08:00:00Z"
+    end   = "2023-06-01T10:30:00Z"
+  }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dashboardResourceName, "absolute_time_frame.0.start", "2023-06-01T08:00:00Z"),
+					resource.TestCheckResourceAttr(dashboardResourceName, "absolute_time_frame.0.end", "2023-06-01T10:30:00Z"),
+				),
+			},
+			{
+				Config: testAccCoralogixResourceDashboardWithTimeFrame(`absolute_time_frame {
+    start = "2023-06-01T10:00:00+02:00"
+    end   = "2023-06-01T12:30:00+02:00"
+  }`),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      dashboardResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckDashboardDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*clientset.ClientSet).Dashboards()
 
@@ -291,3 +360,279 @@ func testAccCoralogixResourceDashboardFromJson(jsonFilePath string) string {
 	}
 `, jsonFilePath)
 }
+
+func testAccCoralogixResourceDashboardWithTimeFrame(timeFrame string) string {
+	return fmt.Sprintf(`resource "coralogix_dashboard" test {
+  name = "time frames"
+  layout {
+    section {
+      row {
+        appearance {
+          height = 19
+        }
+        widget {
+          title = "status 4XX"
+          definition {
+            line_chart {
+              query_definition {
+                query {
+                  metrics {
+                    promql_query = "http_requests_total{status!~\"4..\"}"
+                  }
+                }
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+  %s
+}
+`, timeFrame)
+}
+
+func testAccCoralogixResourceDashboardFromJsonWithoutLayoutIDs() string {
+	return `resource "coralogix_dashboard" test {
+  content_json = jsonencode({
+    name = "no layout ids"
+    layout = {
+      sections = [{
+        rows = [{
+          appearance = { height = 19 }
+          widgets = [{
+            title = "status 4XX"
+            definition = {
+              lineChart = {
+                queryDefinitions = [{
+                  query = { metrics = { promqlQuery = { value = "http_requests_total" } } }
+                }]
+              }
+            }
+          }]
+        }]
+      }]
+    }
+  })
+}
+`
+}
+
+func TestDashboardRoundTrip(t *testing.T) {
+	fixtures := loadRoundTripFixtures(t, "dashboard", func() *dashboard.Dashboard { return &dashboard.Dashboard{} })
+	assertOneofCoverage(t, fixtures, map[string]string{
+		"com.coralogixapis.dashboards.v1.ast.Filter.Source.metrics":                     "the schema has only logs filters",
+		"com.coralogixapis.dashboards.v1.ast.Filter.Source.spans":                       "the schema has only logs filters",
+		"com.coralogixapis.dashboards.v1.ast.Filter.MetricsFilter.Operator.equals":      "the schema has only logs filters",
+		"com.coralogixapis.dashboards.v1.ast.Filter.SpansFilter.Operator.equals":        "the schema has only logs filters",
+		"com.coralogixapis.dashboards.v1.ast.MultiSelect.Source.span_field":             "the schema has no span field source",
+		"com.coralogixapis.dashboards.v1.ast.Widget.Definition.bar_chart":               "the schema has no bar chart widget",
+		"com.coralogixapis.dashboards.v1.ast.Widget.Definition.pie_chart":               "the schema has no pie chart widget",
+		"com.coralogixapis.dashboards.v1.ast.widgets.BarChart.ColorsBy.group_by":        "the schema has no bar chart widget",
+		"com.coralogixapis.dashboards.v1.ast.widgets.BarChart.ColorsBy.stack":           "the schema has no bar chart widget",
+		"com.coralogixapis.dashboards.v1.ast.widgets.BarChart.Query.logs":               "the schema has no bar chart widget",
+		"com.coralogixapis.dashboards.v1.ast.widgets.BarChart.Query.metrics":            "the schema has no bar chart widget",
+		"com.coralogixapis.dashboards.v1.ast.widgets.BarChart.Query.spans":              "the schema has no bar chart widget",
+		"com.coralogixapis.dashboards.v1.ast.widgets.BarChart.XAxis.time":               "the schema has no bar chart widget",
+		"com.coralogixapis.dashboards.v1.ast.widgets.BarChart.XAxis.value":              "the schema has no bar chart widget",
+		"com.coralogixapis.dashboards.v1.ast.widgets.PieChart.Query.logs":               "the schema has no pie chart widget",
+		"com.coralogixapis.dashboards.v1.ast.widgets.PieChart.Query.metrics":            "the schema has no pie chart widget",
+		"com.coralogixapis.dashboards.v1.ast.widgets.PieChart.Query.spans":              "the schema has no pie chart widget",
+		"com.coralogixapis.dashboards.v1.ast.widgets.DataTable.Query.metrics":           "data tables have only logs queries in the schema",
+		"com.coralogixapis.dashboards.v1.ast.widgets.DataTable.Query.spans":             "data tables have only logs queries in the schema",
+		"com.coralogixapis.dashboards.v1.ast.widgets.Gauge.Query.logs":                  "gauges have only metrics queries in the schema",
+		"com.coralogixapis.dashboards.v1.ast.widgets.Gauge.Query.spans":                 "gauges have only metrics queries in the schema",
+		"com.coralogixapis.dashboards.v1.ast.widgets.LineChart.Query.spans":             "line charts have no spans queries in the schema",
+		"com.coralogixapis.dashboards.v1.common.SpanField.metadata_field":               "the schema has no spans queries",
+		"com.coralogixapis.dashboards.v1.common.SpanField.process_tag_field":            "the schema has no spans queries",
+		"com.coralogixapis.dashboards.v1.common.SpanField.tag_field":                    "the schema has no spans queries",
+		"com.coralogixapis.dashboards.v1.common.SpansAggregation.dimension_aggregation": "the schema has no spans queries",
+		"com.coralogixapis.dashboards.v1.common.SpansAggregation.metric_aggregation":    "the schema has no spans queries",
+	})
+
+	for _, name := range sortedFixtureNames(fixtures) {
+		fixture := fixtures[name]
+		t.Run(name, func(t *testing.T) {
+			assertDashboardRoundTrip(t, fixture)
+
+			generator := newDashboardRoundTripGenerator()
+			for i := 0; i < roundTripIterations; i++ {
+				assertDashboardRoundTrip(t, generator.variation(fixture).(*dashboard.Dashboard))
+			}
+		})
+	}
+}
+
+func TestSetDashboardVariablesAndGaugeUnit(t *testing.T) {
+	d := resourceCoralogixDashboard().TestResourceData()
+	diags := setDashboard(d, &dashboard.Dashboard{
+		Name: wrapperspb.String("variables"),
+		Variables: []*dashboard.Variable{
+			{
+				Name:        wrapperspb.String("env"),
+				DisplayName: wrapperspb.String("Environment"),
+				Definition: &dashboard.Variable_Definition{Value: &dashboard.Variable_Definition_Constant{
+					Constant: &dashboard.Constant{Value: wrapperspb.String("production")},
+				}},
+			},
+			{
+				Name: wrapperspb.String("service"),
+				Definition: &dashboard.Variable_Definition{Value: &dashboard.Variable_Definition_MultiSelect{
+					MultiSelect: &dashboard.MultiSelect{
+						Source: &dashboard.MultiSelect_Source{Value: &dashboard.MultiSelect_Source_LogsPath{
+							LogsPath: &dashboard.MultiSelect_LogsPathSource{Value: wrapperspb.String("coralogix.metadata.applicationName")},
+						}},
+						ValuesOrderDirection: dashboard.OrderDirection_ORDER_DIRECTION_DESC,
+					},
+				}},
+			},
+		},
+		Layout: &dashboard.Layout{Sections: []*dashboard.Section{{Rows: []*dashboard.Row{{Widgets: []*dashboard.Widget{{
+			Definition: &dashboard.Widget_Definition{Value: &dashboard.Widget_Definition_Gauge{
+				Gauge: &dashboard.Gauge{Unit: dashboard.Gauge_UNIT_PERCENT},
+			}},
+		}}}}}}},
+	})
+	if diags.HasError() {
+		t.Fatalf("setDashboard: %v", diags)
+	}
+
+	for key, want := range map[string]string{
+		"variable.0.display_name":                                       "Environment",
+		"variable.0.definition.0.constant":                              "production",
+		"variable.1.definition.0.multi_select.0.source.0.logs_path":     "coralogix.metadata.applicationName",
+		"variable.1.definition.0.multi_select.0.values_order_direction": "Desc",
+		"layout.0.section.0.row.0.widget.0.definition.0.gauge.0.unit":   "Percent",
+	} {
+		if got := d.Get(key); got != want {
+			t.Errorf("%s = %q, want %q", key, got, want)
+		}
+	}
+	if got := expandGaugeUnit("Percent"); got != dashboard.Gauge_UNIT_PERCENT {
+		t.Errorf("expandGaugeUnit(\"Percent\") = %s, want %s", got, dashboard.Gauge_UNIT_PERCENT)
+	}
+}
+
+func TestFillDashboardLayoutIDs(t *testing.T) {
+	newDashboard := func(id string, titles ...string) *dashboard.Dashboard {
+		row := &dashboard.Row{}
+		for _, title := range titles {
+			row.Widgets = append(row.Widgets, &dashboard.Widget{Title: wrapperspb.String(title)})
+		}
+		return &dashboard.Dashboard{
+			Id:     wrapperspb.String(id),
+			Layout: &dashboard.Layout{Sections: []*dashboard.Section{{Rows: []*dashboard.Row{row}}}},
+		}
+	}
+	widgetIDs := func(d *dashboard.Dashboard) map[string][]string {
+		fillDashboardLayoutIDs(d)
+		ids := make(map[string][]string)
+		for _, widget := range d.GetLayout().GetSections()[0].GetRows()[0].GetWidgets() {
+			ids[widget.GetTitle().GetValue()] = append(ids[widget.GetTitle().GetValue()], widget.GetId().GetValue())
+		}
+		return ids
+	}
+
+	ids := widgetIDs(newDashboard("dashboard", "errors", "latency", "errors"))
+	if ids["errors"][0] == ids["errors"][1] || ids["errors"][0] == ids["latency"][0] {
+		t.Errorf("expected distinct widget ids, got %v", ids)
+	}
+	if reordered := widgetIDs(newDashboard("dashboard", "latency", "errors", "errors")); !cmp.Equal(ids, reordered) {
+		t.Errorf("expected the widgets to keep their ids when reordered, got %v, want %v", reordered, ids)
+	}
+	if other := widgetIDs(newDashboard("other", "errors", "latency", "errors")); other["latency"][0] == ids["latency"][0] {
+		t.Errorf("expected the widget ids to depend on the dashboard id, got %v for both dashboards", other["latency"])
+	}
+
+	// Editing a widget changes its own id only, its row and section keep theirs.
+	original, edited := newDashboard("dashboard", "errors", "latency"), newDashboard("dashboard", "errors", "latency p99")
+	fillDashboardLayoutIDs(original)
+	fillDashboardLayoutIDs(edited)
+	originalSection, editedSection := original.GetLayout().GetSections()[0], edited.GetLayout().GetSections()[0]
+	if originalSection.GetId().GetValue() != editedSection.GetId().GetValue() ||
+		originalSection.GetRows()[0].GetId().GetValue() != editedSection.GetRows()[0].GetId().GetValue() {
+		t.Errorf("expected editing a widget to keep the row and section ids, got %v and %v", originalSection, editedSection)
+	}
+	if originalSection.GetRows()[0].GetWidgets()[0].GetId().GetValue() != editedSection.GetRows()[0].GetWidgets()[0].GetId().GetValue() {
+		t.Errorf("expected the unchanged widget to keep its id")
+	}
+
+	explicit := newDashboard("dashboard", "errors")
+	explicit.Layout.Sections[0].Rows[0].Widgets[0].Id = &dashboard.UUID{Value: "errors-widget"}
+	if got := widgetIDs(explicit)["errors"][0]; got != "errors-widget" {
+		t.Errorf("expected the explicit widget id to be kept, got %s", got)
+	}
+}
+
+func TestDashboardContentJsonEqual(t *testing.T) {
+	withoutIDs := `{"name": "service", "layout": {"sections": [{"rows": [{"widgets": [{"title": "errors"}, {"title": "latency"}]}]}]}}`
+	echoed := &dashboard.Dashboard{}
+	if err := protojson.Unmarshal([]byte(withoutIDs), echoed); err != nil {
+		t.Fatal(err)
+	}
+	echoed.Id = wrapperspb.String("dashboard")
+	fillDashboardLayoutIDs(echoed)
+	echoedJson, err := protojson.Marshal(echoed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	widgetIDs := `{"name": "service", "layout": {"sections": [{"rows": [{"widgets": [{"id": {"value": "%s"}, "title": "errors"}]}]}]}}`
+	queryIDs := `{"name": "service", "layout": {"sections": [{"id": {"value": "section"}, "rows": [{"id": {"value": "row"}, "widgets": [{"id": {"value": "widget"}, "title": "errors", "definition": {"lineChart": {"queryDefinitions": [{"id": "%s"}]}}}]}]}]}}`
+	tests := []struct {
+		name        string
+		s1, s2      string
+		dashboardID string
+		want        bool
+	}{
+		{name: "ids echoed by the server", s1: withoutIDs, s2: string(echoedJson), dashboardID: "dashboard", want: true},
+		{name: "ids echoed for another dashboard", s1: withoutIDs, s2: string(echoedJson), dashboardID: "other"},
+		{name: "dashboard id of the resource", s1: withoutIDs, s2: `{"id": "dashboard", ` + withoutIDs[1:], dashboardID: "dashboard", want: true},
+		{name: "explicit dashboard id", s1: withoutIDs, s2: `{"id": "other", ` + withoutIDs[1:], dashboardID: "dashboard"},
+		{name: "explicit widget ids", s1: fmt.Sprintf(widgetIDs, "errors"), s2: fmt.Sprintf(widgetIDs, "errors-rate"), dashboardID: "dashboard"},
+		{name: "line chart query ids", s1: fmt.Sprintf(queryIDs, "query-1"), s2: fmt.Sprintf(queryIDs, "query-2"), dashboardID: "dashboard", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dashboardContentJsonEqual(tt.s1, tt.s2, tt.dashboardID); got != tt.want {
+				t.Errorf("got %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func assertDashboardRoundTrip(t *testing.T, dashboard *dashboard.Dashboard) {
+	t.Helper()
+
+	r := resourceCoralogixDashboard()
+	d := r.TestResourceData()
+	d.SetId(dashboard.GetId().GetValue())
+	if diags := setDashboard(d, dashboard); diags.HasError() {
+		t.Fatalf("setDashboard: %v", diags)
+	}
+	expanded, diags := extractDashboard(r.Data(d.State()))
+	if diags.HasError() {
+		t.Fatalf("extractDashboard: %v", diags)
+	}
+
+	assertProtoEqual(t, dashboard, expanded)
+}
+
+func newDashboardRoundTripGenerator() *roundTripGenerator {
+	generator := newRoundTripGenerator(1)
+	// The absolute time frame is in whole seconds in the schema, and it has to end after it starts.
+	generator.generators[roundTripField(&dashboard.Dashboard{}, "absolute_time_frame")] = func(r *rand.Rand) protoreflect.Value {
+		from := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(r.Intn(365*24*60)) * time.Minute)
+		to := from.Add(time.Duration(r.Intn(7*24*60)+1) * time.Minute)
+		return protoreflect.ValueOfMessage((&dashboard.TimeFrame{From: timestamppb.New(from), To: timestamppb.New(to)}).ProtoReflect())
+	}
+
+	// The schema doesn't support these enum values.
+	generator.excludedEnumValues[roundTripEnumValue(dashboard.RowStyle_ROW_STYLE_LIST)] = true
+	generator.excludedEnumValues[roundTripEnumValue(dashboard.Gauge_AGGREGATION_SUM)] = true
+	for unit := dashboard.Gauge_UNIT_MICROSECONDS; unit <= dashboard.Gauge_UNIT_GIBYTES; unit++ {
+		generator.excludedEnumValues[roundTripEnumValue(unit)] = true
+	}
+	return generator
+}
@@ -445,8 +445,11 @@ func (r *Events2MetricResource) Schema(_ context.Context, _ resource.SchemaReque
 				Optional: true,
 				Attributes: map[string]schema.Attribute{
 					"lucene": schema.StringAttribute{
-						Optional:    true,
-						Description: "The search_query that we wanted to be notified on.",
+						Optional: true,
+						Validators: []validator.String{
+							luceneQueryValidator{},
+						},
+						Description: "The search_query that we wanted to be notified on, in Lucene syntax.",
 					},
 					"applications": schema.SetAttribute{
 						ElementType: types.StringType,
@@ -491,8 +494,11 @@ func (r *Events2MetricResource) Schema(_ context.Context, _ resource.SchemaReque
 				Optional: true,
 				Attributes: map[string]schema.Attribute{
 					"lucene": schema.StringAttribute{
-						Optional:    true,
-						Description: "The search_query that we wanted to be notified on.",
+						Optional: true,
+						Validators: []validator.String{
+							luceneQueryValidator{},
+						},
+						Description: "The search_query that we wanted to be notified on, in Lucene syntax.",
 					},
 					"applications": schema.SetAttribute{
 						ElementType: types.StringType,
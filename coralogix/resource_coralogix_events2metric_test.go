@@ -11,7 +11,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"terraform-provider-coralogix/coralogix/clientset"
 	e2m "terraform-provider-coralogix/coralogix/clientset/grpc/events2metrics/v2"
+	l2m "terraform-provider-coralogix/coralogix/clientset/grpc/logs2metrics/v2"
 
+	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -258,3 +260,46 @@ func testAccCoralogixResourceSpans2Metric(l *events2MetricTestFields) string {
 `,
 		l.name, l.description, l.limit)
 }
+
+func TestEvents2MetricRoundTrip(t *testing.T) {
+	fixtures := loadRoundTripFixtures(t, "events2metric", func() *e2m.E2M { return &e2m.E2M{} })
+	assertOneofCoverage(t, fixtures, nil)
+
+	for _, name := range sortedFixtureNames(fixtures) {
+		fixture := fixtures[name]
+		t.Run(name, func(t *testing.T) {
+			assertEvents2MetricRoundTrip(t, fixture)
+
+			generator := newEvents2MetricRoundTripGenerator()
+			for i := 0; i < roundTripIterations; i++ {
+				assertEvents2MetricRoundTrip(t, generator.variation(fixture).(*e2m.E2M))
+			}
+		})
+	}
+}
+
+func assertEvents2MetricRoundTrip(t *testing.T, events2Metric *e2m.E2M) {
+	t.Helper()
+
+	ctx := context.Background()
+	model := roundTripFrameworkState(t, NewEvents2MetricResource(), flattenE2M(ctx, events2Metric))
+	expanded := extractUpdateE2M(ctx, model).GetE2M()
+
+	assertProtoEqual(t, events2Metric, expanded,
+		// The API names the aggregations' metrics, so target_metric_name is computed and isn't expanded.
+		protocmp.IgnoreFields(&e2m.Aggregation{}, "target_metric_name"),
+		// Labels and fields are maps in the schema, aggregations are attributes and the filters are sets.
+		protocmp.SortRepeatedFields(&e2m.E2M{}, "metric_labels", "metric_fields"),
+		protocmp.SortRepeatedFields(&e2m.MetricField{}, "aggregations"),
+		protocmp.SortRepeatedFields(&l2m.LogsQuery{}, "applicationname_filters", "subsystemname_filters", "severity_filters"),
+		protocmp.SortRepeatedFields(&e2m.SpansQuery{}, "applicationname_filters", "subsystemname_filters", "action_filters", "service_filters"),
+	)
+}
+
+func newEvents2MetricRoundTripGenerator() *roundTripGenerator {
+	generator := newRoundTripGenerator(1)
+	// The type follows the query, and every aggregation type is a different attribute with its own metadata.
+	generator.fixed[roundTripField(&e2m.E2M{}, "type")] = true
+	generator.fixed[roundTripField(&e2m.Aggregation{}, "agg_type")] = true
+	return generator
+}
@@ -337,20 +337,30 @@ func validateRecordingRulesGroupYamlContent(config interface{}, _ string) ([]str
 		return nil, []error{fmt.Errorf("groups list can not be empty")}
 	}
 
+	var warnings []string
 	errors := make([]error, 0)
 	for i, group := range groups {
 		if group.Name == "" {
 			errors = append(errors, fmt.Errorf("groups[%d] name can not be empty", i))
 		}
 		if group.Interval == nil {
-			return nil, append(errors, fmt.Errorf("groups[%d] limit have to be set", i))
+			return warnings, append(errors, fmt.Errorf("groups[%d] limit have to be set", i))
+		}
+		for j, rule := range group.Rules {
+			exprWarnings, err := parsePromql(rule.Expr, false)
+			for _, warning := range exprWarnings {
+				warnings = append(warnings, fmt.Sprintf("groups[%d].rules[%d].expr: %s", i, j, warning))
+			}
+			if err != nil {
+				errors = append(errors, fmt.Errorf("groups[%d].rules[%d].expr: %s", i, j, err))
+			}
 		}
 	}
 	if len(errors) != 0 {
-		return nil, errors
+		return warnings, errors
 	}
 
-	return nil, nil
+	return warnings, nil
 }
 
 func recordingRulesSchema() *schema.Resource {
@@ -362,8 +372,9 @@ func recordingRulesSchema() *schema.Resource {
 				Description: "The name of the time series to output to. Must be a valid metric name.",
 			},
 			"expr": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validatePromqlQuery,
 				Description: "The PromQL expression to evaluate. " +
 					"Every evaluation cycle this is evaluated at the current time," +
 					" and the result recorded as a new set of time series with the metric name as given by 'record'.",
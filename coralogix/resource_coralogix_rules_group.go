@@ -2,19 +2,39 @@ package coralogix
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
-	"time"
-
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 	"terraform-provider-coralogix/coralogix/clientset"
 	rulesv1 "terraform-provider-coralogix/coralogix/clientset/grpc/rules-groups/v1"
+)
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	"google.golang.org/protobuf/types/known/wrapperspb"
+var (
+	_ resource.ResourceWithConfigure      = &RulesGroupResource{}
+	_ resource.ResourceWithImportState    = &RulesGroupResource{}
+	_ resource.ResourceWithUpgradeState   = &RulesGroupResource{}
+	_ resource.ResourceWithValidateConfig = &RulesGroupResource{}
 )
 
 var (
@@ -50,773 +70,917 @@ var (
 	rulesValidFormatStandards                      = getKeysStrings(rulesSchemaFormatStandardToProtoFormatStandard)
 )
 
-func resourceCoralogixRulesGroup() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceCoralogixRulesGroupCreate,
-		ReadContext:   resourceCoralogixRulesGroupRead,
-		UpdateContext: resourceCoralogixRulesGroupUpdate,
-		DeleteContext: resourceCoralogixRulesGroupDelete,
+func NewRulesGroupResource() resource.Resource {
+	return &RulesGroupResource{}
+}
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+type RulesGroupResource struct {
+	client *clientset.RuleGroupsClient
+}
 
-		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(60 * time.Second),
-			Read:   schema.DefaultTimeout(30 * time.Second),
-			Update: schema.DefaultTimeout(60 * time.Second),
-			Delete: schema.DefaultTimeout(30 * time.Second),
-		},
+type RulesGroupResourceModel struct {
+	ID            types.String        `tfsdk:"id"`
+	Name          types.String        `tfsdk:"name"`
+	Description   types.String        `tfsdk:"description"`
+	Active        types.Bool          `tfsdk:"active"`
+	Applications  types.Set           `tfsdk:"applications"`
+	Subsystems    types.Set           `tfsdk:"subsystems"`
+	Severities    types.Set           `tfsdk:"severities"`
+	Hidden        types.Bool          `tfsdk:"hidden"`
+	Creator       types.String        `tfsdk:"creator"`
+	Order         types.Int64         `tfsdk:"order"`
+	RuleSubgroups []RuleSubgroupModel `tfsdk:"rule_subgroups"`
+}
+
+type RuleSubgroupModel struct {
+	ID     types.String `tfsdk:"id"`
+	Active types.Bool   `tfsdk:"active"`
+	Rules  []RuleModel  `tfsdk:"rules"`
+}
+
+type RuleModel struct {
+	ID               types.String               `tfsdk:"id"`
+	Parse            *ParseRuleModel            `tfsdk:"parse"`
+	Block            *BlockRuleModel            `tfsdk:"block"`
+	JsonExtract      *JsonExtractRuleModel      `tfsdk:"json_extract"`
+	Replace          *ReplaceRuleModel          `tfsdk:"replace"`
+	ExtractTimestamp *ExtractTimestampRuleModel `tfsdk:"extract_timestamp"`
+	RemoveFields     *RemoveFieldsRuleModel     `tfsdk:"remove_fields"`
+	JsonStringify    *JsonStringifyRuleModel    `tfsdk:"json_stringify"`
+	Extract          *ExtractRuleModel          `tfsdk:"extract"`
+	ParseJsonField   *ParseJsonFieldRuleModel   `tfsdk:"parse_json_field"`
+}
+
+type ParseRuleModel struct {
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	Active            types.Bool   `tfsdk:"active"`
+	SourceField       types.String `tfsdk:"source_field"`
+	DestinationField  types.String `tfsdk:"destination_field"`
+	RegularExpression types.String `tfsdk:"regular_expression"`
+}
+
+type BlockRuleModel struct {
+	Name                      types.String `tfsdk:"name"`
+	Description               types.String `tfsdk:"description"`
+	Active                    types.Bool   `tfsdk:"active"`
+	SourceField               types.String `tfsdk:"source_field"`
+	RegularExpression         types.String `tfsdk:"regular_expression"`
+	KeepBlockedLogs           types.Bool   `tfsdk:"keep_blocked_logs"`
+	BlockingAllMatchingBlocks types.Bool   `tfsdk:"blocking_all_matching_blocks"`
+}
+
+type JsonExtractRuleModel struct {
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	Active           types.Bool   `tfsdk:"active"`
+	DestinationField types.String `tfsdk:"destination_field"`
+	JsonKey          types.String `tfsdk:"json_key"`
+}
+
+type ReplaceRuleModel struct {
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	Active            types.Bool   `tfsdk:"active"`
+	SourceField       types.String `tfsdk:"source_field"`
+	DestinationField  types.String `tfsdk:"destination_field"`
+	RegularExpression types.String `tfsdk:"regular_expression"`
+	ReplacementString types.String `tfsdk:"replacement_string"`
+}
+
+type ExtractTimestampRuleModel struct {
+	Name                types.String `tfsdk:"name"`
+	Description         types.String `tfsdk:"description"`
+	Active              types.Bool   `tfsdk:"active"`
+	SourceField         types.String `tfsdk:"source_field"`
+	FieldFormatStandard types.String `tfsdk:"field_format_standard"`
+	TimeFormat          types.String `tfsdk:"time_format"`
+}
+
+type RemoveFieldsRuleModel struct {
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	Active         types.Bool   `tfsdk:"active"`
+	ExcludedFields types.List   `tfsdk:"excluded_fields"`
+}
+
+type JsonStringifyRuleModel struct {
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	Active           types.Bool   `tfsdk:"active"`
+	SourceField      types.String `tfsdk:"source_field"`
+	DestinationField types.String `tfsdk:"destination_field"`
+	KeepSourceField  types.Bool   `tfsdk:"keep_source_field"`
+}
+
+type ExtractRuleModel struct {
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	Active            types.Bool   `tfsdk:"active"`
+	SourceField       types.String `tfsdk:"source_field"`
+	RegularExpression types.String `tfsdk:"regular_expression"`
+}
+
+type ParseJsonFieldRuleModel struct {
+	Name                 types.String `tfsdk:"name"`
+	Description          types.String `tfsdk:"description"`
+	Active               types.Bool   `tfsdk:"active"`
+	SourceField          types.String `tfsdk:"source_field"`
+	DestinationField     types.String `tfsdk:"destination_field"`
+	KeepSourceField      types.Bool   `tfsdk:"keep_source_field"`
+	KeepDestinationField types.Bool   `tfsdk:"keep_destination_field"`
+}
+
+func (r *RulesGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rules_group"
+}
 
-		Schema: RulesGroupSchema(),
+func (r *RulesGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
 
-		Description: "Rule-group is list of rule-subgroups with 'and' (&&) operation between. For more info please review - https://coralogix.com/docs/log-parsing-rules/ .",
+	clientSet, ok := req.ProviderData.(*clientset.ClientSet)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *clientset.ClientSet, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
 	}
+
+	r.client = clientSet.RuleGroups()
 }
 
-func RulesGroupSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
-		"name": {
-			Type:         schema.TypeString,
-			Required:     true,
-			ValidateFunc: validation.StringIsNotEmpty,
-			Description:  "Rule-group name",
-		},
-		"description": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Default:     "",
-			Description: "Rule-group description",
-		},
-		"active": {
-			Type:        schema.TypeBool,
-			Optional:    true,
-			Default:     true,
-			Description: "Determines whether the rule-group will be active.",
-		},
-		"applications": {
-			Type:     schema.TypeSet,
-			Optional: true,
-			Elem: &schema.Schema{
-				Type: schema.TypeString,
+func (r *RulesGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ruleTypes := make([]path.Expression, 0, len(rulesTypes))
+	for _, ruleType := range rulesTypes {
+		ruleTypes = append(ruleTypes, path.MatchRelative().AtName(ruleType))
+	}
+
+	resp.Schema = schema.Schema{
+		Version: 1,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "Rule-group ID.",
 			},
-			Description: "Rules will execute on logs that match the following applications.",
-			Set:         schema.HashString,
-		},
-		"subsystems": {
-			Type:     schema.TypeSet,
-			Optional: true,
-			Elem: &schema.Schema{
-				Type: schema.TypeString,
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "Rule-group name",
 			},
-			Description: "Rules will execute on logs that match the following subsystems.",
-			Set:         schema.HashString,
-		},
-		"severities": {
-			Type:     schema.TypeSet,
-			Optional: true,
-			Elem: &schema.Schema{
-				Type:         schema.TypeString,
-				ValidateFunc: validation.StringInSlice(rulesValidSeverities, false),
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "Rule-group description",
 			},
-			Description: fmt.Sprintf("Rules will execute on logs that match the following severities. Can be one of %q", rulesValidSeverities),
-			Set:         schema.HashString,
-		},
-		"hidden": {
-			Type:     schema.TypeBool,
-			Optional: true,
-			Default:  false,
-		},
-		"creator": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "Rule-group creator.",
-		},
-		"order": {
-			Type:         schema.TypeInt,
-			Optional:     true,
-			Computed:     true,
-			Description:  "Determines the index of the rule-group between the other rule-groups. By default, will be added last. (1 based indexing).",
-			ValidateFunc: validation.IntAtLeast(1),
-		},
-		"rule_subgroups": {
-			Type:     schema.TypeList,
-			Optional: true,
-			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					"id": {
-						Type:        schema.TypeString,
-						Computed:    true,
-						Description: "The ID of thr rule-subgroup. Will be computed by Coralogix endpoint.",
-					},
-					"active": {
-						Type:        schema.TypeBool,
-						Optional:    true,
-						Default:     true,
-						Description: "Determines whether the rule-subgroup will be active.",
-					},
-					"order": {
-						Type:     schema.TypeInt,
-						Optional: true,
-						Computed: true,
-						Description: "Determines the index of the rule-subgroup inside the rule-group." +
-							"When not set, will be computed by the order it was declared. (1 based indexing).",
-						ValidateFunc: validation.IntAtLeast(1),
-					},
-					"rules": {
-						Type: schema.TypeList,
-						Elem: &schema.Resource{
-							Schema: map[string]*schema.Schema{
-								"parse": {
-									Type:     schema.TypeList,
-									Optional: true,
-									Elem: &schema.Resource{
-										Schema: parseSchema(),
+			"active": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Determines whether the rule-group will be active.",
+			},
+			"applications": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Rules will execute on logs that match the following applications.",
+			},
+			"subsystems": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Rules will execute on logs that match the following subsystems.",
+			},
+			"severities": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(rulesValidSeverities...)),
+				},
+				MarkdownDescription: fmt.Sprintf("Rules will execute on logs that match the following severities. Can be one of %q", rulesValidSeverities),
+			},
+			"hidden": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"creator": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Rule-group creator.",
+			},
+			"order": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				MarkdownDescription: "Determines the index of the rule-group between the other rule-groups. By default, will be added last. (1 based indexing). Leave it unset for rule-groups whose order is managed by `coralogix_rules_groups_order`.",
+			},
+			"rule_subgroups": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the rule-subgroup. Will be computed by Coralogix endpoint.",
+						},
+						"active": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+							MarkdownDescription: "Determines whether the rule-subgroup will be active.",
+						},
+						"rules": schema.ListNestedAttribute{
+							Required: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The ID of the rule. Will be computed by Coralogix endpoint.",
 									},
-									Description: "Parse unstructured logs into JSON format using named Regex groups.",
-									MaxItems:    1,
-								},
-								"block": {
-									Type:     schema.TypeList,
-									Optional: true,
-									Elem: &schema.Resource{
-										Schema: blockSchema(),
+									"parse": schema.SingleNestedAttribute{
+										Optional:            true,
+										Attributes:          parseRuleAttributes(),
+										MarkdownDescription: "Parse unstructured logs into JSON format using named Regex groups.",
 									},
-									Description: "Block rules allow for refined filtering of incoming logs with a RegEx.",
-									MaxItems:    1,
-								},
-								"json_extract": {
-									Type:     schema.TypeList,
-									Optional: true,
-									Elem: &schema.Resource{
-										Schema: jsonExtractSchema(),
+									"block": schema.SingleNestedAttribute{
+										Optional:            true,
+										Attributes:          blockRuleAttributes(),
+										MarkdownDescription: "Block rules allow for refined filtering of incoming logs with a RegEx.",
 									},
-									Description: "Name a JSON field to extract its value directly into a Coralogix metadata field",
-									MaxItems:    1,
-								},
-								"replace": {
-									Type:     schema.TypeList,
-									Optional: true,
-									Elem: &schema.Resource{
-										Schema: replaceSchema(),
+									"json_extract": schema.SingleNestedAttribute{
+										Optional:            true,
+										Attributes:          jsonExtractRuleAttributes(),
+										MarkdownDescription: "Name a JSON field to extract its value directly into a Coralogix metadata field",
 									},
-									Description: "Replace rules are used to strings in order to fix log structure, change log severity, or obscure information.",
-									MaxItems:    1,
-								},
-								"extract_timestamp": {
-									Type:     schema.TypeList,
-									Optional: true,
-									Elem: &schema.Resource{
-										Schema: extractTimestampSchema(),
+									"replace": schema.SingleNestedAttribute{
+										Optional:            true,
+										Attributes:          replaceRuleAttributes(),
+										MarkdownDescription: "Replace rules are used to strings in order to fix log structure, change log severity, or obscure information.",
 									},
-									Description: "Replace rules are used to replace logs timestamp with JSON field.",
-									MaxItems:    1,
-								},
-								"remove_fields": {
-									Type:     schema.TypeList,
-									Optional: true,
-									Elem: &schema.Resource{
-										Schema: removeFieldsSchema(),
+									"extract_timestamp": schema.SingleNestedAttribute{
+										Optional:            true,
+										Attributes:          extractTimestampRuleAttributes(),
+										MarkdownDescription: "Replace rules are used to replace logs timestamp with JSON field.",
 									},
-									Description: "Remove Fields allows to select fields that will not be indexed.",
-									MaxItems:    1,
-								},
-								"json_stringify": {
-									Type:     schema.TypeList,
-									Optional: true,
-									Elem: &schema.Resource{
-										Schema: jsonStringifyFieldsSchema(),
+									"remove_fields": schema.SingleNestedAttribute{
+										Optional:            true,
+										Attributes:          removeFieldsRuleAttributes(),
+										MarkdownDescription: "Remove Fields allows to select fields that will not be indexed.",
 									},
-									Description: "Convert JSON object to JSON string.",
-									MaxItems:    1,
-								},
-								"extract": {
-									Type:     schema.TypeList,
-									Optional: true,
-									Elem: &schema.Resource{
-										Schema: extractSchema(),
+									"json_stringify": schema.SingleNestedAttribute{
+										Optional:            true,
+										Attributes:          jsonStringifyRuleAttributes(),
+										MarkdownDescription: "Convert JSON object to JSON string.",
 									},
-									Description: "Use a named RegEx group to extract specific values you need as JSON getKeysStrings without having to parse the entire log.",
-									MaxItems:    1,
-								},
-								"parse_json_field": {
-									Type:     schema.TypeList,
-									Optional: true,
-									Elem: &schema.Resource{
-										Schema: parseJsonFieldSchema(),
+									"extract": schema.SingleNestedAttribute{
+										Optional:            true,
+										Attributes:          extractRuleAttributes(),
+										MarkdownDescription: "Use a named RegEx group to extract specific values you need as JSON getKeysStrings without having to parse the entire log.",
+									},
+									"parse_json_field": schema.SingleNestedAttribute{
+										Optional:            true,
+										Attributes:          parseJsonFieldRuleAttributes(),
+										MarkdownDescription: "Convert JSON string to JSON object.",
 									},
-									Description: "Convert JSON string to JSON object.",
-									MaxItems:    1,
 								},
+								Validators: []validator.Object{
+									objectvalidator.ExactlyOneOf(ruleTypes...),
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
 							},
+							MarkdownDescription: "List of rules with 'or' (||) operation between. The rules run in the order they're declared, and exactly one rule type has to be set in every rule.",
 						},
-						Required: true,
 					},
 				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				MarkdownDescription: "List of rule-subgroups. Every rule-subgroup is list of rules with 'or' (||) operation between. The rule-subgroups run in the order they're declared.",
 			},
-			Description: "List of rule-subgroups. Every rule-subgroup is list of rules with 'or' (||) operation between.",
 		},
+		MarkdownDescription: "Rule-group is list of rule-subgroups with 'and' (&&) operation between. For more info please review - https://coralogix.com/docs/log-parsing-rules/ .",
 	}
 }
 
-func parseSchema() map[string]*schema.Schema {
-	parseSchema := commonRulesSchema()
-	parseSchema = appendSourceFieldSchema(parseSchema)
-	parseSchema = appendDestinationFieldSchema(parseSchema)
-	parseSchema = appendRegularExpressionSchema(parseSchema)
-	return parseSchema
+func commonRuleAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Required: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			MarkdownDescription: "The rule name.",
+		},
+		"description": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(""),
+			MarkdownDescription: "The rule description.",
+		},
+		"active": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+			MarkdownDescription: "Determines whether to rule will be active or not.",
+		},
+	}
 }
 
-func blockSchema() map[string]*schema.Schema {
-	blockSchema := commonRulesSchema()
-	blockSchema = appendSourceFieldSchema(blockSchema)
-	blockSchema = appendRegularExpressionSchema(blockSchema)
-	blockSchema["keep_blocked_logs"] = &schema.Schema{
-		Type:        schema.TypeBool,
-		Optional:    true,
-		Default:     false,
-		Description: "Determines if to view blocked logs in LiveTail and archive to S3.",
+func sourceFieldAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "The field on which the Regex will operate on.",
 	}
-	blockSchema["blocking_all_matching_blocks"] = &schema.Schema{
-		Type:        schema.TypeBool,
-		Optional:    true,
-		Default:     true,
-		Description: "Block Logic. If true or nor set - blocking all matching blocks, if false - blocking all non-matching blocks.",
-	}
-	return blockSchema
 }
 
-func jsonExtractSchema() map[string]*schema.Schema {
-	jsonExtractSchema := commonRulesSchema()
-	jsonExtractSchema["destination_field"] = &schema.Schema{
-		Type:         schema.TypeString,
-		Required:     true,
-		ValidateFunc: validation.StringInSlice(rulesValidDestinationFields, false),
-		Description: fmt.Sprintf("The field that will be populated by the results of RegEx operation."+
-			"Can be one of %s.", fmt.Sprint(rulesValidDestinationFields)),
+func destinationFieldAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "The field that will be populated by the results of the RegEx operation.",
 	}
-	jsonExtractSchema["json_key"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "JSON key to extract its value directly into a Coralogix metadata field.",
+}
+
+func regularExpressionAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			rulesRegularExpressionValidator{},
+		},
+		MarkdownDescription: "Regular expiration, in the PCRE-like dialect of Coralogix (e.g. lookarounds and possessive quantifiers are supported). " +
+			"Named capture groups can be written as (?P<name>...) or (?<name>...). More info: https://coralogix.com/blog/regex-101/",
 	}
-	return jsonExtractSchema
 }
 
-func replaceSchema() map[string]*schema.Schema {
-	replaceSchema := commonRulesSchema()
-	replaceSchema = appendRegularExpressionSchema(replaceSchema)
-	replaceSchema = appendSourceFieldSchema(replaceSchema)
-	replaceSchema = appendDestinationFieldSchema(replaceSchema)
-	replaceSchema["replacement_string"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Default:     "",
-		Description: "The string that will replace the matched RegEx",
+func keepSourceFieldAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Determines whether to keep or to delete the source field.",
 	}
-	return replaceSchema
 }
 
-func extractTimestampSchema() map[string]*schema.Schema {
-	extractTimestampSchema := commonRulesSchema()
-	extractTimestampSchema = appendSourceFieldSchema(extractTimestampSchema)
-	extractTimestampSchema["field_format_standard"] = &schema.Schema{
-		Type:         schema.TypeString,
-		Required:     true,
-		ValidateFunc: validation.StringInSlice(rulesValidFormatStandards, false),
-		Description:  fmt.Sprintf("The format standard you want to use. Can be one of %q", rulesValidFormatStandards),
+func parseRuleAttributes() map[string]schema.Attribute {
+	attributes := commonRuleAttributes()
+	attributes["source_field"] = sourceFieldAttribute()
+	attributes["destination_field"] = destinationFieldAttribute()
+	attributes["regular_expression"] = regularExpressionAttribute()
+	return attributes
+}
+
+func blockRuleAttributes() map[string]schema.Attribute {
+	attributes := commonRuleAttributes()
+	attributes["source_field"] = sourceFieldAttribute()
+	attributes["regular_expression"] = regularExpressionAttribute()
+	attributes["keep_blocked_logs"] = schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Determines if to view blocked logs in LiveTail and archive to S3.",
 	}
-	extractTimestampSchema["time_format"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "A time format that matches the field format standard",
+	attributes["blocking_all_matching_blocks"] = schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(true),
+		MarkdownDescription: "Block Logic. If true or nor set - blocking all matching blocks, if false - blocking all non-matching blocks.",
 	}
-	return extractTimestampSchema
+	return attributes
 }
 
-func removeFieldsSchema() map[string]*schema.Schema {
-	removeFieldsSchema := commonRulesSchema()
-	removeFieldsSchema["excluded_fields"] = &schema.Schema{
-		Type:     schema.TypeList,
+func jsonExtractRuleAttributes() map[string]schema.Attribute {
+	attributes := commonRuleAttributes()
+	attributes["destination_field"] = schema.StringAttribute{
 		Required: true,
-		Elem: &schema.Schema{
-			Type: schema.TypeString,
+		Validators: []validator.String{
+			stringvalidator.OneOf(rulesValidDestinationFields...),
 		},
-		MinItems:    1,
-		Description: "Excluded fields won't be indexed.",
+		MarkdownDescription: fmt.Sprintf("The field that will be populated by the results of RegEx operation."+
+			"Can be one of %s.", fmt.Sprint(rulesValidDestinationFields)),
 	}
-	return removeFieldsSchema
-}
-
-func jsonStringifyFieldsSchema() map[string]*schema.Schema {
-	jsonStringifySchema := commonRulesSchema()
-	jsonStringifySchema = appendSourceFieldSchema(jsonStringifySchema)
-	jsonStringifySchema = appendDestinationFieldSchema(jsonStringifySchema)
-	jsonStringifySchema["keep_source_field"] = &schema.Schema{
-		Type:        schema.TypeBool,
-		Optional:    true,
-		Default:     false,
-		Description: "Determines whether to keep or to delete the source field.",
+	attributes["json_key"] = schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "JSON key to extract its value directly into a Coralogix metadata field.",
 	}
-	return jsonStringifySchema
+	return attributes
 }
 
-func extractSchema() map[string]*schema.Schema {
-	extractSchema := commonRulesSchema()
-	extractSchema = appendSourceFieldSchema(extractSchema)
-	extractSchema = appendRegularExpressionSchema(extractSchema)
-	return extractSchema
+func replaceRuleAttributes() map[string]schema.Attribute {
+	attributes := commonRuleAttributes()
+	attributes["source_field"] = sourceFieldAttribute()
+	attributes["destination_field"] = destinationFieldAttribute()
+	attributes["regular_expression"] = regularExpressionAttribute()
+	attributes["replacement_string"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString(""),
+		MarkdownDescription: "The string that will replace the matched RegEx",
+	}
+	return attributes
 }
 
-func parseJsonFieldSchema() map[string]*schema.Schema {
-	parseJsonFieldSchema := commonRulesSchema()
-	parseJsonFieldSchema = appendSourceFieldSchema(parseJsonFieldSchema)
-	parseJsonFieldSchema = appendDestinationFieldSchema(parseJsonFieldSchema)
-	parseJsonFieldSchema["keep_source_field"] = &schema.Schema{
-		Type:        schema.TypeBool,
-		Optional:    true,
-		Default:     false,
-		Description: "Determines whether to keep or to delete the source field.",
+func extractTimestampRuleAttributes() map[string]schema.Attribute {
+	attributes := commonRuleAttributes()
+	attributes["source_field"] = sourceFieldAttribute()
+	attributes["field_format_standard"] = schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(rulesValidFormatStandards...),
+		},
+		MarkdownDescription: fmt.Sprintf("The format standard you want to use. Can be one of %q", rulesValidFormatStandards),
 	}
-	parseJsonFieldSchema["keep_destination_field"] = &schema.Schema{
-		Type:        schema.TypeBool,
-		Optional:    true,
-		Default:     true,
-		Description: "Determines whether to keep or to delete the destination field.",
+	attributes["time_format"] = schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "A time format that matches the field format standard",
 	}
-	return parseJsonFieldSchema
+	return attributes
 }
 
-func commonRulesSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
-		"id": {
-			Type:        schema.TypeString,
-			Computed:    true,
-			Description: "The rule id.",
-		},
-		"name": {
-			Type:         schema.TypeString,
-			Required:     true,
-			ValidateFunc: validation.StringIsNotEmpty,
-			Description:  "The rule name.",
-		},
-		"description": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "The rule description.",
-		},
-		"active": {
-			Type:        schema.TypeBool,
-			Optional:    true,
-			Default:     true,
-			Description: "Determines whether to rule will be active or not.",
-		},
-		"order": {
-			Type:     schema.TypeInt,
-			Computed: true,
-			Optional: true,
-			Description: "Determines the index of the rule inside the rule-subgroup." +
-				"When not set, will be computed by the order it was declared. (1 based indexing).",
-			ValidateFunc: validation.IntAtLeast(1),
+func removeFieldsRuleAttributes() map[string]schema.Attribute {
+	attributes := commonRuleAttributes()
+	attributes["excluded_fields"] = schema.ListAttribute{
+		Required:    true,
+		ElementType: types.StringType,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
 		},
+		MarkdownDescription: "Excluded fields won't be indexed.",
 	}
+	return attributes
 }
 
-func appendSourceFieldSchema(m map[string]*schema.Schema) map[string]*schema.Schema {
-	m["source_field"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "The field on which the Regex will operate on.",
-	}
-	return m
+func jsonStringifyRuleAttributes() map[string]schema.Attribute {
+	attributes := commonRuleAttributes()
+	attributes["source_field"] = sourceFieldAttribute()
+	attributes["destination_field"] = destinationFieldAttribute()
+	attributes["keep_source_field"] = keepSourceFieldAttribute()
+	return attributes
 }
 
-func appendDestinationFieldSchema(m map[string]*schema.Schema) map[string]*schema.Schema {
-	m["destination_field"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "The field that will be populated by the results of the RegEx operation.",
-	}
-	return m
+func extractRuleAttributes() map[string]schema.Attribute {
+	attributes := commonRuleAttributes()
+	attributes["source_field"] = sourceFieldAttribute()
+	attributes["regular_expression"] = regularExpressionAttribute()
+	return attributes
 }
 
-func appendRegularExpressionSchema(m map[string]*schema.Schema) map[string]*schema.Schema {
-	m["regular_expression"] = &schema.Schema{
-		Type:         schema.TypeString,
-		Required:     true,
-		ValidateFunc: validation.StringIsValidRegExp,
-		Description:  "Regular expiration. More info: https://coralogix.com/blog/regex-101/",
+func parseJsonFieldRuleAttributes() map[string]schema.Attribute {
+	attributes := commonRuleAttributes()
+	attributes["source_field"] = sourceFieldAttribute()
+	attributes["destination_field"] = destinationFieldAttribute()
+	attributes["keep_source_field"] = keepSourceFieldAttribute()
+	attributes["keep_destination_field"] = schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(true),
+		MarkdownDescription: "Determines whether to keep or to delete the destination field.",
 	}
-	return m
+	return attributes
 }
 
-func resourceCoralogixRulesGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	createRuleGroupRequest, err := extractCreateRuleGroupRequest(d)
-	if err != nil {
-		return diag.FromErr(err)
+// ValidateConfig checks the regular expressions of parse, extract and replace rules against their other parameters,
+// see validateRegexRule.
+func (r *RulesGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var ruleSubgroups types.List
+	diags := req.Config.GetAttribute(ctx, path.Root("rule_subgroups"), &ruleSubgroups)
+	if diags.HasError() || ruleSubgroups.IsNull() || ruleSubgroups.IsUnknown() {
+		return
 	}
 
-	log.Printf("[INFO] Creating new rule-group: %#v", createRuleGroupRequest)
-	ruleGroupResp, err := meta.(*clientset.ClientSet).RuleGroups().CreateRuleGroup(ctx, createRuleGroupRequest)
-	if err != nil {
-		log.Printf("[ERROR] Received error: %#v", err)
-		return handleRpcError(err, "rule-group")
+	var subgroups []RuleSubgroupModel
+	if diags := ruleSubgroups.ElementsAs(ctx, &subgroups, false); diags.HasError() {
+		// Lists which are unknown until apply can't be checked yet. They'll be checked on the next plan.
+		return
 	}
-	ruleGroup := ruleGroupResp.GetRuleGroup()
-	log.Printf("[INFO] Submitted new rule-group: %#v", ruleGroup)
-	d.SetId(ruleGroup.GetId().GetValue())
 
-	return resourceCoralogixRulesGroupRead(ctx, d, meta)
+	for i, subgroup := range subgroups {
+		for j, rule := range subgroup.Rules {
+			rulePath := path.Root("rule_subgroups").AtListIndex(i).AtName("rules").AtListIndex(j)
+			var err error
+			switch {
+			case rule.Parse != nil:
+				rulePath = rulePath.AtName("parse")
+				err = validateRegexRule("parse", map[string]interface{}{
+					"regular_expression": rule.Parse.RegularExpression.ValueString(),
+					"destination_field":  rule.Parse.DestinationField.ValueString(),
+				})
+			case rule.Extract != nil:
+				rulePath = rulePath.AtName("extract")
+				err = validateRegexRule("extract", map[string]interface{}{
+					"regular_expression": rule.Extract.RegularExpression.ValueString(),
+				})
+			case rule.Replace != nil:
+				rulePath = rulePath.AtName("replace")
+				err = validateRegexRule("replace", map[string]interface{}{
+					"regular_expression": rule.Replace.RegularExpression.ValueString(),
+					"replacement_string": rule.Replace.ReplacementString.ValueString(),
+				})
+			}
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(rulePath, "Invalid rule", err.Error())
+			}
+		}
+	}
 }
 
-func resourceCoralogixRulesGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	id := d.Id()
-	getRuleGroupRequest := &rulesv1.GetRuleGroupRequest{
-		GroupId: id,
+func (r *RulesGroupResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			StateUpgrader: r.upgradeRulesGroupStateV0ToV1,
+		},
 	}
+}
 
-	log.Printf("[INFO] Reading rule-group %s", id)
-	ruleGroupResp, err := meta.(*clientset.ClientSet).RuleGroups().GetRuleGroup(ctx, getRuleGroupRequest)
-	if err != nil {
-		log.Printf("[ERROR] Received error: %#v", err)
-		if status.Code(err) == codes.NotFound {
-			d.SetId("")
-			return diag.Diagnostics{diag.Diagnostic{
-				Severity: diag.Warning,
-				Summary:  fmt.Sprintf("Rule-Group %q is in state, but no longer exists in Coralogix backend", id),
-				Detail:   fmt.Sprintf("%s will be recreated when you apply", id),
-			}}
-		}
-		return handleRpcErrorWithID(err, "rule-group", id)
+// upgradeRulesGroupStateV0ToV1 converts the state written by the SDK based resource into the nested attributes of the
+// current schema. Every rule type used to be a block list with its own id and order, so the id moves up to the rule,
+// and the orders are dropped - rule-subgroups and rules are ordered by their position in the list.
+func (r *RulesGroupResource) upgradeRulesGroupStateV0ToV1(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	if req.RawState == nil || req.RawState.JSON == nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Rules Group State", "The prior state of the rules-group is missing.")
+		return
 	}
-	ruleGroup := ruleGroupResp.GetRuleGroup()
-	log.Printf("[INFO] Received rule-group: %#v", ruleGroup)
-
-	return setRuleGroup(d, ruleGroup)
-}
 
-func resourceCoralogixRulesGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	req, err := extractCreateRuleGroupRequest(d)
-	if err != nil {
-		return diag.FromErr(err)
+	decoder := json.NewDecoder(strings.NewReader(string(req.RawState.JSON)))
+	decoder.UseNumber()
+	var rawState map[string]interface{}
+	if err := decoder.Decode(&rawState); err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Rules Group State", "Could not decode the prior state of the rules-group: "+err.Error())
+		return
 	}
 
-	id := d.Id()
-	updateRuleGroupRequest := &rulesv1.UpdateRuleGroupRequest{
-		GroupId:   wrapperspb.String(id),
-		RuleGroup: req,
+	ruleSubgroups, _ := rawState["rule_subgroups"].([]interface{})
+	for _, ruleSubgroup := range ruleSubgroups {
+		ruleSubgroupMap, _ := ruleSubgroup.(map[string]interface{})
+		rules, _ := ruleSubgroupMap["rules"].([]interface{})
+		for _, rule := range rules {
+			ruleMap, _ := rule.(map[string]interface{})
+			for _, ruleType := range rulesTypes {
+				if params := firstSDKv2Block(ruleMap[ruleType]); params != nil {
+					ruleMap["id"] = params["id"]
+				}
+			}
+		}
 	}
 
-	log.Printf("[INFO] Updating rule-group %s to %s", id, updateRuleGroupRequest)
-	ruleGroupResp, err := meta.(*clientset.ClientSet).RuleGroups().UpdateRuleGroup(ctx, updateRuleGroupRequest)
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	upgradedState, err := upgradeObjectFromSDKv2State(ctx, schemaResp.Schema.Attributes, rawState)
 	if err != nil {
-		log.Printf("[ERROR] Received error: %#v", err)
-		return handleRpcErrorWithID(err, "rule-group", id)
+		resp.Diagnostics.AddError("Unable to Upgrade Rules Group State", "Could not convert the prior state of the rules-group: "+err.Error())
+		return
 	}
-	log.Printf("[INFO] Submitted updated rule-group: %#v", ruleGroupResp)
 
-	return resourceCoralogixRulesGroupRead(ctx, d, meta)
+	resp.State.Raw = upgradedState
+}
+
+func (r *RulesGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-func resourceCoralogixRulesGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	id := d.Id()
-	deleteRuleGroupRequest := &rulesv1.DeleteRuleGroupRequest{
-		GroupId: id,
+func (r *RulesGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	jsm := &jsonpb.Marshaler{}
+	var plan RulesGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	log.Printf("[INFO] Deleting rule-group %s", id)
-	_, err := meta.(*clientset.ClientSet).RuleGroups().DeleteRuleGroup(ctx, deleteRuleGroupRequest)
+	createRuleGroupRequest, diags := extractCreateRuleGroupRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ruleGroupStr, _ := jsm.MarshalToString(createRuleGroupRequest)
+	log.Printf("[INFO] Creating new rule-group: %s", ruleGroupStr)
+	createResp, err := r.client.CreateRuleGroup(ctx, createRuleGroupRequest)
 	if err != nil {
 		log.Printf("[ERROR] Received error: %#v", err)
-		return handleRpcErrorWithID(err, "rule-group", id)
+		resp.Diagnostics.AddError(
+			"Error creating Rules Group",
+			handleRpcErrorNewFramework(err, "rule-group"),
+		)
+		return
+	}
+	ruleGroup := createResp.GetRuleGroup()
+	ruleGroupStr, _ = jsm.MarshalToString(ruleGroup)
+	log.Printf("[INFO] Submitted new rule-group: %s", ruleGroupStr)
+
+	plan, diags = flattenRuleGroup(ctx, ruleGroup, plan.Creator)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	log.Printf("[INFO] rule-group %s deleted", id)
 
-	d.SetId("")
-	return nil
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
 }
 
-func extractCreateRuleGroupRequest(d *schema.ResourceData) (*rulesv1.CreateRuleGroupRequest, error) {
-	name := wrapperspb.String(d.Get("name").(string))
-	description := wrapperspb.String(d.Get("description").(string))
-	creator := wrapperspb.String(d.Get("creator").(string))
-	enabled := wrapperspb.Bool(d.Get("active").(bool))
-	hidden := wrapperspb.Bool(d.Get("hidden").(bool))
-	ruleMatchers := expandRuleMatcher(d)
-	rulesSubgroups, err := expandRuleSubgroups(d.Get("rule_subgroups"))
-	if err != nil {
-		return nil, err
-	}
-	order := wrapperspb.UInt32(uint32(d.Get("order").(int)))
-	createRuleGroupRequest := &rulesv1.CreateRuleGroupRequest{
-		Name:          name,
-		Description:   description,
-		Creator:       creator,
-		Enabled:       enabled,
-		Hidden:        hidden,
-		RuleMatchers:  ruleMatchers,
-		RuleSubgroups: rulesSubgroups,
-		Order:         order,
+func (r *RulesGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RulesGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	return createRuleGroupRequest, nil
-}
 
-func setRuleGroup(d *schema.ResourceData, ruleGroup *rulesv1.RuleGroup) diag.Diagnostics {
-	if err := d.Set("active", ruleGroup.GetEnabled().GetValue()); err != nil {
-		return diag.FromErr(err)
+	id := state.ID.ValueString()
+	log.Printf("[INFO] Reading rule-group %s", id)
+	getRuleGroupResp, err := r.client.GetRuleGroup(ctx, &rulesv1.GetRuleGroupRequest{GroupId: id})
+	if err != nil {
+		log.Printf("[ERROR] Received error: %#v", err)
+		if status.Code(err) == codes.NotFound {
+			resp.Diagnostics.AddWarning(
+				fmt.Sprintf("Rule-Group %q is in state, but no longer exists in Coralogix backend", id),
+				fmt.Sprintf("%s will be recreated when you apply", id),
+			)
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error reading Rules Group",
+				handleRpcErrorNewFramework(err, "rule-group"),
+			)
+		}
+		return
 	}
+	ruleGroup := getRuleGroupResp.GetRuleGroup()
+	log.Printf("[INFO] Received rule-group: %#v", ruleGroup)
 
-	if err := d.Set("name", ruleGroup.GetName().GetValue()); err != nil {
-		return diag.FromErr(err)
+	state, diags = flattenRuleGroup(ctx, ruleGroup, state.Creator)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if err := d.Set("description", ruleGroup.GetDescription().GetValue()); err != nil {
-		return diag.FromErr(err)
-	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
 
-	if err := d.Set("creator", ruleGroup.GetCreator().GetValue()); err != nil {
-		return diag.FromErr(err)
+func (r *RulesGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	jsm := &jsonpb.Marshaler{}
+	var plan RulesGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if err := d.Set("order", ruleGroup.GetOrder().GetValue()); err != nil {
-		return diag.FromErr(err)
+	ruleGroupReq, diags := extractCreateRuleGroupRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-
-	if err := d.Set("hidden", ruleGroup.GetHidden().GetValue()); err != nil {
-		return diag.FromErr(err)
+	id := plan.ID.ValueString()
+	// Without an explicit order the state value may be stale (e.g. after a
+	// coralogix_rules_groups_order apply), so keep the current position.
+	if plan.Order.IsUnknown() {
+		getRuleGroupResp, err := r.client.GetRuleGroup(ctx, &rulesv1.GetRuleGroupRequest{GroupId: id})
+		if err != nil {
+			log.Printf("[ERROR] Received error: %#v", err)
+			resp.Diagnostics.AddError(
+				"Error reading Rules Group",
+				handleRpcErrorNewFramework(err, "rule-group"),
+			)
+			return
+		}
+		ruleGroupReq.Order = getRuleGroupResp.GetRuleGroup().GetOrder()
 	}
 
-	ruleMatcher, err := flattenRuleMatcher(ruleGroup.GetRuleMatchers())
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	if err = d.Set("applications", ruleMatcher["applications"]); err != nil {
-		return diag.FromErr(err)
+	updateRuleGroupRequest := &rulesv1.UpdateRuleGroupRequest{
+		GroupId:   wrapperspb.String(id),
+		RuleGroup: ruleGroupReq,
 	}
-	if err = d.Set("subsystems", ruleMatcher["subsystems"]); err != nil {
-		return diag.FromErr(err)
+	ruleGroupStr, _ := jsm.MarshalToString(updateRuleGroupRequest)
+	log.Printf("[INFO] Updating rule-group %s to %s", id, ruleGroupStr)
+	if _, err := r.client.UpdateRuleGroup(ctx, updateRuleGroupRequest); err != nil {
+		log.Printf("[ERROR] Received error: %#v", err)
+		resp.Diagnostics.AddError(
+			"Error updating Rules Group",
+			handleRpcErrorNewFramework(err, "rule-group"),
+		)
+		return
 	}
-	if err = d.Set("severities", ruleMatcher["severities"]); err != nil {
-		return diag.FromErr(err)
+
+	// Get refreshed rule-group value from Coralogix
+	getRuleGroupResp, err := r.client.GetRuleGroup(ctx, &rulesv1.GetRuleGroupRequest{GroupId: id})
+	if err != nil {
+		log.Printf("[ERROR] Received error: %#v", err)
+		if status.Code(err) == codes.NotFound {
+			resp.Diagnostics.AddWarning(
+				fmt.Sprintf("Rule-Group %q is in state, but no longer exists in Coralogix backend", id),
+				fmt.Sprintf("%s will be recreated when you apply", id),
+			)
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error reading Rules Group",
+				handleRpcErrorNewFramework(err, "rule-group"),
+			)
+		}
+		return
 	}
+	ruleGroup := getRuleGroupResp.GetRuleGroup()
+	log.Printf("[INFO] Received rule-group: %#v", ruleGroup)
 
-	if ruleSubgroups, err := flattenRuleSubgroups(ruleGroup.GetRuleSubgroups()); err != nil {
-		return diag.FromErr(err)
-	} else if err = d.Set("rule_subgroups", ruleSubgroups); err != nil {
-		return diag.FromErr(err)
+	plan, diags = flattenRuleGroup(ctx, ruleGroup, plan.Creator)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	return nil
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
 }
 
-func expandRuleMatcher(d *schema.ResourceData) []*rulesv1.RuleMatcher {
-	applications := d.Get("applications").(*schema.Set).List()
-	subsystems := d.Get("subsystems").(*schema.Set).List()
-	severities := d.Get("severities").(*schema.Set).List()
-	ruleMatchers := make([]*rulesv1.RuleMatcher, 0, len(applications)+len(subsystems)+len(severities))
-
-	for _, app := range applications {
-		constraintStr := wrapperspb.String(app.(string))
-		applicationNameConstraint := rulesv1.ApplicationNameConstraint{Value: constraintStr}
-		ruleMatcherApplicationName := rulesv1.RuleMatcher_ApplicationName{ApplicationName: &applicationNameConstraint}
-		ruleMatchers = append(ruleMatchers, &rulesv1.RuleMatcher{Constraint: &ruleMatcherApplicationName})
+func (r *RulesGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RulesGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	for _, subSys := range subsystems {
-		constraintStr := wrapperspb.String(subSys.(string))
-		subsystemNameConstraint := rulesv1.SubsystemNameConstraint{Value: constraintStr}
-		ruleMatcherApplicationName := rulesv1.RuleMatcher_SubsystemName{SubsystemName: &subsystemNameConstraint}
-		ruleMatchers = append(ruleMatchers, &rulesv1.RuleMatcher{Constraint: &ruleMatcherApplicationName})
+	id := state.ID.ValueString()
+	log.Printf("[INFO] Deleting rule-group %s", id)
+	if _, err := r.client.DeleteRuleGroup(ctx, &rulesv1.DeleteRuleGroupRequest{GroupId: id}); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error Deleting Rules Group %s", id),
+			handleRpcErrorNewFramework(err, "rule-group"),
+		)
+		return
 	}
+	log.Printf("[INFO] rule-group %s deleted", id)
+}
 
-	for _, sev := range severities {
-		constraintEnum := expandRuledSeverity(sev.(string))
-		severityConstraint := rulesv1.SeverityConstraint{Value: constraintEnum}
-		ruleMatcherSeverity := rulesv1.RuleMatcher_Severity{Severity: &severityConstraint}
-		ruleMatchers = append(ruleMatchers, &rulesv1.RuleMatcher{Constraint: &ruleMatcherSeverity})
+func extractCreateRuleGroupRequest(ctx context.Context, plan RulesGroupResourceModel) (*rulesv1.CreateRuleGroupRequest, diag.Diagnostics) {
+	ruleSubgroups, diags := expandRuleSubgroups(ctx, plan.RuleSubgroups)
+	if diags.HasError() {
+		return nil, diags
 	}
 
-	return ruleMatchers
-}
-
-func expandRuledSeverity(severity string) rulesv1.SeverityConstraint_Value {
-	sevStr := rulesSchemaSeverityToProtoSeverity[severity]
-	return rulesv1.SeverityConstraint_Value(rulesv1.SeverityConstraint_Value_value[sevStr])
+	return &rulesv1.CreateRuleGroupRequest{
+		Name:          typeStringToWrapperspbString(plan.Name),
+		Description:   wrapperspb.String(plan.Description.ValueString()),
+		Creator:       wrapperspb.String(plan.Creator.ValueString()),
+		Enabled:       wrapperspb.Bool(plan.Active.ValueBool()),
+		Hidden:        wrapperspb.Bool(plan.Hidden.ValueBool()),
+		RuleMatchers:  expandRuleMatchers(plan),
+		RuleSubgroups: ruleSubgroups,
+		// An unknown order is sent as 0, which adds the rule-group last.
+		Order: wrapperspb.UInt32(uint32(plan.Order.ValueInt64())),
+	}, nil
 }
 
-func expandRuleSubgroups(v interface{}) ([]*rulesv1.CreateRuleGroupRequest_CreateRuleSubgroup, error) {
-	s := v.([]interface{})
-	ruleSubgroups := make([]*rulesv1.CreateRuleGroupRequest_CreateRuleSubgroup, 0, len(s))
-	for i, o := range s {
-		m := o.(map[string]interface{})
-		rsg, err := expandRuleSubgroup(m)
-		if err != nil {
-			return nil, err
-		}
-
-		if rsg.Order == nil {
-			rsg.Order = wrapperspb.UInt32(uint32(i + 1))
-		}
+func expandRuleMatchers(plan RulesGroupResourceModel) []*rulesv1.RuleMatcher {
+	applications := typeStringSliceToWrappedStringSlice(plan.Applications.Elements())
+	subsystems := typeStringSliceToWrappedStringSlice(plan.Subsystems.Elements())
+	severities := typeStringSliceToWrappedStringSlice(plan.Severities.Elements())
+	ruleMatchers := make([]*rulesv1.RuleMatcher, 0, len(applications)+len(subsystems)+len(severities))
 
-		ruleSubgroups = append(ruleSubgroups, rsg)
+	for _, application := range applications {
+		ruleMatchers = append(ruleMatchers, &rulesv1.RuleMatcher{Constraint: &rulesv1.RuleMatcher_ApplicationName{
+			ApplicationName: &rulesv1.ApplicationNameConstraint{Value: application},
+		}})
 	}
 
-	return ruleSubgroups, nil
-}
-
-func expandRuleSubgroup(m map[string]interface{}) (*rulesv1.CreateRuleGroupRequest_CreateRuleSubgroup, error) {
-	rules, err := expandRules(m["rules"].([]interface{}))
-	if err != nil {
-		return nil, err
+	for _, subsystem := range subsystems {
+		ruleMatchers = append(ruleMatchers, &rulesv1.RuleMatcher{Constraint: &rulesv1.RuleMatcher_SubsystemName{
+			SubsystemName: &rulesv1.SubsystemNameConstraint{Value: subsystem},
+		}})
 	}
 
-	active := wrapperspb.Bool(m["active"].(bool))
-
-	var order *wrapperspb.UInt32Value
-	if o, ok := m["order"].(int); ok && o != 0 {
-		order = wrapperspb.UInt32(uint32(o))
+	for _, severity := range severities {
+		ruleMatchers = append(ruleMatchers, &rulesv1.RuleMatcher{Constraint: &rulesv1.RuleMatcher_Severity{
+			Severity: &rulesv1.SeverityConstraint{Value: expandRuledSeverity(severity.GetValue())},
+		}})
 	}
 
-	return &rulesv1.CreateRuleGroupRequest_CreateRuleSubgroup{
-		Rules:   rules,
-		Enabled: active,
-		Order:   order,
-	}, nil
+	return ruleMatchers
 }
 
-func expandRules(s []interface{}) ([]*rulesv1.CreateRuleGroupRequest_CreateRuleSubgroup_CreateRule, error) {
-	rules := make([]*rulesv1.CreateRuleGroupRequest_CreateRuleSubgroup_CreateRule, 0)
-	for i, v := range s {
-		rule, err := expandRule(v)
-		if err != nil {
-			return nil, err
-		}
-
-		if rule.Order == nil {
-			rule.Order = wrapperspb.UInt32(uint32(i + 1))
-		}
-
-		rules = append(rules, rule)
-	}
-	return rules, nil
+func expandRuledSeverity(severity string) rulesv1.SeverityConstraint_Value {
+	sevStr := rulesSchemaSeverityToProtoSeverity[severity]
+	return rulesv1.SeverityConstraint_Value(rulesv1.SeverityConstraint_Value_value[sevStr])
 }
 
-func expandRule(i interface{}) (*rulesv1.CreateRuleGroupRequest_CreateRuleSubgroup_CreateRule, error) {
-	m := i.(map[string]interface{})
-	var rule *rulesv1.CreateRuleGroupRequest_CreateRuleSubgroup_CreateRule
-	for k, v := range m {
-		if r, ok := v.([]interface{}); ok && len(r) > 0 {
-			if rule == nil {
-				rule = expandRuleForSpecificRuleType(k, r[0])
-			} else {
-				return nil, fmt.Errorf("exactly one of %q must be provided inside rule. more than one rule type where provided", getKeysInterface(m))
+func expandRuleSubgroups(ctx context.Context, ruleSubgroups []RuleSubgroupModel) ([]*rulesv1.CreateRuleGroupRequest_CreateRuleSubgroup, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	result := make([]*rulesv1.CreateRuleGroupRequest_CreateRuleSubgroup, 0, len(ruleSubgroups))
+	for i, ruleSubgroup := range ruleSubgroups {
+		rules := make([]*rulesv1.CreateRuleGroupRequest_CreateRuleSubgroup_CreateRule, 0, len(ruleSubgroup.Rules))
+		for j, ruleModel := range ruleSubgroup.Rules {
+			rule, dgs := expandRule(ctx, ruleModel)
+			if dgs.HasError() {
+				diags.Append(dgs...)
+				continue
 			}
+			rule.Order = wrapperspb.UInt32(uint32(j + 1))
+			rules = append(rules, rule)
 		}
+		result = append(result, &rulesv1.CreateRuleGroupRequest_CreateRuleSubgroup{
+			Rules:   rules,
+			Enabled: wrapperspb.Bool(ruleSubgroup.Active.ValueBool()),
+			Order:   wrapperspb.UInt32(uint32(i + 1)),
+		})
 	}
-	if rule == nil {
-		return nil, fmt.Errorf("exactly one of %q must be provided inside rule. no rule type was provided", getKeysInterface(m))
-	}
-	return rule, nil
+	return result, diags
 }
 
-func expandRuleForSpecificRuleType(rulesType string, i interface{}) *rulesv1.CreateRuleGroupRequest_CreateRuleSubgroup_CreateRule {
-	m := i.(map[string]interface{})
-
-	var order *wrapperspb.UInt32Value
-	if o, ok := m["order"].(int); ok && o != 0 {
-		order = wrapperspb.UInt32(uint32(o))
-	}
-
-	return &rulesv1.CreateRuleGroupRequest_CreateRuleSubgroup_CreateRule{
-		Name:        wrapperspb.String(m["name"].(string)),
-		Description: wrapperspb.String(m["description"].(string)),
-		SourceField: func() *wrapperspb.StringValue {
-			if sourceFieldObj, ok := m["source_field"]; ok {
-				return wrapperspb.String(sourceFieldObj.(string))
-			}
-			return wrapperspb.String("text")
-		}(),
-		Enabled:    wrapperspb.Bool(m["active"].(bool)),
-		Order:      order,
-		Parameters: expandParameters(rulesType, m),
-	}
-}
-
-func expandParameters(ruleType string, m map[string]interface{}) *rulesv1.RuleParameters {
-	var ruleParameters rulesv1.RuleParameters
-
-	switch ruleType {
-	case "parse":
-		destinationField := wrapperspb.String(m["destination_field"].(string))
-		rule := wrapperspb.String(m["regular_expression"].(string))
-		parseParameters := rulesv1.ParseParameters{DestinationField: destinationField, Rule: rule}
-		ruleParametersParseParameters := rulesv1.RuleParameters_ParseParameters{ParseParameters: &parseParameters}
-		ruleParameters.RuleParameters = &ruleParametersParseParameters
-	case "extract":
-		rule := wrapperspb.String(m["regular_expression"].(string))
-		extractParameters := rulesv1.ExtractParameters{Rule: rule}
-		ruleParametersExtractParameters := rulesv1.RuleParameters_ExtractParameters{ExtractParameters: &extractParameters}
-		ruleParameters.RuleParameters = &ruleParametersExtractParameters
-	case "json_extract":
-		destinationField := expandDestinationField(m["destination_field"].(string))
-		rule := wrapperspb.String(m["json_key"].(string))
-		jsonExtractParameters := rulesv1.JsonExtractParameters{DestinationField: destinationField, Rule: rule}
-		ruleParametersJsonExtractParameters := rulesv1.RuleParameters_JsonExtractParameters{JsonExtractParameters: &jsonExtractParameters}
-		ruleParameters.RuleParameters = &ruleParametersJsonExtractParameters
-	case "replace":
-		destinationField := wrapperspb.String(m["destination_field"].(string))
-		replaceNewVal := wrapperspb.String(m["replacement_string"].(string))
-		rule := wrapperspb.String(m["regular_expression"].(string))
-		replaceParameters := rulesv1.ReplaceParameters{DestinationField: destinationField, ReplaceNewVal: replaceNewVal, Rule: rule}
-		ruleParametersReplaceParameters := rulesv1.RuleParameters_ReplaceParameters{ReplaceParameters: &replaceParameters}
-		ruleParameters.RuleParameters = &ruleParametersReplaceParameters
-	case "block":
-		keepBlockedLogs := wrapperspb.Bool(m["keep_blocked_logs"].(bool))
-		rule := wrapperspb.String(m["regular_expression"].(string))
-		if m["blocking_all_matching_blocks"].(bool) {
-			blockParameters := rulesv1.BlockParameters{KeepBlockedLogs: keepBlockedLogs, Rule: rule}
-			ruleParametersBlockParameters := rulesv1.RuleParameters_BlockParameters{BlockParameters: &blockParameters}
-			ruleParameters.RuleParameters = &ruleParametersBlockParameters
+func expandRule(ctx context.Context, rule RuleModel) (*rulesv1.CreateRuleGroupRequest_CreateRuleSubgroup_CreateRule, diag.Diagnostics) {
+	var name, description types.String
+	var active types.Bool
+	sourceField := types.StringValue("text")
+	var parameters rulesv1.RuleParameters
+	switch {
+	case rule.Parse != nil:
+		name, description, active, sourceField = rule.Parse.Name, rule.Parse.Description, rule.Parse.Active, rule.Parse.SourceField
+		parameters.RuleParameters = &rulesv1.RuleParameters_ParseParameters{ParseParameters: &rulesv1.ParseParameters{
+			DestinationField: typeStringToWrapperspbString(rule.Parse.DestinationField),
+			Rule:             typeStringToWrapperspbString(rule.Parse.RegularExpression),
+		}}
+	case rule.Extract != nil:
+		name, description, active, sourceField = rule.Extract.Name, rule.Extract.Description, rule.Extract.Active, rule.Extract.SourceField
+		parameters.RuleParameters = &rulesv1.RuleParameters_ExtractParameters{ExtractParameters: &rulesv1.ExtractParameters{
+			Rule: typeStringToWrapperspbString(rule.Extract.RegularExpression),
+		}}
+	case rule.JsonExtract != nil:
+		name, description, active = rule.JsonExtract.Name, rule.JsonExtract.Description, rule.JsonExtract.Active
+		parameters.RuleParameters = &rulesv1.RuleParameters_JsonExtractParameters{JsonExtractParameters: &rulesv1.JsonExtractParameters{
+			DestinationField: expandDestinationField(rule.JsonExtract.DestinationField.ValueString()),
+			Rule:             typeStringToWrapperspbString(rule.JsonExtract.JsonKey),
+		}}
+	case rule.Replace != nil:
+		name, description, active, sourceField = rule.Replace.Name, rule.Replace.Description, rule.Replace.Active, rule.Replace.SourceField
+		parameters.RuleParameters = &rulesv1.RuleParameters_ReplaceParameters{ReplaceParameters: &rulesv1.ReplaceParameters{
+			DestinationField: typeStringToWrapperspbString(rule.Replace.DestinationField),
+			ReplaceNewVal:    wrapperspb.String(rule.Replace.ReplacementString.ValueString()),
+			Rule:             typeStringToWrapperspbString(rule.Replace.RegularExpression),
+		}}
+	case rule.Block != nil:
+		name, description, active, sourceField = rule.Block.Name, rule.Block.Description, rule.Block.Active, rule.Block.SourceField
+		keepBlockedLogs := wrapperspb.Bool(rule.Block.KeepBlockedLogs.ValueBool())
+		regularExpression := typeStringToWrapperspbString(rule.Block.RegularExpression)
+		if rule.Block.BlockingAllMatchingBlocks.ValueBool() {
+			parameters.RuleParameters = &rulesv1.RuleParameters_BlockParameters{BlockParameters: &rulesv1.BlockParameters{
+				KeepBlockedLogs: keepBlockedLogs,
+				Rule:            regularExpression,
+			}}
 		} else {
-			allowParameters := rulesv1.AllowParameters{KeepBlockedLogs: keepBlockedLogs, Rule: rule}
-			ruleParametersAllowParameters := rulesv1.RuleParameters_AllowParameters{AllowParameters: &allowParameters}
-			ruleParameters.RuleParameters = &ruleParametersAllowParameters
+			parameters.RuleParameters = &rulesv1.RuleParameters_AllowParameters{AllowParameters: &rulesv1.AllowParameters{
+				KeepBlockedLogs: keepBlockedLogs,
+				Rule:            regularExpression,
+			}}
 		}
-	case "extract_timestamp":
-		standard := expandFieldFormatStandard(m["field_format_standard"].(string))
-		format := wrapperspb.String(m["time_format"].(string))
-		extractTimestampParameters := rulesv1.ExtractTimestampParameters{Format: format, Standard: standard}
-		ruleParametersExtractTimestampParameters := rulesv1.RuleParameters_ExtractTimestampParameters{ExtractTimestampParameters: &extractTimestampParameters}
-		ruleParameters.RuleParameters = &ruleParametersExtractTimestampParameters
-	case "remove_fields":
-		excludedFields := interfaceSliceToStringSlice(m["excluded_fields"].([]interface{}))
-		removeFieldsParameters := rulesv1.RemoveFieldsParameters{Fields: excludedFields}
-		ruleParametersRemoveFieldsParameters := rulesv1.RuleParameters_RemoveFieldsParameters{RemoveFieldsParameters: &removeFieldsParameters}
-		ruleParameters.RuleParameters = &ruleParametersRemoveFieldsParameters
-	case "json_stringify":
-		destinationField := wrapperspb.String(m["destination_field"].(string))
-		deleteSource := wrapperspb.Bool(!m["keep_source_field"].(bool))
-		jsonStringifyParameters := rulesv1.JsonStringifyParameters{DestinationField: destinationField, DeleteSource: deleteSource}
-		ruleParametersJsonStringifyParameters := rulesv1.RuleParameters_JsonStringifyParameters{JsonStringifyParameters: &jsonStringifyParameters}
-		ruleParameters.RuleParameters = &ruleParametersJsonStringifyParameters
-	case "parse_json_field":
-		destinationField := wrapperspb.String(m["destination_field"].(string))
-		deleteSource := wrapperspb.Bool(!m["keep_source_field"].(bool))
-		overrideDest := wrapperspb.Bool(!m["keep_destination_field"].(bool))
-		escapedValue := wrapperspb.Bool(true)
-		jsonParseParameters := rulesv1.JsonParseParameters{
-			DestinationField: destinationField,
-			DeleteSource:     deleteSource,
-			EscapedValue:     escapedValue,
-			OverrideDest:     overrideDest,
+	case rule.ExtractTimestamp != nil:
+		name, description, active, sourceField = rule.ExtractTimestamp.Name, rule.ExtractTimestamp.Description, rule.ExtractTimestamp.Active, rule.ExtractTimestamp.SourceField
+		parameters.RuleParameters = &rulesv1.RuleParameters_ExtractTimestampParameters{ExtractTimestampParameters: &rulesv1.ExtractTimestampParameters{
+			Format:   typeStringToWrapperspbString(rule.ExtractTimestamp.TimeFormat),
+			Standard: expandFieldFormatStandard(rule.ExtractTimestamp.FieldFormatStandard.ValueString()),
+		}}
+	case rule.RemoveFields != nil:
+		name, description, active = rule.RemoveFields.Name, rule.RemoveFields.Description, rule.RemoveFields.Active
+		var excludedFields []string
+		if diags := rule.RemoveFields.ExcludedFields.ElementsAs(ctx, &excludedFields, false); diags.HasError() {
+			return nil, diags
 		}
-		ruleParametersJsonStringifyParameters := rulesv1.RuleParameters_JsonParseParameters{JsonParseParameters: &jsonParseParameters}
-		ruleParameters.RuleParameters = &ruleParametersJsonStringifyParameters
+		parameters.RuleParameters = &rulesv1.RuleParameters_RemoveFieldsParameters{RemoveFieldsParameters: &rulesv1.RemoveFieldsParameters{
+			Fields: excludedFields,
+		}}
+	case rule.JsonStringify != nil:
+		name, description, active, sourceField = rule.JsonStringify.Name, rule.JsonStringify.Description, rule.JsonStringify.Active, rule.JsonStringify.SourceField
+		parameters.RuleParameters = &rulesv1.RuleParameters_JsonStringifyParameters{JsonStringifyParameters: &rulesv1.JsonStringifyParameters{
+			DestinationField: typeStringToWrapperspbString(rule.JsonStringify.DestinationField),
+			DeleteSource:     wrapperspb.Bool(!rule.JsonStringify.KeepSourceField.ValueBool()),
+		}}
+	case rule.ParseJsonField != nil:
+		name, description, active, sourceField = rule.ParseJsonField.Name, rule.ParseJsonField.Description, rule.ParseJsonField.Active, rule.ParseJsonField.SourceField
+		parameters.RuleParameters = &rulesv1.RuleParameters_JsonParseParameters{JsonParseParameters: &rulesv1.JsonParseParameters{
+			DestinationField: typeStringToWrapperspbString(rule.ParseJsonField.DestinationField),
+			DeleteSource:     wrapperspb.Bool(!rule.ParseJsonField.KeepSourceField.ValueBool()),
+			EscapedValue:     wrapperspb.Bool(true),
+			OverrideDest:     wrapperspb.Bool(!rule.ParseJsonField.KeepDestinationField.ValueBool()),
+		}}
 	default:
-		panic(ruleType)
+		var diags diag.Diagnostics
+		diags.AddError("Invalid rule", fmt.Sprintf("exactly one of %q must be provided inside rule", rulesTypes))
+		return nil, diags
 	}
 
-	return &ruleParameters
+	return &rulesv1.CreateRuleGroupRequest_CreateRuleSubgroup_CreateRule{
+		Name:        typeStringToWrapperspbString(name),
+		Description: wrapperspb.String(description.ValueString()),
+		SourceField: typeStringToWrapperspbString(sourceField),
+		Parameters:  &parameters,
+		Enabled:     wrapperspb.Bool(active.ValueBool()),
+	}, nil
 }
 
 func expandDestinationField(destinationField string) rulesv1.JsonExtractParameters_DestinationField {
@@ -831,7 +995,40 @@ func expandFieldFormatStandard(formatStandard string) rulesv1.ExtractTimestampPa
 	return rulesv1.ExtractTimestampParameters_FormatStandard(formatStandardVal)
 }
 
-func flattenRuleMatcher(ruleMatchers []*rulesv1.RuleMatcher) (map[string][]string, error) {
+// flattenRuleGroup converts the rule-group into the resource model. The API returns an empty creator when it wasn't
+// set, so the current creator is used to tell an unset creator from an empty one.
+func flattenRuleGroup(ctx context.Context, ruleGroup *rulesv1.RuleGroup, currentCreator types.String) (RulesGroupResourceModel, diag.Diagnostics) {
+	ruleMatchers, diags := flattenRuleMatchers(ruleGroup.GetRuleMatchers())
+	if diags.HasError() {
+		return RulesGroupResourceModel{}, diags
+	}
+	ruleSubgroups, diags := flattenRuleSubgroups(ctx, ruleGroup.GetRuleSubgroups())
+	if diags.HasError() {
+		return RulesGroupResourceModel{}, diags
+	}
+
+	creator := types.StringValue(ruleGroup.GetCreator().GetValue())
+	if creator.ValueString() == "" && currentCreator.IsNull() {
+		creator = types.StringNull()
+	}
+
+	return RulesGroupResourceModel{
+		ID:            types.StringValue(ruleGroup.GetId().GetValue()),
+		Name:          types.StringValue(ruleGroup.GetName().GetValue()),
+		Description:   types.StringValue(ruleGroup.GetDescription().GetValue()),
+		Active:        types.BoolValue(ruleGroup.GetEnabled().GetValue()),
+		Applications:  stringSliceToTypeStringSet(ruleMatchers["applications"]),
+		Subsystems:    stringSliceToTypeStringSet(ruleMatchers["subsystems"]),
+		Severities:    stringSliceToTypeStringSet(ruleMatchers["severities"]),
+		Hidden:        types.BoolValue(ruleGroup.GetHidden().GetValue()),
+		Creator:       creator,
+		Order:         types.Int64Value(int64(ruleGroup.GetOrder().GetValue())),
+		RuleSubgroups: ruleSubgroups,
+	}, nil
+}
+
+func flattenRuleMatchers(ruleMatchers []*rulesv1.RuleMatcher) (map[string][]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
 	ruleMatcherMap := map[string][]string{"applications": {}, "subsystems": {}, "severities": {}}
 	for _, ruleMatcher := range ruleMatchers {
 		switch ruleMatcher.Constraint.(type) {
@@ -845,132 +1042,145 @@ func flattenRuleMatcher(ruleMatchers []*rulesv1.RuleMatcher) (map[string][]strin
 			severityStr := ruleMatcher.GetSeverity().GetValue().String()
 			ruleMatcherMap["severities"] = append(ruleMatcherMap["severities"], rulesProtoSeverityToSchemaSeverity[severityStr])
 		default:
-			return nil, fmt.Errorf("unexpected type %T for rule matcher", ruleMatcher)
+			diags.AddError("Error flattening Rules Group", fmt.Sprintf("unexpected type %T for rule matcher", ruleMatcher.Constraint))
 		}
 	}
-	return ruleMatcherMap, nil
+	return ruleMatcherMap, diags
 }
 
-func flattenRuleSubgroups(ruleSubgroups []*rulesv1.RuleSubgroup) ([]interface{}, error) {
-	result := make([]interface{}, 0, len(ruleSubgroups))
-	for _, ruleSubgroup := range ruleSubgroups {
-		if rsg, err := flattenRuleGroup(ruleSubgroup); err != nil {
-			return nil, err
-		} else {
-			result = append(result, rsg)
-		}
-	}
-	return result, nil
-}
-
-func flattenRuleGroup(ruleSubgroup *rulesv1.RuleSubgroup) (map[string]interface{}, error) {
-	rules, err := flattenRules(ruleSubgroup)
-	if err != nil {
-		return nil, err
+func flattenRuleSubgroups(ctx context.Context, ruleSubgroups []*rulesv1.RuleSubgroup) ([]RuleSubgroupModel, diag.Diagnostics) {
+	if len(ruleSubgroups) == 0 {
+		return nil, nil
 	}
 
-	rsg := map[string]interface{}{
-		"id":     ruleSubgroup.GetId().GetValue(),
-		"order":  ruleSubgroup.GetOrder().GetValue(),
-		"active": ruleSubgroup.GetEnabled().GetValue(),
-		"rules":  rules,
-	}
-
-	return rsg, nil
-}
-
-func flattenRules(ruleSubgroup *rulesv1.RuleSubgroup) ([]interface{}, error) {
-	rs := ruleSubgroup.GetRules()
-	rules := make([]interface{}, 0, len(rs))
-	for _, r := range rs {
-		var err error
-		rule, err := flattenRule(r)
-		if err != nil {
-			return nil, err
+	var diags diag.Diagnostics
+	result := make([]RuleSubgroupModel, 0, len(ruleSubgroups))
+	for _, ruleSubgroup := range ruleSubgroups {
+		rules := make([]RuleModel, 0, len(ruleSubgroup.GetRules()))
+		for _, r := range ruleSubgroup.GetRules() {
+			rule, dgs := flattenRule(ctx, r)
+			if dgs.HasError() {
+				diags.Append(dgs...)
+				continue
+			}
+			rules = append(rules, rule)
 		}
-		rules = append(rules, rule)
+		result = append(result, RuleSubgroupModel{
+			ID:     types.StringValue(ruleSubgroup.GetId().GetValue()),
+			Active: types.BoolValue(ruleSubgroup.GetEnabled().GetValue()),
+			Rules:  rules,
+		})
 	}
-	return rules, nil
+	return result, diags
 }
 
-func flattenRule(r *rulesv1.Rule) (map[string]interface{}, error) {
-	rule := flattenCommonRulesParams(r)
-	var ruleType string
-	ruleParams := r.GetParameters().GetRuleParameters()
-	switch ruleParams := ruleParams.(type) {
+func flattenRule(ctx context.Context, r *rulesv1.Rule) (RuleModel, diag.Diagnostics) {
+	rule := RuleModel{ID: types.StringValue(r.GetId().GetValue())}
+	name := types.StringValue(r.GetName().GetValue())
+	description := types.StringValue(r.GetDescription().GetValue())
+	active := types.BoolValue(r.GetEnabled().GetValue())
+	sourceField := types.StringValue(r.GetSourceField().GetValue())
+
+	switch ruleParams := r.GetParameters().GetRuleParameters().(type) {
 	case *rulesv1.RuleParameters_ExtractParameters:
-		ruleType = "extract"
-		extractParameters := ruleParams.ExtractParameters
-		rule["regular_expression"] = extractParameters.GetRule().GetValue()
-		rule["source_field"] = r.GetSourceField().GetValue()
+		rule.Extract = &ExtractRuleModel{
+			Name:              name,
+			Description:       description,
+			Active:            active,
+			SourceField:       sourceField,
+			RegularExpression: types.StringValue(ruleParams.ExtractParameters.GetRule().GetValue()),
+		}
 	case *rulesv1.RuleParameters_JsonExtractParameters:
-		ruleType = "json_extract"
-		jsonExtractParameters := ruleParams.JsonExtractParameters
-		rule["json_key"] = jsonExtractParameters.GetRule().GetValue()
-		rule["destination_field"] = rulesProtoDestinationFieldToSchemaDestinationField[jsonExtractParameters.GetDestinationField().String()]
+		rule.JsonExtract = &JsonExtractRuleModel{
+			Name:             name,
+			Description:      description,
+			Active:           active,
+			DestinationField: types.StringValue(rulesProtoDestinationFieldToSchemaDestinationField[ruleParams.JsonExtractParameters.GetDestinationField().String()]),
+			JsonKey:          types.StringValue(ruleParams.JsonExtractParameters.GetRule().GetValue()),
+		}
 	case *rulesv1.RuleParameters_ReplaceParameters:
-		ruleType = "replace"
-		replaceParameters := ruleParams.ReplaceParameters
-		rule["source_field"] = r.GetSourceField().GetValue()
-		rule["destination_field"] = replaceParameters.GetDestinationField().GetValue()
-		rule["regular_expression"] = replaceParameters.GetRule().GetValue()
-		rule["replacement_string"] = replaceParameters.GetReplaceNewVal().GetValue()
+		rule.Replace = &ReplaceRuleModel{
+			Name:              name,
+			Description:       description,
+			Active:            active,
+			SourceField:       sourceField,
+			DestinationField:  types.StringValue(ruleParams.ReplaceParameters.GetDestinationField().GetValue()),
+			RegularExpression: types.StringValue(ruleParams.ReplaceParameters.GetRule().GetValue()),
+			ReplacementString: types.StringValue(ruleParams.ReplaceParameters.GetReplaceNewVal().GetValue()),
+		}
 	case *rulesv1.RuleParameters_ParseParameters:
-		ruleType = "parse"
-		parseParameters := ruleParams.ParseParameters
-		rule["source_field"] = r.GetSourceField().GetValue()
-		rule["destination_field"] = parseParameters.GetDestinationField().GetValue()
-		rule["regular_expression"] = parseParameters.GetRule().GetValue()
+		rule.Parse = &ParseRuleModel{
+			Name:              name,
+			Description:       description,
+			Active:            active,
+			SourceField:       sourceField,
+			DestinationField:  types.StringValue(ruleParams.ParseParameters.GetDestinationField().GetValue()),
+			RegularExpression: types.StringValue(ruleParams.ParseParameters.GetRule().GetValue()),
+		}
 	case *rulesv1.RuleParameters_AllowParameters:
-		ruleType = "block"
-		allowParameters := ruleParams.AllowParameters
-		rule["source_field"] = r.GetSourceField().GetValue()
-		rule["regular_expression"] = allowParameters.GetRule().GetValue()
-		rule["keep_blocked_logs"] = allowParameters.GetKeepBlockedLogs().GetValue()
-		rule["blocking_all_matching_blocks"] = false
+		rule.Block = &BlockRuleModel{
+			Name:                      name,
+			Description:               description,
+			Active:                    active,
+			SourceField:               sourceField,
+			RegularExpression:         types.StringValue(ruleParams.AllowParameters.GetRule().GetValue()),
+			KeepBlockedLogs:           types.BoolValue(ruleParams.AllowParameters.GetKeepBlockedLogs().GetValue()),
+			BlockingAllMatchingBlocks: types.BoolValue(false),
+		}
 	case *rulesv1.RuleParameters_BlockParameters:
-		ruleType = "block"
-		blockParameters := ruleParams.BlockParameters
-		rule["source_field"] = r.GetSourceField().GetValue()
-		rule["regular_expression"] = blockParameters.GetRule().GetValue()
-		rule["keep_blocked_logs"] = blockParameters.GetKeepBlockedLogs().GetValue()
-		rule["blocking_all_matching_blocks"] = true
+		rule.Block = &BlockRuleModel{
+			Name:                      name,
+			Description:               description,
+			Active:                    active,
+			SourceField:               sourceField,
+			RegularExpression:         types.StringValue(ruleParams.BlockParameters.GetRule().GetValue()),
+			KeepBlockedLogs:           types.BoolValue(ruleParams.BlockParameters.GetKeepBlockedLogs().GetValue()),
+			BlockingAllMatchingBlocks: types.BoolValue(true),
+		}
 	case *rulesv1.RuleParameters_ExtractTimestampParameters:
-		ruleType = "extract_timestamp"
-		extractTimestampParameters := ruleParams.ExtractTimestampParameters
-		rule["source_field"] = r.GetSourceField().GetValue()
-		rule["time_format"] = extractTimestampParameters.GetFormat().GetValue()
-		rule["field_format_standard"] = rulesProtoFormatStandardToSchemaFormatStandard[extractTimestampParameters.GetStandard().String()]
+		rule.ExtractTimestamp = &ExtractTimestampRuleModel{
+			Name:                name,
+			Description:         description,
+			Active:              active,
+			SourceField:         sourceField,
+			FieldFormatStandard: types.StringValue(rulesProtoFormatStandardToSchemaFormatStandard[ruleParams.ExtractTimestampParameters.GetStandard().String()]),
+			TimeFormat:          types.StringValue(ruleParams.ExtractTimestampParameters.GetFormat().GetValue()),
+		}
 	case *rulesv1.RuleParameters_RemoveFieldsParameters:
-		ruleType = "remove_fields"
-		removeFieldsParameters := ruleParams.RemoveFieldsParameters
-		rule["excluded_fields"] = removeFieldsParameters.GetFields()
+		excludedFields, diags := types.ListValueFrom(ctx, types.StringType, ruleParams.RemoveFieldsParameters.GetFields())
+		if diags.HasError() {
+			return RuleModel{}, diags
+		}
+		rule.RemoveFields = &RemoveFieldsRuleModel{
+			Name:           name,
+			Description:    description,
+			Active:         active,
+			ExcludedFields: excludedFields,
+		}
 	case *rulesv1.RuleParameters_JsonStringifyParameters:
-		ruleType = "json_stringify"
-		jsonStringifyParameters := ruleParams.JsonStringifyParameters
-		rule["source_field"] = r.GetSourceField().GetValue()
-		rule["destination_field"] = jsonStringifyParameters.GetDestinationField().GetValue()
-		rule["keep_source_field"] = !(jsonStringifyParameters.GetDeleteSource().GetValue())
+		rule.JsonStringify = &JsonStringifyRuleModel{
+			Name:             name,
+			Description:      description,
+			Active:           active,
+			SourceField:      sourceField,
+			DestinationField: types.StringValue(ruleParams.JsonStringifyParameters.GetDestinationField().GetValue()),
+			KeepSourceField:  types.BoolValue(!ruleParams.JsonStringifyParameters.GetDeleteSource().GetValue()),
+		}
 	case *rulesv1.RuleParameters_JsonParseParameters:
-		ruleType = "parse_json_field"
-		jsonParseParameters := ruleParams.JsonParseParameters
-		rule["source_field"] = r.GetSourceField().GetValue()
-		rule["destination_field"] = jsonParseParameters.GetDestinationField().GetValue()
-		rule["keep_source_field"] = !(jsonParseParameters.GetDeleteSource().GetValue())
-		rule["keep_destination_field"] = !(jsonParseParameters.GetOverrideDest().GetValue())
+		rule.ParseJsonField = &ParseJsonFieldRuleModel{
+			Name:                 name,
+			Description:          description,
+			Active:               active,
+			SourceField:          sourceField,
+			DestinationField:     types.StringValue(ruleParams.JsonParseParameters.GetDestinationField().GetValue()),
+			KeepSourceField:      types.BoolValue(!ruleParams.JsonParseParameters.GetDeleteSource().GetValue()),
+			KeepDestinationField: types.BoolValue(!ruleParams.JsonParseParameters.GetOverrideDest().GetValue()),
+		}
 	default:
-		return nil, fmt.Errorf("unexpected type %T for r parameters", ruleParams)
+		var diags diag.Diagnostics
+		diags.AddError("Error flattening Rules Group", fmt.Sprintf("unexpected type %T for rule parameters", ruleParams))
+		return RuleModel{}, diags
 	}
 
-	return map[string]interface{}{ruleType: []interface{}{rule}}, nil
-}
-
-func flattenCommonRulesParams(rule *rulesv1.Rule) map[string]interface{} {
-	return map[string]interface{}{
-		"id":          rule.GetId().GetValue(),
-		"description": rule.GetDescription().GetValue(),
-		"name":        rule.GetName().GetValue(),
-		"active":      rule.GetEnabled().GetValue(),
-		"order":       rule.GetOrder().GetValue(),
-	}
+	return rule, nil
 }
@@ -3,15 +3,21 @@ package coralogix
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 
 	"terraform-provider-coralogix/coralogix/clientset"
 	rulesgroups "terraform-provider-coralogix/coralogix/clientset/grpc/rules-groups/v1"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 /*
@@ -21,7 +27,7 @@ func TestAccCoralogixResourceRuleGroup_minimal(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		CheckDestroy:      testAccCheckRuleGroupDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -47,9 +53,9 @@ func TestAccCoralogixResourceRuleGroup_block(t *testing.T) {
 	regEx := `sql_error_code\\s*=\\s*28000`
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckRuleGroupDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCoralogixResourceRuleGroupBlock(r, regEx, keepBlockedLogs),
@@ -61,15 +67,14 @@ func TestAccCoralogixResourceRuleGroup_block(t *testing.T) {
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "name", r.name),
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "creator", r.creator),
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "description", r.description),
-					resource.TestCheckResourceAttrSet(rulesGroupResourceName, "rule_subgroups.0.rules.0.block.0.id"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.block.0.order", "1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.block.0.active", "true"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.block.0.name", r.ruleParams.name),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.block.0.description", r.ruleParams.description),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.block.0.source_field", "text"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.block.0.keep_blocked_logs", keepBlockedLogs),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.block.0.blocking_all_matching_blocks", "true"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.block.0.regular_expression", "sql_error_code\\s*=\\s*28000"),
+					resource.TestCheckResourceAttrSet(rulesGroupResourceName, "rule_subgroups.0.rules.0.id"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.block.active", "true"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.block.name", r.ruleParams.name),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.block.description", r.ruleParams.description),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.block.source_field", "text"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.block.keep_blocked_logs", keepBlockedLogs),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.block.blocking_all_matching_blocks", "true"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.block.regular_expression", "sql_error_code\\s*=\\s*28000"),
 				),
 			},
 			{
@@ -88,9 +93,9 @@ func TestAccCoralogixResourceRuleGroup_allow(t *testing.T) {
 	regEx := `sql_error_code\\s*=\\s*28000`
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckRuleGroupDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCoralogixResourceRuleGroupAllow(r, regEx, keepBlockedLogs),
@@ -102,14 +107,13 @@ func TestAccCoralogixResourceRuleGroup_allow(t *testing.T) {
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "name", r.name),
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "creator", r.creator),
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "description", r.description),
-					resource.TestCheckResourceAttrSet(rulesGroupResourceName, "rule_subgroups.0.rules.0.block.0.id"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.block.0.order", "1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.block.0.active", "true"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.block.0.name", r.ruleParams.name),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.block.0.description", r.ruleParams.description),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.block.0.keep_blocked_logs", keepBlockedLogs),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.block.0.blocking_all_matching_blocks", "false"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.block.0.regular_expression", "sql_error_code\\s*=\\s*28000"),
+					resource.TestCheckResourceAttrSet(rulesGroupResourceName, "rule_subgroups.0.rules.0.id"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.block.active", "true"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.block.name", r.ruleParams.name),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.block.description", r.ruleParams.description),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.block.keep_blocked_logs", keepBlockedLogs),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.block.blocking_all_matching_blocks", "false"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.block.regular_expression", "sql_error_code\\s*=\\s*28000"),
 				),
 			},
 			{
@@ -128,9 +132,9 @@ func TestAccCoralogixResourceRuleGroup_jsonExtract(t *testing.T) {
 	destinationField := "Category"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckRuleGroupDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCoralogixResourceRuleGroupJsonExtract(r, jsonKey, destinationField),
@@ -142,13 +146,12 @@ func TestAccCoralogixResourceRuleGroup_jsonExtract(t *testing.T) {
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "name", r.name),
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "creator", r.creator),
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "description", r.description),
-					resource.TestCheckResourceAttrSet(rulesGroupResourceName, "rule_subgroups.0.rules.0.json_extract.0.id"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.json_extract.0.order", "1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.json_extract.0.active", "true"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.json_extract.0.name", r.ruleParams.name),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.json_extract.0.description", r.ruleParams.description),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.json_extract.0.destination_field", destinationField),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.json_extract.0.json_key", jsonKey),
+					resource.TestCheckResourceAttrSet(rulesGroupResourceName, "rule_subgroups.0.rules.0.id"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.json_extract.active", "true"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.json_extract.name", r.ruleParams.name),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.json_extract.description", r.ruleParams.description),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.json_extract.destination_field", destinationField),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.json_extract.json_key", jsonKey),
 				),
 			},
 			{
@@ -169,9 +172,9 @@ func TestAccCoralogixResourceRuleGroup_replace(t *testing.T) {
 	resourceName := "coralogix_rules_group.test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckRuleGroupDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCoralogixResourceRuleGroupReplace(r, regEx, replacementString),
@@ -183,15 +186,14 @@ func TestAccCoralogixResourceRuleGroup_replace(t *testing.T) {
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "name", r.name),
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "creator", r.creator),
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "description", r.description),
-					resource.TestCheckResourceAttrSet(rulesGroupResourceName, "rule_subgroups.0.rules.0.replace.0.id"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.replace.0.order", "1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.replace.0.active", "true"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.replace.0.name", r.ruleParams.name),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.replace.0.description", r.ruleParams.description),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.replace.0.source_field", "text"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.replace.0.destination_field", "text"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.replace.0.regular_expression", regEx),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.replace.0.replacement_string", replacementString),
+					resource.TestCheckResourceAttrSet(rulesGroupResourceName, "rule_subgroups.0.rules.0.id"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.replace.active", "true"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.replace.name", r.ruleParams.name),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.replace.description", r.ruleParams.description),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.replace.source_field", "text"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.replace.destination_field", "text"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.replace.regular_expression", regEx),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.replace.replacement_string", replacementString),
 				),
 			},
 			{
@@ -213,9 +215,9 @@ func TestAccCoralogixResourceRuleGroup_extractTimestamp(t *testing.T) {
 	resourceName := "coralogix_rules_group.test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckRuleGroupDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCoralogixResourceRuleGroupExtractTimestamp(r, timeFormat, fieldFormatStandard),
@@ -227,13 +229,12 @@ func TestAccCoralogixResourceRuleGroup_extractTimestamp(t *testing.T) {
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "name", r.name),
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "creator", r.creator),
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "description", r.description),
-					resource.TestCheckResourceAttrSet(rulesGroupResourceName, "rule_subgroups.0.rules.0.extract_timestamp.0.id"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.extract_timestamp.0.order", "1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.extract_timestamp.0.active", "true"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.extract_timestamp.0.name", r.ruleParams.name),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.extract_timestamp.0.description", r.ruleParams.description),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.extract_timestamp.0.time_format", timeFormat),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.extract_timestamp.0.field_format_standard", fieldFormatStandard),
+					resource.TestCheckResourceAttrSet(rulesGroupResourceName, "rule_subgroups.0.rules.0.id"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.extract_timestamp.active", "true"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.extract_timestamp.name", r.ruleParams.name),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.extract_timestamp.description", r.ruleParams.description),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.extract_timestamp.time_format", timeFormat),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.extract_timestamp.field_format_standard", fieldFormatStandard),
 				),
 			},
 			{
@@ -253,9 +254,9 @@ func TestAccCoralogixResourceRuleGroup_removeFields(t *testing.T) {
 	resourceName := "coralogix_rules_group.test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckRuleGroupDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCoralogixResourceRuleGroupRemoveFields(r, excludedFields),
@@ -267,13 +268,12 @@ func TestAccCoralogixResourceRuleGroup_removeFields(t *testing.T) {
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "name", r.name),
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "creator", r.creator),
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "description", r.description),
-					resource.TestCheckResourceAttrSet(rulesGroupResourceName, "rule_subgroups.0.rules.0.remove_fields.0.id"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.remove_fields.0.order", "1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.remove_fields.0.active", "true"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.remove_fields.0.name", r.ruleParams.name),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.remove_fields.0.description", r.ruleParams.description),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.remove_fields.0.excluded_fields.0", "coralogix.metadata.applicationName"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.remove_fields.0.excluded_fields.1", "coralogix.metadata.className"),
+					resource.TestCheckResourceAttrSet(rulesGroupResourceName, "rule_subgroups.0.rules.0.id"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.remove_fields.active", "true"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.remove_fields.name", r.ruleParams.name),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.remove_fields.description", r.ruleParams.description),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.remove_fields.excluded_fields.0", "coralogix.metadata.applicationName"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.remove_fields.excluded_fields.1", "coralogix.metadata.className"),
 				),
 			},
 			{
@@ -291,9 +291,9 @@ func TestAccCoralogixResourceRuleGroup_jsonStringify(t *testing.T) {
 	keepSourceField := "true"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckRuleGroupDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCoralogixResourceRuleGroupJsonStringify(r, keepSourceField),
@@ -305,14 +305,13 @@ func TestAccCoralogixResourceRuleGroup_jsonStringify(t *testing.T) {
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "name", r.name),
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "creator", r.creator),
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "description", r.description),
-					resource.TestCheckResourceAttrSet(rulesGroupResourceName, "rule_subgroups.0.rules.0.json_stringify.0.id"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.json_stringify.0.order", "1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.json_stringify.0.active", "true"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.json_stringify.0.name", r.ruleParams.name),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.json_stringify.0.description", r.ruleParams.description),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.json_stringify.0.source_field", "text"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.json_stringify.0.destination_field", "text"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.json_stringify.0.keep_source_field", keepSourceField),
+					resource.TestCheckResourceAttrSet(rulesGroupResourceName, "rule_subgroups.0.rules.0.id"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.json_stringify.active", "true"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.json_stringify.name", r.ruleParams.name),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.json_stringify.description", r.ruleParams.description),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.json_stringify.source_field", "text"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.json_stringify.destination_field", "text"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.json_stringify.keep_source_field", keepSourceField),
 				),
 			},
 			{
@@ -332,9 +331,9 @@ func TestAccCoralogixResourceRuleGroup_extract(t *testing.T) {
 	resourceName := "coralogix_rules_group.test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckRuleGroupDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCoralogixResourceRuleGroupExtract(r, regEx),
@@ -346,13 +345,12 @@ func TestAccCoralogixResourceRuleGroup_extract(t *testing.T) {
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "name", r.name),
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "creator", r.creator),
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "description", r.description),
-					resource.TestCheckResourceAttrSet(rulesGroupResourceName, "rule_subgroups.0.rules.0.extract.0.id"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.extract.0.order", "1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.extract.0.active", "true"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.extract.0.name", r.ruleParams.name),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.extract.0.description", r.ruleParams.description),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.extract.0.source_field", "text"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.extract.0.regular_expression",
+					resource.TestCheckResourceAttrSet(rulesGroupResourceName, "rule_subgroups.0.rules.0.id"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.extract.active", "true"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.extract.name", r.ruleParams.name),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.extract.description", r.ruleParams.description),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.extract.source_field", "text"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.extract.regular_expression",
 						"\\b(?P<severity>DEBUG|TRACE|INFO|WARN|ERROR|FATAL|EXCEPTION|[Dd]ebug|[Tt]race|[Ii]nfo|[Ww]arn|[Ee]rror|[Ff]atal|[Ee]xception)\\b"),
 				),
 			},
@@ -373,9 +371,9 @@ func TestAccCoralogixResourceRuleGroup_parse(t *testing.T) {
 	resourceName := "coralogix_rules_group.test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckRuleGroupDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCoralogixResourceRuleGroupParse(r, regEx),
@@ -387,14 +385,13 @@ func TestAccCoralogixResourceRuleGroup_parse(t *testing.T) {
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "name", r.name),
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "creator", r.creator),
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "description", r.description),
-					resource.TestCheckResourceAttrSet(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse.0.id"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse.0.order", "1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse.0.active", "true"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse.0.name", r.ruleParams.name),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse.0.description", r.ruleParams.description),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse.0.source_field", "text"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse.0.destination_field", "text"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse.0.regular_expression",
+					resource.TestCheckResourceAttrSet(rulesGroupResourceName, "rule_subgroups.0.rules.0.id"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse.active", "true"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse.name", r.ruleParams.name),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse.description", r.ruleParams.description),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse.source_field", "text"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse.destination_field", "text"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse.regular_expression",
 						"(?P<remote_addr>\\d{1,3}.\\d{1,3}.\\d{1,3}.\\d{1,3})\\s*-\\s*(?P<user>[^ ]+)\\s*\\[(?P<timestemp>\\d{4}-\\d{2}\\-\\d{2}T\\d{2}\\:\\d{2}\\:\\d{2}\\.\\d{1,6}Z)\\]\\s*\\\\\\\"(?P<method>[A-z]+)\\s[\\/\\\\]+(?P<request>[^\\s]+)\\s*(?P<protocol>[A-z0-9\\/\\.]+)\\\\\\\"\\s*(?P<status>\\d+)\\s*(?P<body_bytes_sent>\\d+)?\\s*?\\\\\\\"(?P<http_referer>[^\"]+)\\\"\\s*\\\\\\\"(?P<http_user_agent>[^\"]+)\\\"\\s(?P<request_time>\\d{1,6})\\s*(?P<response_time>\\d{1,6})"),
 				),
 			},
@@ -414,9 +411,9 @@ func TestAccCoralogixResourceRuleGroup_parseJsonField(t *testing.T) {
 	resourceName := "coralogix_rules_group.test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckRuleGroupDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCoralogixResourceRuleGroupParseJsonField(r, keepSourceField, keepDestinationField),
@@ -428,15 +425,14 @@ func TestAccCoralogixResourceRuleGroup_parseJsonField(t *testing.T) {
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "name", r.name),
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "creator", r.creator),
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "description", r.description),
-					resource.TestCheckResourceAttrSet(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse_json_field.0.id"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse_json_field.0.order", "1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse_json_field.0.active", "true"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse_json_field.0.name", r.ruleParams.name),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse_json_field.0.description", r.ruleParams.description),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse_json_field.0.source_field", "text"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse_json_field.0.destination_field", "text"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse_json_field.0.keep_source_field", keepSourceField),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse_json_field.0.keep_destination_field", keepDestinationField),
+					resource.TestCheckResourceAttrSet(rulesGroupResourceName, "rule_subgroups.0.rules.0.id"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse_json_field.active", "true"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse_json_field.name", r.ruleParams.name),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse_json_field.description", r.ruleParams.description),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse_json_field.source_field", "text"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse_json_field.destination_field", "text"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse_json_field.keep_source_field", keepSourceField),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse_json_field.keep_destination_field", keepDestinationField),
 				),
 			},
 			{
@@ -448,14 +444,41 @@ func TestAccCoralogixResourceRuleGroup_parseJsonField(t *testing.T) {
 	})
 }
 
+func TestAccCoralogixResourceRuleGroup_invalidRegularExpressions(t *testing.T) {
+	r := getRandomRuleGroup()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCoralogixResourceRuleGroupParse(r, `(?P<status>[0-9]+`),
+				ExpectError: regexp.MustCompile(`Regular expression syntax error at line 1, column 1: missing closing parenthesis`),
+			},
+			{
+				Config:      testAccCoralogixResourceRuleGroupParse(r, `(?P<http-status>[0-9]+)`),
+				ExpectError: regexp.MustCompile(`invalid group name "http-status"`),
+			},
+			{
+				Config:      testAccCoralogixResourceRuleGroupExtract(r, `status=[0-9]+`),
+				ExpectError: regexp.MustCompile(`regular_expression has no named capture groups`),
+			},
+			{
+				Config:      testAccCoralogixResourceRuleGroupReplace(r, `(user)=[a-z]+`, `$2`),
+				ExpectError: regexp.MustCompile(`replacement_string refers to capture group \$2, but regular_expression has 1 capture groups`),
+			},
+		},
+	})
+}
+
 func TestAccCoralogixResourceRuleGroup_rules_combination(t *testing.T) {
 	r := getRandomRuleGroup()
 	resourceName := "coralogix_rules_group.test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckRuleGroupDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCoralogixResourceRuleRulesCombination(r),
@@ -468,17 +491,10 @@ func TestAccCoralogixResourceRuleGroup_rules_combination(t *testing.T) {
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "creator", r.creator),
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "description", r.description),
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.#", "3"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse.#", "1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse.0.name", "rule1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse.0.order", "1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.1.extract.#", "1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.1.extract.0.name", "rule2"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.1.extract.0.order", "2"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.2.parse.#", "1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.2.parse.0.name", "rule3"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.2.parse.0.order", "3"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.1.rules.0.extract_timestamp.#", "1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.1.rules.0.extract_timestamp.0.name", "rule1"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse.name", "rule1"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.1.extract.name", "rule2"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.2.parse.name", "rule3"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.1.rules.0.extract_timestamp.name", "rule1"),
 				),
 			},
 			{
@@ -496,9 +512,9 @@ func TestAccCoralogixResourceRuleGroup_update(t *testing.T) {
 	resourceName := "coralogix_rules_group.test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckRuleGroupDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCoralogixResourceRuleRulesCombination(r1),
@@ -511,14 +527,10 @@ func TestAccCoralogixResourceRuleGroup_update(t *testing.T) {
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "creator", r1.creator),
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "description", r1.description),
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.#", "3"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse.#", "1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse.0.name", "rule1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.1.extract.#", "1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.1.extract.0.name", "rule2"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.2.parse.#", "1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.2.parse.0.name", "rule3"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.1.rules.0.extract_timestamp.#", "1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.1.rules.0.extract_timestamp.0.name", "rule1"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse.name", "rule1"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.1.extract.name", "rule2"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.2.parse.name", "rule3"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.1.rules.0.extract_timestamp.name", "rule1"),
 				),
 			},
 			{
@@ -537,16 +549,18 @@ func TestAccCoralogixResourceRuleGroup_update(t *testing.T) {
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "creator", r2.creator),
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "description", r2.description),
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.#", "3"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse.#", "1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse.0.name", "rule1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.1.extract.#", "1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.1.extract.0.name", "rule2"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.2.parse.#", "1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.2.parse.0.name", "rule3"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.1.rules.0.extract_timestamp.#", "1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.1.rules.0.extract_timestamp.0.name", "rule1"),
+					resource.TestCheckResourceAttrSet(rulesGroupResourceName, "rule_subgroups.0.id"),
+					resource.TestCheckResourceAttrSet(rulesGroupResourceName, "rule_subgroups.0.rules.0.id"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse.name", "rule1"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.1.extract.name", "rule2"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.2.parse.name", "rule3"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.1.rules.0.extract_timestamp.name", "rule1"),
 				),
 			},
+			{
+				Config:   testAccCoralogixResourceRuleRulesCombination(r2),
+				PlanOnly: true,
+			},
 		},
 	})
 }
@@ -556,9 +570,9 @@ func TestAccCoralogixResourceRuleGroup_update_order_inside_rule_group(t *testing
 	resourceName := "coralogix_rules_group.test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckRuleGroupDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCoralogixResourceRuleRulesCombination(r),
@@ -571,17 +585,11 @@ func TestAccCoralogixResourceRuleGroup_update_order_inside_rule_group(t *testing
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "creator", r.creator),
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "description", r.description),
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.#", "3"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse.#", "1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse.0.name", "rule1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse.0.order", "1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.1.extract.#", "1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.1.extract.0.name", "rule2"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.1.extract.0.order", "2"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.2.parse.#", "1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.2.parse.0.name", "rule3"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.2.parse.0.order", "3"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.1.rules.0.extract_timestamp.#", "1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.1.rules.0.extract_timestamp.0.name", "rule1"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.parse.name", "rule1"),
+					resource.TestCheckResourceAttrSet(rulesGroupResourceName, "rule_subgroups.0.rules.0.id"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.1.extract.name", "rule2"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.2.parse.name", "rule3"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.1.rules.0.extract_timestamp.name", "rule1"),
 				),
 			},
 			{
@@ -600,19 +608,17 @@ func TestAccCoralogixResourceRuleGroup_update_order_inside_rule_group(t *testing
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "creator", r.creator),
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "description", r.description),
 					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.#", "3"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.extract.#", "1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.extract.0.name", "rule2"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.extract.0.order", "1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.1.parse.#", "1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.1.parse.0.name", "rule3"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.1.parse.0.order", "2"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.2.parse.#", "1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.2.parse.0.name", "rule1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.2.parse.0.order", "3"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.1.rules.0.extract_timestamp.#", "1"),
-					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.1.rules.0.extract_timestamp.0.name", "rule1"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.0.extract.name", "rule2"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.1.parse.name", "rule3"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.0.rules.2.parse.name", "rule1"),
+					resource.TestCheckResourceAttrSet(rulesGroupResourceName, "rule_subgroups.0.rules.2.id"),
+					resource.TestCheckResourceAttr(rulesGroupResourceName, "rule_subgroups.1.rules.0.extract_timestamp.name", "rule1"),
 				),
 			},
+			{
+				Config:   testAccCoralogixResourceRuleRulesCombinationDifferentOrders(r),
+				PlanOnly: true,
+			},
 		},
 	})
 }
@@ -624,9 +630,9 @@ func TestAccCoralogixResourceRuleGroupOrder(t *testing.T) {
 		secondRuleGroupOrder = 1
 	}
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckRuleGroupDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCoralogixResourceRuleRulesGroupsOrders(firstRuleGroupOrder, secondRuleGroupOrder),
@@ -686,336 +692,391 @@ func testAccCheckRuleGroupDestroy(s *terraform.State) error {
 
 func testAccCoralogixResourceRuleGroupBlock(r *ruleGroupParams, regEx, keepBlockedLogs string) string {
 	return fmt.Sprintf(`resource "coralogix_rules_group" "test" {
-  name         = "%s"
-  description  = "%s"
-  creator      = "%s"
-  rule_subgroups {
-    rules{
-	 block {
-     	name               	= "%s"
-	    description        	= "%s"
-        source_field 		= "text"
-        regular_expression	= "%s"
-        keep_blocked_logs  	= "%s"
-    	}
-	}
-  }
- }
+  name           = "%s"
+  description    = "%s"
+  creator        = "%s"
+  rule_subgroups = [
+    {
+      rules = [
+        {
+          block = {
+            name               = "%s"
+            description        = "%s"
+            source_field       = "text"
+            regular_expression = "%s"
+            keep_blocked_logs  = %s
+          }
+        }
+      ]
+    }
+  ]
+}
 `, r.name, r.description, r.creator, r.ruleParams.name, r.ruleParams.description, regEx, keepBlockedLogs)
 }
 
 func testAccCoralogixResourceRuleGroupJsonExtract(r *ruleGroupParams, jsonKey, destinationField string) string {
 	return fmt.Sprintf(`resource "coralogix_rules_group" "test" {
-  name         = "%s"
-  description  = "%s"
-  creator      = "%s"
-  rule_subgroups {
-	rules{
-     json_extract {
-       name               	= "%s"
-       description        	= "%s"
-       json_key     		= "%s"
-       destination_field  	= "%s"
-     }
-	}
-  }
- }
+  name           = "%s"
+  description    = "%s"
+  creator        = "%s"
+  rule_subgroups = [
+    {
+      rules = [
+        {
+          json_extract = {
+            name              = "%s"
+            description       = "%s"
+            json_key          = "%s"
+            destination_field = "%s"
+          }
+        }
+      ]
+    }
+  ]
+}
 `, r.name, r.description, r.creator, r.ruleParams.name, r.ruleParams.description, jsonKey, destinationField)
 }
 
 func testAccCoralogixResourceRuleGroupReplace(r *ruleGroupParams, regEx, replacementString string) string {
 	return fmt.Sprintf(`resource "coralogix_rules_group" "test" {
-  name         = "%s"
-  description  = "%s"
-  creator      = "%s"
-  rule_subgroups {
-    rules{
-      replace {
-      name               	= "%s"
-      description        	= "%s"
-	  source_field       	= "text"
-      destination_field  	= "text"
-      regular_expression	= "%s"
-      replacement_string     = "%s"
-     }
-	}
-  }
- }
+  name           = "%s"
+  description    = "%s"
+  creator        = "%s"
+  rule_subgroups = [
+    {
+      rules = [
+        {
+          replace = {
+            name               = "%s"
+            description        = "%s"
+            source_field       = "text"
+            destination_field  = "text"
+            regular_expression = "%s"
+            replacement_string = "%s"
+          }
+        }
+      ]
+    }
+  ]
+}
 `, r.name, r.description, r.creator, r.ruleParams.name, r.ruleParams.description, regEx, replacementString)
 }
 
 func testAccCoralogixResourceRuleGroupAllow(r *ruleGroupParams, regEx, keepBlockedLogs string) string {
 	return fmt.Sprintf(`resource "coralogix_rules_group" "test" {
-  name         = "%s"
-  description  = "%s"
-  creator      = "%s"
-  rule_subgroups {
-    rules{
-       block {
-      name               	= "%s"
-      description        	= "%s"
-      source_field 			= "text"
-      regular_expression	= "%s"
-      keep_blocked_logs     = "%s"
-      blocking_all_matching_blocks = false
+  name           = "%s"
+  description    = "%s"
+  creator        = "%s"
+  rule_subgroups = [
+    {
+      rules = [
+        {
+          block = {
+            name                         = "%s"
+            description                  = "%s"
+            source_field                 = "text"
+            regular_expression           = "%s"
+            keep_blocked_logs            = %s
+            blocking_all_matching_blocks = false
+          }
+        }
+      ]
     }
-   }
-  }
- }
+  ]
+}
 `, r.name, r.description, r.creator, r.ruleParams.name, r.ruleParams.description, regEx, keepBlockedLogs)
 }
 
 func testAccCoralogixResourceRuleGroupExtractTimestamp(r *ruleGroupParams, timeFormat, fieldFormatStandard string) string {
 	return fmt.Sprintf(`resource "coralogix_rules_group" "test" {
-  name         = "%s"
-  description  = "%s"
-  creator      = "%s"
-  rule_subgroups {
-    rules{
-       extract_timestamp {
-      name               	= "%s"
-      description        	= "%s"
-      source_field 			= "text"
-      time_format        	= "%s"
-      field_format_standard = "%s"
+  name           = "%s"
+  description    = "%s"
+  creator        = "%s"
+  rule_subgroups = [
+    {
+      rules = [
+        {
+          extract_timestamp = {
+            name                  = "%s"
+            description           = "%s"
+            source_field          = "text"
+            time_format           = "%s"
+            field_format_standard = "%s"
+          }
+        }
+      ]
     }
-   }
-  }
- }
+  ]
+}
 `, r.name, r.description, r.creator, r.ruleParams.name, r.ruleParams.description, timeFormat, fieldFormatStandard)
 }
 
 func testAccCoralogixResourceRuleGroupRemoveFields(r *ruleGroupParams, excludedFields string) string {
 	return fmt.Sprintf(`resource "coralogix_rules_group" "test" {
-  name         = "%s"
-  description  = "%s"
-  creator      = "%s"
-  rule_subgroups {
-	rules{
-     remove_fields {
-       name               = "%s"
-       description        = "%s"
-       excluded_fields    = %s
-     }
-   }
-  }
- }
+  name           = "%s"
+  description    = "%s"
+  creator        = "%s"
+  rule_subgroups = [
+    {
+      rules = [
+        {
+          remove_fields = {
+            name            = "%s"
+            description     = "%s"
+            excluded_fields = %s
+          }
+        }
+      ]
+    }
+  ]
+}
 `, r.name, r.description, r.creator, r.ruleParams.name, r.ruleParams.description, excludedFields)
 }
 
 func testAccCoralogixResourceRuleGroupJsonStringify(r *ruleGroupParams, keepSourceField string) string {
 	return fmt.Sprintf(`resource "coralogix_rules_group" "test" {
-  name         = "%s"
-  description  = "%s"
-  creator      = "%s"
-  rule_subgroups {
-    rules{
-      json_stringify {
-      name               = "%s"
-      description        = "%s"
-      source_field       = "text"
-      destination_field  = "text"
-      keep_source_field  = "%s"
+  name           = "%s"
+  description    = "%s"
+  creator        = "%s"
+  rule_subgroups = [
+    {
+      rules = [
+        {
+          json_stringify = {
+            name              = "%s"
+            description       = "%s"
+            source_field      = "text"
+            destination_field = "text"
+            keep_source_field = %s
+          }
+        }
+      ]
     }
-   }
-  }
- }
+  ]
+}
 `, r.name, r.description, r.creator, r.ruleParams.name, r.ruleParams.description, keepSourceField)
 }
 
 func testAccCoralogixResourceRuleGroupExtract(r *ruleGroupParams, regEx string) string {
 	return fmt.Sprintf(`resource "coralogix_rules_group" "test" {
-  name         = "%s"
-  description  = "%s"
-  creator      = "%s"
-  rule_subgroups {
-    rules{
-      extract {
-       name               = "%s"
-       description        = "%s"
-       source_field       = "text"
-       regular_expression = "%s"
-     }
+  name           = "%s"
+  description    = "%s"
+  creator        = "%s"
+  rule_subgroups = [
+    {
+      rules = [
+        {
+          extract = {
+            name               = "%s"
+            description        = "%s"
+            source_field       = "text"
+            regular_expression = "%s"
+          }
+        }
+      ]
     }
-  }
- }
+  ]
+}
 `, r.name, r.description, r.creator, r.ruleParams.name, r.ruleParams.description, regEx)
 }
 
 func testAccCoralogixResourceRuleGroupParse(r *ruleGroupParams, regEx string) string {
 	return fmt.Sprintf(`resource "coralogix_rules_group" "test" {
-  name         = "%s"
-  description  = "%s"
-  creator      = "%s"
-  rule_subgroups {
-    rules{
-      parse {
-		name               = "%s"
-		description        = "%s"
-		source_field       = "text"
-		destination_field  = "text"
-		regular_expression  = "%s"
-	  }
+  name           = "%s"
+  description    = "%s"
+  creator        = "%s"
+  rule_subgroups = [
+    {
+      rules = [
+        {
+          parse = {
+            name               = "%s"
+            description        = "%s"
+            source_field       = "text"
+            destination_field  = "text"
+            regular_expression = "%s"
+          }
+        }
+      ]
     }
-  }
- }
+  ]
+}
 `, r.name, r.description, r.creator, r.ruleParams.name, r.ruleParams.description, regEx)
 }
 
 func testAccCoralogixResourceRuleGroupParseJsonField(r *ruleGroupParams, keepSourceField, keepDestinationField string) string {
 	return fmt.Sprintf(`resource "coralogix_rules_group" "test" {
-  name         = "%s"
-  description  = "%s"
-  creator      = "%s"
-  rule_subgroups {
-    rules{
-      parse_json_field {
-		name               = "%s"
-		description        = "%s"
-		source_field       = "text"
-		destination_field  = "text"
-		keep_source_field  = "%s"
-		keep_destination_field = "%s"
-	  }
+  name           = "%s"
+  description    = "%s"
+  creator        = "%s"
+  rule_subgroups = [
+    {
+      rules = [
+        {
+          parse_json_field = {
+            name                   = "%s"
+            description            = "%s"
+            source_field           = "text"
+            destination_field      = "text"
+            keep_source_field      = %s
+            keep_destination_field = %s
+          }
+        }
+      ]
     }
-  }
- }
+  ]
+}
 `, r.name, r.description, r.creator, r.ruleParams.name, r.ruleParams.description, keepSourceField, keepDestinationField)
 }
 
 func testAccCoralogixResourceRuleRulesCombination(r *ruleGroupParams) string {
 	return fmt.Sprintf(`resource "coralogix_rules_group" "test" {
-  name         = "%s"
-  description  = "%s"
-  creator      = "%s"
-  rule_subgroups {
-	rules{
-    parse {
-      name               = "rule1"
-      description        = "description"
-      source_field       = "text"
- 	  destination_field  = "text"
-      regular_expression  = "(?P<remote_addr>\\d{1,3}.\\d{1,3}.\\d{1,3}.\\d{1,3})\\s*-\\s*(?P<user>[^ ]+)\\s*\\[(?P<timestemp>\\d{4}-\\d{2}\\-\\d{2}T\\d{2}\\:\\d{2}\\:\\d{2}\\.\\d{1,6}Z)\\]\\s*\\\\\\\"(?P<method>[A-z]+)\\s[\\/\\\\]+(?P<request>[^\\s]+)\\s*(?P<protocol>[A-z0-9\\/\\.]+)\\\\\\\"\\s*(?P<status>\\d+)\\s*(?P<body_bytes_sent>\\d+)?\\s*?\\\\\\\"(?P<http_referer>[^\"]+)\\\"\\s*\\\\\\\"(?P<http_user_agent>[^\"]+)\\\"\\s(?P<request_time>\\d{1,6})\\s*(?P<response_time>\\d{1,6})"
-     }
+  name           = "%s"
+  description    = "%s"
+  creator        = "%s"
+  rule_subgroups = [
+    {
+      rules = [
+        {
+          parse = {
+            name               = "rule1"
+            description        = "description"
+            source_field       = "text"
+            destination_field  = "text"
+            regular_expression = "(?P<remote_addr>\\d{1,3}.\\d{1,3}.\\d{1,3}.\\d{1,3})\\s*-\\s*(?P<user>[^ ]+)\\s*\\[(?P<timestemp>\\d{4}-\\d{2}\\-\\d{2}T\\d{2}\\:\\d{2}\\:\\d{2}\\.\\d{1,6}Z)\\]\\s*\\\\\\\"(?P<method>[A-z]+)\\s[\\/\\\\]+(?P<request>[^\\s]+)\\s*(?P<protocol>[A-z0-9\\/\\.]+)\\\\\\\"\\s*(?P<status>\\d+)\\s*(?P<body_bytes_sent>\\d+)?\\s*?\\\\\\\"(?P<http_referer>[^\"]+)\\\"\\s*\\\\\\\"(?P<http_user_agent>[^\"]+)\\\"\\s(?P<request_time>\\d{1,6})\\s*(?P<response_time>\\d{1,6})"
+          }
+        },
+        {
+          extract = {
+            name               = "rule2"
+            description        = "description"
+            source_field       = "text"
+            regular_expression = "(?P<remote_addr>\\d{1,3}.\\d{1,3}.\\d{1,3}.\\d{1,3})\\s*-\\s*(?P<user>[^ ]+)\\s*\\[(?P<timestemp>\\d{4}-\\d{2}\\-\\d{2}T\\d{2}\\:\\d{2}\\:\\d{2}\\.\\d{1,6}Z)\\]\\s*\\\\\\\"(?P<method>[A-z]+)\\s[\\/\\\\]+(?P<request>[^\\s]+)\\s*(?P<protocol>[A-z0-9\\/\\.]+)\\\\\\\"\\s*(?P<status>\\d+)\\s*(?P<body_bytes_sent>\\d+)?\\s*?\\\\\\\"(?P<http_referer>[^\"]+)\\\"\\s*\\\\\\\"(?P<http_user_agent>[^\"]+)\\\"\\s(?P<request_time>\\d{1,6})\\s*(?P<response_time>\\d{1,6})"
+          }
+        },
+        {
+          parse = {
+            name               = "rule3"
+            description        = "description"
+            source_field       = "text"
+            destination_field  = "text"
+            regular_expression = "(?P<remote_addr>\\d{1,3}.\\d{1,3}.\\d{1,3}.\\d{1,3})\\s*-\\s*(?P<user>[^ ]+)\\s*\\[(?P<timestemp>\\d{4}-\\d{2}\\-\\d{2}T\\d{2}\\:\\d{2}\\:\\d{2}\\.\\d{1,6}Z)\\]\\s*\\\\\\\"(?P<method>[A-z]+)\\s[\\/\\\\]+(?P<request>[^\\s]+)\\s*(?P<protocol>[A-z0-9\\/\\.]+)\\\\\\\"\\s*(?P<status>\\d+)\\s*(?P<body_bytes_sent>\\d+)?\\s*?\\\\\\\"(?P<http_referer>[^\"]+)\\\"\\s*\\\\\\\"(?P<http_user_agent>[^\"]+)\\\"\\s(?P<request_time>\\d{1,6})\\s*(?P<response_time>\\d{1,6})"
+          }
+        }
+      ]
+    },
+    {
+      rules = [
+        {
+          extract_timestamp = {
+            name                  = "rule1"
+            description           = "description"
+            source_field          = "text"
+            time_format           = "2006-01-02T15:04:05.999999999Z07:00"
+            field_format_standard = "Golang"
+          }
+        }
+      ]
     }
-	rules{
-     extract {
-       name               = "rule2"
-       description        = "description"
-       source_field       = "text"
-       regular_expression  = "(?P<remote_addr>\\d{1,3}.\\d{1,3}.\\d{1,3}.\\d{1,3})\\s*-\\s*(?P<user>[^ ]+)\\s*\\[(?P<timestemp>\\d{4}-\\d{2}\\-\\d{2}T\\d{2}\\:\\d{2}\\:\\d{2}\\.\\d{1,6}Z)\\]\\s*\\\\\\\"(?P<method>[A-z]+)\\s[\\/\\\\]+(?P<request>[^\\s]+)\\s*(?P<protocol>[A-z0-9\\/\\.]+)\\\\\\\"\\s*(?P<status>\\d+)\\s*(?P<body_bytes_sent>\\d+)?\\s*?\\\\\\\"(?P<http_referer>[^\"]+)\\\"\\s*\\\\\\\"(?P<http_user_agent>[^\"]+)\\\"\\s(?P<request_time>\\d{1,6})\\s*(?P<response_time>\\d{1,6})"
-     }
-    }
-
-	rules{
-	 parse {
-       name               = "rule3"
-       description        = "description"
-       source_field       = "text"
- 	   destination_field  = "text"
-       regular_expression  = "(?P<remote_addr>\\d{1,3}.\\d{1,3}.\\d{1,3}.\\d{1,3})\\s*-\\s*(?P<user>[^ ]+)\\s*\\[(?P<timestemp>\\d{4}-\\d{2}\\-\\d{2}T\\d{2}\\:\\d{2}\\:\\d{2}\\.\\d{1,6}Z)\\]\\s*\\\\\\\"(?P<method>[A-z]+)\\s[\\/\\\\]+(?P<request>[^\\s]+)\\s*(?P<protocol>[A-z0-9\\/\\.]+)\\\\\\\"\\s*(?P<status>\\d+)\\s*(?P<body_bytes_sent>\\d+)?\\s*?\\\\\\\"(?P<http_referer>[^\"]+)\\\"\\s*\\\\\\\"(?P<http_user_agent>[^\"]+)\\\"\\s(?P<request_time>\\d{1,6})\\s*(?P<response_time>\\d{1,6})"
-     }
-	} 
-  }
-
-  rule_subgroups {
-   rules{
-	extract_timestamp {
-      name               	= "rule1"
-      description        	= "description"
-      source_field 			= "text"
-      time_format        	= "2006-01-02T15:04:05.999999999Z07:00"
-      field_format_standard = "Golang"
-    }
-   }
-  }
- }
+  ]
+}
 `, r.name, r.description, r.creator)
 }
 
 func testAccCoralogixResourceRuleRulesCombinationDifferentOrders(r *ruleGroupParams) string {
 	return fmt.Sprintf(`resource "coralogix_rules_group" "test" {
-  name         = "%s"
-  description  = "%s"
-  creator      = "%s"
-  rule_subgroups {
-	rules{
-     extract {
-       name               = "rule2"
-       description        = "description"
-       source_field       = "text"
-       regular_expression  = "(?P<remote_addr>\\d{1,3}.\\d{1,3}.\\d{1,3}.\\d{1,3})\\s*-\\s*(?P<user>[^ ]+)\\s*\\[(?P<timestemp>\\d{4}-\\d{2}\\-\\d{2}T\\d{2}\\:\\d{2}\\:\\d{2}\\.\\d{1,6}Z)\\]\\s*\\\\\\\"(?P<method>[A-z]+)\\s[\\/\\\\]+(?P<request>[^\\s]+)\\s*(?P<protocol>[A-z0-9\\/\\.]+)\\\\\\\"\\s*(?P<status>\\d+)\\s*(?P<body_bytes_sent>\\d+)?\\s*?\\\\\\\"(?P<http_referer>[^\"]+)\\\"\\s*\\\\\\\"(?P<http_user_agent>[^\"]+)\\\"\\s(?P<request_time>\\d{1,6})\\s*(?P<response_time>\\d{1,6})"
-     }
-    }
-
-	rules{
-	 parse {
-       name               = "rule3"
-       description        = "description"
-       source_field       = "text"
- 	   destination_field  = "text"
-       regular_expression  = "(?P<remote_addr>\\d{1,3}.\\d{1,3}.\\d{1,3}.\\d{1,3})\\s*-\\s*(?P<user>[^ ]+)\\s*\\[(?P<timestemp>\\d{4}-\\d{2}\\-\\d{2}T\\d{2}\\:\\d{2}\\:\\d{2}\\.\\d{1,6}Z)\\]\\s*\\\\\\\"(?P<method>[A-z]+)\\s[\\/\\\\]+(?P<request>[^\\s]+)\\s*(?P<protocol>[A-z0-9\\/\\.]+)\\\\\\\"\\s*(?P<status>\\d+)\\s*(?P<body_bytes_sent>\\d+)?\\s*?\\\\\\\"(?P<http_referer>[^\"]+)\\\"\\s*\\\\\\\"(?P<http_user_agent>[^\"]+)\\\"\\s(?P<request_time>\\d{1,6})\\s*(?P<response_time>\\d{1,6})"
-      }
+  name           = "%s"
+  description    = "%s"
+  creator        = "%s"
+  rule_subgroups = [
+    {
+      rules = [
+        {
+          extract = {
+            name               = "rule2"
+            description        = "description"
+            source_field       = "text"
+            regular_expression = "(?P<remote_addr>\\d{1,3}.\\d{1,3}.\\d{1,3}.\\d{1,3})\\s*-\\s*(?P<user>[^ ]+)\\s*\\[(?P<timestemp>\\d{4}-\\d{2}\\-\\d{2}T\\d{2}\\:\\d{2}\\:\\d{2}\\.\\d{1,6}Z)\\]\\s*\\\\\\\"(?P<method>[A-z]+)\\s[\\/\\\\]+(?P<request>[^\\s]+)\\s*(?P<protocol>[A-z0-9\\/\\.]+)\\\\\\\"\\s*(?P<status>\\d+)\\s*(?P<body_bytes_sent>\\d+)?\\s*?\\\\\\\"(?P<http_referer>[^\"]+)\\\"\\s*\\\\\\\"(?P<http_user_agent>[^\"]+)\\\"\\s(?P<request_time>\\d{1,6})\\s*(?P<response_time>\\d{1,6})"
+          }
+        },
+        {
+          parse = {
+            name               = "rule3"
+            description        = "description"
+            source_field       = "text"
+            destination_field  = "text"
+            regular_expression = "(?P<remote_addr>\\d{1,3}.\\d{1,3}.\\d{1,3}.\\d{1,3})\\s*-\\s*(?P<user>[^ ]+)\\s*\\[(?P<timestemp>\\d{4}-\\d{2}\\-\\d{2}T\\d{2}\\:\\d{2}\\:\\d{2}\\.\\d{1,6}Z)\\]\\s*\\\\\\\"(?P<method>[A-z]+)\\s[\\/\\\\]+(?P<request>[^\\s]+)\\s*(?P<protocol>[A-z0-9\\/\\.]+)\\\\\\\"\\s*(?P<status>\\d+)\\s*(?P<body_bytes_sent>\\d+)?\\s*?\\\\\\\"(?P<http_referer>[^\"]+)\\\"\\s*\\\\\\\"(?P<http_user_agent>[^\"]+)\\\"\\s(?P<request_time>\\d{1,6})\\s*(?P<response_time>\\d{1,6})"
+          }
+        },
+        {
+          parse = {
+            name               = "rule1"
+            description        = "description"
+            source_field       = "text"
+            destination_field  = "text"
+            regular_expression = "(?P<remote_addr>\\d{1,3}.\\d{1,3}.\\d{1,3}.\\d{1,3})\\s*-\\s*(?P<user>[^ ]+)\\s*\\[(?P<timestemp>\\d{4}-\\d{2}\\-\\d{2}T\\d{2}\\:\\d{2}\\:\\d{2}\\.\\d{1,6}Z)\\]\\s*\\\\\\\"(?P<method>[A-z]+)\\s[\\/\\\\]+(?P<request>[^\\s]+)\\s*(?P<protocol>[A-z0-9\\/\\.]+)\\\\\\\"\\s*(?P<status>\\d+)\\s*(?P<body_bytes_sent>\\d+)?\\s*?\\\\\\\"(?P<http_referer>[^\"]+)\\\"\\s*\\\\\\\"(?P<http_user_agent>[^\"]+)\\\"\\s(?P<request_time>\\d{1,6})\\s*(?P<response_time>\\d{1,6})"
+          }
+        }
+      ]
+    },
+    {
+      rules = [
+        {
+          extract_timestamp = {
+            name                  = "rule1"
+            description           = "description"
+            source_field          = "text"
+            time_format           = "2006-01-02T15:04:05.999999999Z07:00"
+            field_format_standard = "Golang"
+          }
+        }
+      ]
     }
-
-	rules{
-     parse {
-      name               = "rule1"
-      description        = "description"
-      source_field       = "text"
- 	  destination_field  = "text"
-      regular_expression  = "(?P<remote_addr>\\d{1,3}.\\d{1,3}.\\d{1,3}.\\d{1,3})\\s*-\\s*(?P<user>[^ ]+)\\s*\\[(?P<timestemp>\\d{4}-\\d{2}\\-\\d{2}T\\d{2}\\:\\d{2}\\:\\d{2}\\.\\d{1,6}Z)\\]\\s*\\\\\\\"(?P<method>[A-z]+)\\s[\\/\\\\]+(?P<request>[^\\s]+)\\s*(?P<protocol>[A-z0-9\\/\\.]+)\\\\\\\"\\s*(?P<status>\\d+)\\s*(?P<body_bytes_sent>\\d+)?\\s*?\\\\\\\"(?P<http_referer>[^\"]+)\\\"\\s*\\\\\\\"(?P<http_user_agent>[^\"]+)\\\"\\s(?P<request_time>\\d{1,6})\\s*(?P<response_time>\\d{1,6})"
-     }
-    }
-  }
-
-  rule_subgroups {
-   rules{
-	extract_timestamp {
-      name               	= "rule1"
-      description        	= "description"
-      source_field 			= "text"
-      time_format        	= "2006-01-02T15:04:05.999999999Z07:00"
-      field_format_standard = "Golang"
-    }
-   }
-  }
- }
+  ]
+}
 `, r.name, r.description, r.creator)
 }
 
 func testAccCoralogixResourceRuleRulesGroupsOrders(firstRuleGroupOrder, secondRuleGroupOrder int) string {
 	return fmt.Sprintf(`resource "coralogix_rules_group" "test1" {
-  name         = "name1"
-  description  = "description1"
-  creator      = "creator1"
- order = %d
-  rule_subgroups {
-	rules{
-     extract {
-       name               = "rule2"
-       description        = "description"
-       source_field       = "text"
-       regular_expression  = "(?P<remote_addr>\\d{1,3}.\\d{1,3}.\\d{1,3}.\\d{1,3})\\s*-\\s*(?P<user>[^ ]+)\\s*\\[(?P<timestemp>\\d{4}-\\d{2}\\-\\d{2}T\\d{2}\\:\\d{2}\\:\\d{2}\\.\\d{1,6}Z)\\]\\s*\\\\\\\"(?P<method>[A-z]+)\\s[\\/\\\\]+(?P<request>[^\\s]+)\\s*(?P<protocol>[A-z0-9\\/\\.]+)\\\\\\\"\\s*(?P<status>\\d+)\\s*(?P<body_bytes_sent>\\d+)?\\s*?\\\\\\\"(?P<http_referer>[^\"]+)\\\"\\s*\\\\\\\"(?P<http_user_agent>[^\"]+)\\\"\\s(?P<request_time>\\d{1,6})\\s*(?P<response_time>\\d{1,6})"
-     }
+  name           = "name1"
+  description    = "description1"
+  creator        = "creator1"
+  order          = %d
+  rule_subgroups = [
+    {
+      rules = [
+        {
+          extract = {
+            name               = "rule2"
+            description        = "description"
+            source_field       = "text"
+            regular_expression = "(?P<remote_addr>\\d{1,3}.\\d{1,3}.\\d{1,3}.\\d{1,3})\\s*-\\s*(?P<user>[^ ]+)\\s*\\[(?P<timestemp>\\d{4}-\\d{2}\\-\\d{2}T\\d{2}\\:\\d{2}\\:\\d{2}\\.\\d{1,6}Z)\\]\\s*\\\\\\\"(?P<method>[A-z]+)\\s[\\/\\\\]+(?P<request>[^\\s]+)\\s*(?P<protocol>[A-z0-9\\/\\.]+)\\\\\\\"\\s*(?P<status>\\d+)\\s*(?P<body_bytes_sent>\\d+)?\\s*?\\\\\\\"(?P<http_referer>[^\"]+)\\\"\\s*\\\\\\\"(?P<http_user_agent>[^\"]+)\\\"\\s(?P<request_time>\\d{1,6})\\s*(?P<response_time>\\d{1,6})"
+          }
+        }
+      ]
     }
-  }
+  ]
 }
 resource "coralogix_rules_group" "test2" {
-  name         = "name2"
-  description  = "description2"
-  creator      = "creator2"
-  order = %d
-  rule_subgroups {
-	rules{
-     extract {
-       name               = "rule2"
-       description        = "description"
-       source_field       = "text"
-       regular_expression  = "(?P<remote_addr>\\d{1,3}.\\d{1,3}.\\d{1,3}.\\d{1,3})\\s*-\\s*(?P<user>[^ ]+)\\s*\\[(?P<timestemp>\\d{4}-\\d{2}\\-\\d{2}T\\d{2}\\:\\d{2}\\:\\d{2}\\.\\d{1,6}Z)\\]\\s*\\\\\\\"(?P<method>[A-z]+)\\s[\\/\\\\]+(?P<request>[^\\s]+)\\s*(?P<protocol>[A-z0-9\\/\\.]+)\\\\\\\"\\s*(?P<status>\\d+)\\s*(?P<body_bytes_sent>\\d+)?\\s*?\\\\\\\"(?P<http_referer>[^\"]+)\\\"\\s*\\\\\\\"(?P<http_user_agent>[^\"]+)\\\"\\s(?P<request_time>\\d{1,6})\\s*(?P<response_time>\\d{1,6})"
-     }
+  name           = "name2"
+  description    = "description2"
+  creator        = "creator2"
+  order          = %d
+  rule_subgroups = [
+    {
+      rules = [
+        {
+          extract = {
+            name               = "rule2"
+            description        = "description"
+            source_field       = "text"
+            regular_expression = "(?P<remote_addr>\\d{1,3}.\\d{1,3}.\\d{1,3}.\\d{1,3})\\s*-\\s*(?P<user>[^ ]+)\\s*\\[(?P<timestemp>\\d{4}-\\d{2}\\-\\d{2}T\\d{2}\\:\\d{2}\\:\\d{2}\\.\\d{1,6}Z)\\]\\s*\\\\\\\"(?P<method>[A-z]+)\\s[\\/\\\\]+(?P<request>[^\\s]+)\\s*(?P<protocol>[A-z0-9\\/\\.]+)\\\\\\\"\\s*(?P<status>\\d+)\\s*(?P<body_bytes_sent>\\d+)?\\s*?\\\\\\\"(?P<http_referer>[^\"]+)\\\"\\s*\\\\\\\"(?P<http_user_agent>[^\"]+)\\\"\\s(?P<request_time>\\d{1,6})\\s*(?P<response_time>\\d{1,6})"
+          }
+        }
+      ]
     }
-  }
+  ]
 }
 `, firstRuleGroupOrder, secondRuleGroupOrder)
 }
@@ -1028,3 +1089,124 @@ type ruleGroupParams struct {
 	ruleParams
 	name, description, creator string
 }
+
+func TestRulesGroupRoundTrip(t *testing.T) {
+	fixtures := loadRoundTripFixtures(t, "rules_group", func() *rulesgroups.RuleGroup { return &rulesgroups.RuleGroup{} })
+	assertOneofCoverage(t, fixtures, nil)
+
+	for _, name := range sortedFixtureNames(fixtures) {
+		fixture := fixtures[name]
+		t.Run(name, func(t *testing.T) {
+			assertRulesGroupRoundTrip(t, fixture)
+
+			generator := newRulesGroupRoundTripGenerator()
+			for i := 0; i < roundTripIterations; i++ {
+				ruleGroup := generator.variation(fixture).(*rulesgroups.RuleGroup)
+				// JSON extract and remove fields rules always run on the log's text, so their schema has no
+				// source field.
+				for _, subgroup := range ruleGroup.GetRuleSubgroups() {
+					for _, rule := range subgroup.GetRules() {
+						switch rule.GetParameters().GetRuleParameters().(type) {
+						case *rulesgroups.RuleParameters_JsonExtractParameters, *rulesgroups.RuleParameters_RemoveFieldsParameters:
+							rule.SourceField = wrapperspb.String("text")
+						}
+					}
+				}
+				assertRulesGroupRoundTrip(t, ruleGroup)
+			}
+		})
+	}
+}
+
+func assertRulesGroupRoundTrip(t *testing.T, ruleGroup *rulesgroups.RuleGroup) {
+	t.Helper()
+
+	ctx := context.Background()
+	model, diags := flattenRuleGroup(ctx, ruleGroup, types.StringNull())
+	if diags.HasError() {
+		t.Fatalf("flatten: %v", diags)
+	}
+	model = roundTripFrameworkState(t, NewRulesGroupResource(), model)
+
+	assertProtoEqual(t, ruleGroup, expandRulesGroupModel(t, model), rulesGroupRoundTripCompareOptions()...)
+}
+
+// expandRulesGroupModel returns the rule-group of the create request of the model.
+func expandRulesGroupModel(t *testing.T, model RulesGroupResourceModel) *rulesgroups.RuleGroup {
+	t.Helper()
+
+	req, diags := extractCreateRuleGroupRequest(context.Background(), model)
+	if diags.HasError() {
+		t.Fatalf("expand: %v", diags)
+	}
+
+	// The request has the rule-group's fields, without the ids.
+	b, err := protojson.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expanded := &rulesgroups.RuleGroup{}
+	if err = protojson.Unmarshal(b, expanded); err != nil {
+		t.Fatal(err)
+	}
+	return expanded
+}
+
+// rulesGroupRoundTripCompareOptions ignores the differences between a rule-group and its create request.
+func rulesGroupRoundTripCompareOptions() []cmp.Option {
+	return []cmp.Option{
+		// The ids are assigned by the API.
+		protocmp.IgnoreFields(&rulesgroups.RuleGroup{}, "id"),
+		protocmp.IgnoreFields(&rulesgroups.RuleSubgroup{}, "id"),
+		protocmp.IgnoreFields(&rulesgroups.Rule{}, "id"),
+		// The matchers are the applications, subsystems and severities sets.
+		protocmp.SortRepeatedFields(&rulesgroups.RuleGroup{}, "rule_matchers"),
+	}
+}
+
+func TestUpgradeRulesGroupStateV0ToV1(t *testing.T) {
+	fixtures := loadRoundTripFixtures(t, "rules_group", func() *rulesgroups.RuleGroup { return &rulesgroups.RuleGroup{} })
+	states := loadStateUpgradeFixtures(t, "rules_group")
+
+	for _, name := range sortedFixtureNames(states) {
+		rawState := states[name]
+		t.Run(name, func(t *testing.T) {
+			fixture, ok := fixtures[name]
+			if !ok {
+				t.Fatalf("no round-trip fixture named %s", name)
+			}
+
+			state := upgradeFrameworkState(t, NewRulesGroupResource().(*RulesGroupResource), rawState)
+			var model RulesGroupResourceModel
+			if diags := state.Get(context.Background(), &model); diags.HasError() {
+				t.Fatalf("get state: %v", diags)
+			}
+
+			assertProtoEqual(t, fixture, expandRulesGroupModel(t, model), rulesGroupRoundTripCompareOptions()...)
+
+			// The rules' ids move up from their rule types, and the subgroups keep theirs.
+			assertAttrValue(t, "id", types.StringValue(fixture.GetId().GetValue()), model.ID)
+			if len(model.RuleSubgroups) != len(fixture.GetRuleSubgroups()) {
+				t.Fatalf("rule_subgroups: got %d subgroups, want %d", len(model.RuleSubgroups), len(fixture.GetRuleSubgroups()))
+			}
+			for i, subgroup := range fixture.GetRuleSubgroups() {
+				assertAttrValue(t, fmt.Sprintf("rule_subgroups.%d.id", i), types.StringValue(subgroup.GetId().GetValue()), model.RuleSubgroups[i].ID)
+				for j, rule := range subgroup.GetRules() {
+					assertAttrValue(t, fmt.Sprintf("rule_subgroups.%d.rules.%d.id", i, j), types.StringValue(rule.GetId().GetValue()), model.RuleSubgroups[i].Rules[j].ID)
+				}
+			}
+		})
+	}
+}
+
+func newRulesGroupRoundTripGenerator() *roundTripGenerator {
+	generator := newRoundTripGenerator(1)
+	// The subgroups and rules are ordered by their position.
+	generator.fixed[roundTripField(&rulesgroups.RuleSubgroup{}, "order")] = true
+	generator.fixed[roundTripField(&rulesgroups.Rule{}, "order")] = true
+	// The severities are a set, so random values could collapse into one.
+	generator.fixed[roundTripField(&rulesgroups.SeverityConstraint{}, "value")] = true
+	// The schema only supports escaped JSON values.
+	generator.fixed[roundTripField(&rulesgroups.JsonParseParameters{}, "escaped_value")] = true
+	return generator
+}
@@ -0,0 +1,445 @@
+package coralogix
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"terraform-provider-coralogix/coralogix/clientset"
+	rulesv1 "terraform-provider-coralogix/coralogix/clientset/grpc/rules-groups/v1"
+)
+
+var (
+	_ resource.ResourceWithConfigure      = &RulesGroupsOrderResource{}
+	_ resource.ResourceWithImportState    = &RulesGroupsOrderResource{}
+	_ resource.ResourceWithValidateConfig = &RulesGroupsOrderResource{}
+)
+
+func NewRulesGroupsOrderResource() resource.Resource {
+	return &RulesGroupsOrderResource{}
+}
+
+type RulesGroupsOrderResource struct {
+	client *clientset.RuleGroupsClient
+}
+
+type RulesGroupsOrderResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	RuleGroupsIDs types.List   `tfsdk:"rule_groups_ids"`
+}
+
+func (r *RulesGroupsOrderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rules_groups_order"
+}
+
+func (r *RulesGroupsOrderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clientSet, ok := req.ProviderData.(*clientset.ClientSet)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *clientset.ClientSet, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clientSet.RuleGroups()
+}
+
+func (r *RulesGroupsOrderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				MarkdownDescription: "Rules-groups order ID.",
+			},
+			"rule_groups_ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+				MarkdownDescription: "The IDs of the managed rule-groups, in the order they should be evaluated. " +
+					"The rule-groups are reordered between the positions they already occupy, so rule-groups which aren't listed keep their place.",
+			},
+		},
+		MarkdownDescription: "Rules-groups order owns the evaluation order of a set of rule-groups. " +
+			"Rule-groups listed here shouldn't set their own `order`.",
+	}
+}
+
+// ValidateConfig rejects rule-groups which are listed more than once, as they can't be in two positions.
+func (r *RulesGroupsOrderResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var ruleGroupsIDs types.List
+	diags := req.Config.GetAttribute(ctx, path.Root("rule_groups_ids"), &ruleGroupsIDs)
+	if diags.HasError() || ruleGroupsIDs.IsNull() || ruleGroupsIDs.IsUnknown() {
+		return
+	}
+
+	seen := make(map[string]int)
+	for i, element := range ruleGroupsIDs.Elements() {
+		ruleGroupID, ok := element.(types.String)
+		if !ok || ruleGroupID.IsNull() || ruleGroupID.IsUnknown() {
+			continue
+		}
+		id := ruleGroupID.ValueString()
+		if j, ok := seen[id]; ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rule_groups_ids").AtListIndex(i),
+				"Duplicate rule-group",
+				fmt.Sprintf("rule-group %q is already listed at rule_groups_ids.%d", id, j),
+			)
+			continue
+		}
+		seen[id] = i
+	}
+}
+
+func (r *RulesGroupsOrderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var ids []string
+	for _, id := range strings.Split(req.ID, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected a comma-separated list of rule-group IDs, got %q", req.ID),
+		)
+		return
+	}
+
+	ruleGroupsIDs, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state := RulesGroupsOrderResourceModel{
+		ID:            types.StringValue(id.UniqueId()),
+		RuleGroupsIDs: ruleGroupsIDs,
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *RulesGroupsOrderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RulesGroupsOrderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids, diags := expandRulesGroupsOrderIDs(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(reorderRulesGroups(ctx, ids, r.client)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = types.StringValue(id.UniqueId())
+
+	r.readRulesGroupsOrder(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || plan.ID.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *RulesGroupsOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RulesGroupsOrderResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.readRulesGroupsOrder(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state.ID.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *RulesGroupsOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan RulesGroupsOrderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids, diags := expandRulesGroupsOrderIDs(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(reorderRulesGroups(ctx, ids, r.client)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.readRulesGroupsOrder(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.ID.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *RulesGroupsOrderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RulesGroupsOrderResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	log.Printf("[INFO] Removing rules-groups order %s from state, the rule-groups keep their current order", state.ID.ValueString())
+}
+
+// readRulesGroupsOrder sets the listed rule-groups of model in their current order. Rule-groups which no longer exist
+// are dropped with a warning, and when none of them exists, the model's ID is set to null.
+func (r *RulesGroupsOrderResource) readRulesGroupsOrder(ctx context.Context, model *RulesGroupsOrderResourceModel, diags *diag.Diagnostics) {
+	ids, dgs := expandRulesGroupsOrderIDs(ctx, *model)
+	diags.Append(dgs...)
+	if diags.HasError() {
+		return
+	}
+
+	ruleGroups := make([]*rulesv1.RuleGroup, 0, len(ids))
+	for _, id := range ids {
+		ruleGroup, err := getRuleGroupForOrder(ctx, id, r.client)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				diags.AddWarning(
+					fmt.Sprintf("Rule-Group %q is in rules-groups order, but no longer exists in Coralogix backend", id),
+					fmt.Sprintf("%s will be removed from the rules-groups order", id),
+				)
+				continue
+			}
+			diags.AddError("Error reading Rules Group", handleRpcErrorNewFrameworkWithID(err, "rule-group", id))
+			return
+		}
+		ruleGroups = append(ruleGroups, ruleGroup)
+	}
+
+	if len(ruleGroups) == 0 {
+		diags.AddWarning(
+			"Rules-groups order is in state, but none of its rule-groups exists in Coralogix backend",
+			"The rules-groups order will be recreated when you apply",
+		)
+		model.ID = types.StringNull()
+		return
+	}
+
+	sort.SliceStable(ruleGroups, func(i, j int) bool {
+		return ruleGroups[i].GetOrder().GetValue() < ruleGroups[j].GetOrder().GetValue()
+	})
+	orderedIDs := make([]string, 0, len(ruleGroups))
+	for _, ruleGroup := range ruleGroups {
+		orderedIDs = append(orderedIDs, ruleGroup.GetId().GetValue())
+	}
+	model.RuleGroupsIDs, dgs = types.ListValueFrom(ctx, types.StringType, orderedIDs)
+	diags.Append(dgs...)
+}
+
+func expandRulesGroupsOrderIDs(ctx context.Context, model RulesGroupsOrderResourceModel) ([]string, diag.Diagnostics) {
+	var ids []string
+	diags := model.RuleGroupsIDs.ElementsAs(ctx, &ids, false)
+	return ids, diags
+}
+
+// reorderRulesGroups moves the given rule-groups into the order they're listed in.
+// The positions currently held by these rule-groups are sorted and handed out by list
+// index, so the slots of rule-groups that aren't listed stay untouched. The rule-groups
+// API has no bulk reorder, so the rule-groups are moved one by one, as planned by
+// planRulesGroupsMoves. The reorder isn't atomic: if a move fails, the moves before it
+// stay applied, and the next apply continues from there.
+//
+// The API re-creates the rule-subgroups and rules of every rule-group it updates, so the
+// moved rule-groups get new rule-subgroup and rule IDs, which coralogix_rules_group picks
+// up on its next refresh.
+func reorderRulesGroups(ctx context.Context, ids []string, client *clientset.RuleGroupsClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+	orders := make([]uint32, 0, len(ids))
+	for _, id := range ids {
+		ruleGroup, err := getRuleGroupForOrder(ctx, id, client)
+		if err != nil {
+			log.Printf("[ERROR] Received error: %#v", err)
+			diags.AddError("Error reading Rules Group", handleRpcErrorNewFrameworkWithID(err, "rule-group", id))
+			return diags
+		}
+		orders = append(orders, ruleGroup.GetOrder().GetValue())
+	}
+
+	moves, err := planRulesGroupsMoves(ids, orders)
+	if err != nil {
+		diags.AddAttributeError(path.Root("rule_groups_ids"), "Error reordering Rules Groups", err.Error())
+		return diags
+	}
+	for _, move := range moves {
+		// Read the rule-group again, as an earlier move may have re-created it.
+		ruleGroup, err := getRuleGroupForOrder(ctx, move.id, client)
+		if err != nil {
+			log.Printf("[ERROR] Received error: %#v", err)
+			diags.AddError("Error reading Rules Group", handleRpcErrorNewFrameworkWithID(err, "rule-group", move.id))
+			return diags
+		}
+		req := ruleGroupToCreateRuleGroupRequest(ruleGroup)
+		req.Order = wrapperspb.UInt32(move.order)
+		updateRuleGroupRequest := &rulesv1.UpdateRuleGroupRequest{
+			GroupId:   wrapperspb.String(move.id),
+			RuleGroup: req,
+		}
+
+		log.Printf("[INFO] Moving rule-group %s from order %d to order %d", move.id, ruleGroup.GetOrder().GetValue(), move.order)
+		if _, err := client.UpdateRuleGroup(ctx, updateRuleGroupRequest); err != nil {
+			log.Printf("[ERROR] Received error: %#v", err)
+			diags.AddError("Error updating Rules Group", handleRpcErrorNewFrameworkWithID(err, "rule-group", move.id))
+			return diags
+		}
+	}
+
+	return diags
+}
+
+type rulesGroupMove struct {
+	id    string
+	order uint32
+}
+
+// planRulesGroupsMoves returns the updates which put ids (whose current orders are given
+// by orders) into the sorted orders, in the listed order. Moving a rule-group to an order
+// shifts every rule-group between its old and new order by one, so the slots are filled
+// from the first to the last: a listed rule-group is moved into its slot, and a listed
+// rule-group that sits in the slot of a rule-group that isn't listed is moved right after
+// the next unlisted one, until that one reaches its slot.
+// Rule-groups sharing an order can't be placed, so they're returned as an error.
+func planRulesGroupsMoves(ids []string, orders []uint32) ([]rulesGroupMove, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	positions := append([]uint32(nil), orders...)
+	sort.Slice(positions, func(i, j int) bool { return positions[i] < positions[j] })
+	first, last := positions[0], positions[len(positions)-1]
+
+	// current[i] holds the rule-group at order first+i, or "" for one that isn't listed,
+	// and wanted[i] holds the rule-group that should end up there.
+	current := make([]string, last-first+1)
+	wanted := make([]string, last-first+1)
+	for i, id := range ids {
+		if other := current[orders[i]-first]; other != "" {
+			return nil, fmt.Errorf("rule-groups %q and %q have the same order %d, so they can't be reordered", other, id, orders[i])
+		}
+		current[orders[i]-first] = id
+		wanted[positions[i]-first] = id
+	}
+
+	var moves []rulesGroupMove
+	move := func(from, to int) {
+		id := current[from]
+		current = append(current[:from], current[from+1:]...)
+		current = append(current[:to], append([]string{id}, current[to:]...)...)
+		moves = append(moves, rulesGroupMove{id: id, order: first + uint32(to)})
+	}
+	for slot := range wanted {
+		if wanted[slot] == "" {
+			for current[slot] != "" {
+				next := slot + 1
+				for current[next] != "" {
+					next++
+				}
+				move(slot, next)
+			}
+			continue
+		}
+		if current[slot] != wanted[slot] {
+			for from := slot + 1; from < len(current); from++ {
+				if current[from] == wanted[slot] {
+					move(from, slot)
+					break
+				}
+			}
+		}
+	}
+
+	return moves, nil
+}
+
+func getRuleGroupForOrder(ctx context.Context, id string, client *clientset.RuleGroupsClient) (*rulesv1.RuleGroup, error) {
+	log.Printf("[INFO] Reading rule-group %s", id)
+	ruleGroupResp, err := client.GetRuleGroup(ctx, &rulesv1.GetRuleGroupRequest{GroupId: id})
+	if err != nil {
+		return nil, err
+	}
+	ruleGroup := ruleGroupResp.GetRuleGroup()
+	log.Printf("[INFO] Received rule-group: %#v", ruleGroup)
+	return ruleGroup, nil
+}
+
+func ruleGroupToCreateRuleGroupRequest(ruleGroup *rulesv1.RuleGroup) *rulesv1.CreateRuleGroupRequest {
+	ruleSubgroups := make([]*rulesv1.CreateRuleGroupRequest_CreateRuleSubgroup, 0, len(ruleGroup.GetRuleSubgroups()))
+	for _, ruleSubgroup := range ruleGroup.GetRuleSubgroups() {
+		rules := make([]*rulesv1.CreateRuleGroupRequest_CreateRuleSubgroup_CreateRule, 0, len(ruleSubgroup.GetRules()))
+		for _, rule := range ruleSubgroup.GetRules() {
+			rules = append(rules, &rulesv1.CreateRuleGroupRequest_CreateRuleSubgroup_CreateRule{
+				Name:        rule.GetName(),
+				Description: rule.GetDescription(),
+				SourceField: rule.GetSourceField(),
+				Parameters:  rule.GetParameters(),
+				Enabled:     rule.GetEnabled(),
+				Order:       rule.GetOrder(),
+			})
+		}
+		ruleSubgroups = append(ruleSubgroups, &rulesv1.CreateRuleGroupRequest_CreateRuleSubgroup{
+			Rules:   rules,
+			Enabled: ruleSubgroup.GetEnabled(),
+			Order:   ruleSubgroup.GetOrder(),
+		})
+	}
+
+	return &rulesv1.CreateRuleGroupRequest{
+		Name:          ruleGroup.GetName(),
+		Description:   ruleGroup.GetDescription(),
+		Enabled:       ruleGroup.GetEnabled(),
+		Hidden:        ruleGroup.GetHidden(),
+		Creator:       ruleGroup.GetCreator(),
+		RuleMatchers:  ruleGroup.GetRuleMatchers(),
+		RuleSubgroups: ruleSubgroups,
+		Order:         ruleGroup.GetOrder(),
+	}
+}
@@ -0,0 +1,173 @@
+package coralogix
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"terraform-provider-coralogix/coralogix/clientset"
+	rulesv1 "terraform-provider-coralogix/coralogix/clientset/grpc/rules-groups/v1"
+	"terraform-provider-coralogix/coralogix/mockserver"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var rulesGroupsOrderResourceName = "coralogix_rules_groups_order.test"
+
+func TestAccCoralogixResourceRulesGroupsOrder(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxedProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoralogixResourceRulesGroupsOrder("test1", "test2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(rulesGroupsOrderResourceName, "id"),
+					resource.TestCheckResourceAttr(rulesGroupsOrderResourceName, "rule_groups_ids.#", "2"),
+					resource.TestCheckResourceAttrPair(rulesGroupsOrderResourceName, "rule_groups_ids.0", "coralogix_rules_group.test1", "id"),
+					resource.TestCheckResourceAttrPair(rulesGroupsOrderResourceName, "rule_groups_ids.1", "coralogix_rules_group.test2", "id"),
+				),
+			},
+			{
+				Config: testAccCoralogixResourceRulesGroupsOrder("test2", "test1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rulesGroupsOrderResourceName, "rule_groups_ids.#", "2"),
+					resource.TestCheckResourceAttrPair(rulesGroupsOrderResourceName, "rule_groups_ids.0", "coralogix_rules_group.test2", "id"),
+					resource.TestCheckResourceAttrPair(rulesGroupsOrderResourceName, "rule_groups_ids.1", "coralogix_rules_group.test1", "id"),
+				),
+			},
+			{
+				Config:   testAccCoralogixResourceRulesGroupsOrder("test2", "test1"),
+				PlanOnly: true,
+			},
+			{
+				Config:      testAccCoralogixResourceRulesGroupsOrder("test1", "test1"),
+				ExpectError: regexp.MustCompile(`is already listed at rule_groups_ids.0`),
+			},
+		},
+	})
+}
+
+func TestReorderRulesGroups(t *testing.T) {
+	tests := []struct {
+		name     string
+		order    string
+		expected string
+	}{
+		{name: "already ordered", order: "ace", expected: "abcdef"},
+		{name: "adjacent", order: "cba", expected: "cbadef"},
+		{name: "all", order: "fedcba", expected: "fedcba"},
+		{name: "unlisted in between", order: "eac", expected: "ebadcf"},
+		{name: "unlisted first", order: "dfb", expected: "adcfeb"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, err := mockserver.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer server.Close()
+			ctx := context.Background()
+			client := clientset.NewClientSet(server.URL(), "mock-api-key", "").RuleGroups()
+
+			ids := make(map[string]string)
+			for _, name := range "abcdef" {
+				resp, err := client.CreateRuleGroup(ctx, &rulesv1.CreateRuleGroupRequest{
+					Name: wrapperspb.String(string(name)),
+					RuleSubgroups: []*rulesv1.CreateRuleGroupRequest_CreateRuleSubgroup{{
+						Rules: []*rulesv1.CreateRuleGroupRequest_CreateRuleSubgroup_CreateRule{{
+							Name:        wrapperspb.String("rule"),
+							SourceField: wrapperspb.String("text"),
+							Parameters: &rulesv1.RuleParameters{RuleParameters: &rulesv1.RuleParameters_BlockParameters{
+								BlockParameters: &rulesv1.BlockParameters{Rule: wrapperspb.String("error")},
+							}},
+						}},
+					}},
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+				ids[string(name)] = resp.GetRuleGroup().GetId().GetValue()
+			}
+
+			var orderIDs []string
+			for _, name := range tt.order {
+				orderIDs = append(orderIDs, ids[string(name)])
+			}
+			if diags := reorderRulesGroups(ctx, orderIDs, client); diags.HasError() {
+				t.Fatalf("reorderRulesGroups: %v", diags)
+			}
+
+			actual := make([]string, len(ids))
+			for name, id := range ids {
+				ruleGroup, err := getRuleGroupForOrder(ctx, id, client)
+				if err != nil {
+					t.Fatal(err)
+				}
+				actual[ruleGroup.GetOrder().GetValue()-1] = name
+			}
+			if got := strings.Join(actual, ""); got != tt.expected {
+				t.Errorf("expected the rule-groups in order %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestPlanRulesGroupsMovesDuplicateOrders(t *testing.T) {
+	_, err := planRulesGroupsMoves([]string{"a", "b", "c"}, []uint32{3, 1, 3})
+	if err == nil || !strings.Contains(err.Error(), `"a" and "c" have the same order 3`) {
+		t.Errorf("expected an error about the duplicate order, got %v", err)
+	}
+}
+
+func testAccCoralogixResourceRulesGroupsOrder(first, second string) string {
+	return fmt.Sprintf(`resource "coralogix_rules_group" "test1" {
+  name           = "name1"
+  description    = "description1"
+  creator        = "creator1"
+  rule_subgroups = [
+    {
+      rules = [
+        {
+          block = {
+            name               = "rule1"
+            source_field       = "text"
+            regular_expression = "sql_error_code\\s*=\\s*28000"
+          }
+        }
+      ]
+    }
+  ]
+}
+
+resource "coralogix_rules_group" "test2" {
+  name           = "name2"
+  description    = "description2"
+  creator        = "creator2"
+  rule_subgroups = [
+    {
+      rules = [
+        {
+          block = {
+            name               = "rule1"
+            source_field       = "text"
+            regular_expression = "sql_error_code\\s*=\\s*28000"
+          }
+        }
+      ]
+    }
+  ]
+}
+
+resource "coralogix_rules_groups_order" "test" {
+  rule_groups_ids = [
+    coralogix_rules_group.%s.id,
+    coralogix_rules_group.%s.id,
+  ]
+}
+`, first, second)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -21,6 +22,7 @@ import (
 var (
 	validWebhookTypes = []string{"slack", "custom", "pager_duty", "email_group", "microsoft_teams", "jira", "opsgenie", "sendlog", "demisto"}
 	validMethods      = []string{"get", "post", "put"}
+	webhookIDRegex    = regexp.MustCompile(`^\d+$`)
 )
 
 func resourceCoralogixWebhook() *schema.Resource {
@@ -204,8 +206,8 @@ func WebhookSchema() map[string]*schema.Schema {
 						Type:     schema.TypeSet,
 						Required: true,
 						Elem: &schema.Schema{
-							Type: schema.TypeString,
-							//ValidateDiagFunc: mailValidationFunc(),
+							Type:             schema.TypeString,
+							ValidateDiagFunc: mailValidationFunc(),
 						},
 						Set: schema.HashString,
 					},
@@ -244,9 +246,9 @@ func WebhookSchema() map[string]*schema.Schema {
 						Required: true,
 					},
 					"email": {
-						Type:     schema.TypeString,
-						Required: true,
-						//ValidateDiagFunc: mailValidationFunc(),
+						Type:             schema.TypeString,
+						Required:         true,
+						ValidateDiagFunc: mailValidationFunc(),
 					},
 					"project_key": {
 						Type:     schema.TypeString,
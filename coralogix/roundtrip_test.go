@@ -0,0 +1,427 @@
+package coralogix
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+// The round-trip tests check that every expand* function is the inverse of its flatten* function: an object
+// read from the API, flattened into the state and expanded back, has to be sent unchanged. Otherwise a field is
+// lost (or changed) whenever the provider updates the object.
+//
+// The objects are golden files under testdata/roundtrip/<resource>, in the API's JSON format. Each resource
+// test also checks that the fixtures cover every oneof variant of the API object (so a new variant needs a
+// fixture, or an explicit reason why it isn't supported), and runs the round-trip on random variations of the
+// fixtures, which keep their structure but get random leaf values.
+
+// roundTripIterations is the number of random variations of every fixture.
+const roundTripIterations = 50
+
+// loadRoundTripFixtures reads the golden files of a resource, by file name.
+func loadRoundTripFixtures[T proto.Message](t *testing.T, resourceName string, newMessage func() T) map[string]T {
+	t.Helper()
+
+	paths, err := filepath.Glob(filepath.Join("testdata", "roundtrip", resourceName, "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) == 0 {
+		t.Fatalf("no round-trip fixtures found for %s", resourceName)
+	}
+
+	fixtures := make(map[string]T, len(paths))
+	for _, p := range paths {
+		b, err := os.ReadFile(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		message := newMessage()
+		if err = protojson.Unmarshal(b, message); err != nil {
+			t.Fatalf("%s: %s", p, err)
+		}
+		fixtures[strings.TrimSuffix(filepath.Base(p), ".json")] = message
+	}
+	return fixtures
+}
+
+// sortedFixtureNames keeps the order of the subtests, and of the random values they use, stable.
+func sortedFixtureNames[T any](fixtures map[string]T) []string {
+	names := make([]string, 0, len(fixtures))
+	for name := range fixtures {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// assertProtoEqual fails with a diff when the expanded message isn't the one the fixture started from.
+// opts can ignore the order of repeated fields that are sets in the schema.
+func assertProtoEqual(t *testing.T, want, got proto.Message, opts ...cmp.Option) {
+	t.Helper()
+
+	opts = append([]cmp.Option{protocmp.Transform()}, opts...)
+	if diff := cmp.Diff(want, got, opts...); diff != "" {
+		wantJSON, _ := protojson.Marshal(want)
+		t.Fatalf("round-trip changed the object (-want +got):\n%s\nobject: %s", diff, wantJSON)
+	}
+}
+
+// roundTripFrameworkState passes a model through the state of a plugin-framework resource, as between a Read
+// and the next plan, which catches models that don't match the schema.
+func roundTripFrameworkState[M any](t *testing.T, r resource.Resource, model M) M {
+	t.Helper()
+
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("schema: %v", schemaResp.Diagnostics)
+	}
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	if diags := state.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("set state: %v", diags)
+	}
+
+	var result M
+	if diags := state.Get(ctx, &result); diags.HasError() {
+		t.Fatalf("get state: %v", diags)
+	}
+	return result
+}
+
+// assertOneofCoverage fails for every oneof variant of the message type that no fixture uses, unless it's one
+// of the unsupported variants (by the variant's full field name, with the reason as value).
+func assertOneofCoverage[T proto.Message](t *testing.T, fixtures map[string]T, unsupported map[string]string) {
+	t.Helper()
+
+	var descriptor protoreflect.MessageDescriptor
+	covered := make(map[protoreflect.FullName]bool)
+	for _, fixture := range fixtures {
+		descriptor = fixture.ProtoReflect().Descriptor()
+		collectPopulatedOneofVariants(fixture.ProtoReflect(), covered)
+	}
+
+	for _, variant := range collectOneofVariants(descriptor, make(map[protoreflect.FullName]bool), nil) {
+		if _, ok := unsupported[string(variant)]; ok {
+			continue
+		}
+		if !covered[variant] {
+			t.Errorf("no round-trip fixture covers the oneof variant %s. Add a fixture that uses it, "+
+				"or list it as unsupported with the reason", variant)
+		}
+	}
+
+	for variant := range unsupported {
+		if covered[protoreflect.FullName(variant)] {
+			t.Errorf("the oneof variant %s is listed as unsupported, but a round-trip fixture uses it", variant)
+		}
+	}
+}
+
+// collectOneofVariants lists the variants of every oneof reachable from the message type.
+func collectOneofVariants(descriptor protoreflect.MessageDescriptor, visited map[protoreflect.FullName]bool, variants []protoreflect.FullName) []protoreflect.FullName {
+	if visited[descriptor.FullName()] || isWellKnownType(descriptor) {
+		return variants
+	}
+	visited[descriptor.FullName()] = true
+
+	fields := descriptor.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if oneof := field.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() {
+			variants = append(variants, field.FullName())
+		}
+		if field.IsMap() {
+			field = field.MapValue()
+		}
+		if field.Message() != nil {
+			variants = collectOneofVariants(field.Message(), visited, variants)
+		}
+	}
+	return variants
+}
+
+func collectPopulatedOneofVariants(message protoreflect.Message, covered map[protoreflect.FullName]bool) {
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if oneof := field.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() {
+			covered[field.FullName()] = true
+		}
+		switch {
+		case field.IsList() && field.Message() != nil:
+			list := value.List()
+			for i := 0; i < list.Len(); i++ {
+				collectPopulatedOneofVariants(list.Get(i).Message(), covered)
+			}
+		case field.IsMap() && field.MapValue().Message() != nil:
+			value.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+				collectPopulatedOneofVariants(v.Message(), covered)
+				return true
+			})
+		case !field.IsList() && !field.IsMap() && field.Message() != nil:
+			collectPopulatedOneofVariants(value.Message(), covered)
+		}
+		return true
+	})
+}
+
+func isWellKnownType(descriptor protoreflect.MessageDescriptor) bool {
+	return descriptor.ParentFile().Package() == "google.protobuf"
+}
+
+// roundTripField returns the full name of a field of the message, for the generators. It panics for fields
+// that don't exist, so a renamed field can't silently stop being fixed.
+func roundTripField(message proto.Message, name protoreflect.Name) protoreflect.FullName {
+	field := message.ProtoReflect().Descriptor().Fields().ByName(name)
+	if field == nil {
+		panic(fmt.Sprintf("%s has no field %s", message.ProtoReflect().Descriptor().FullName(), name))
+	}
+	return field.FullName()
+}
+
+// roundTripEnumValue returns the full name of an enum value, for the generators' excluded values.
+func roundTripEnumValue(value protoreflect.Enum) protoreflect.FullName {
+	return value.Descriptor().Values().ByNumber(value.Number()).FullName()
+}
+
+// roundTripGenerator generates random variations of a fixture. Every populated leaf gets a random value of
+// its kind, unless the field has its own generator (for values with a format or a range), or is fixed (kept
+// as in the fixture, e.g. ids). Wrapped values are keyed by the field holding the wrapper.
+type roundTripGenerator struct {
+	rand *rand.Rand
+	// fixed fields (and, for messages, everything in them) keep the fixture's value.
+	fixed map[protoreflect.FullName]bool
+	// generators return a value for a field, or for every element of a repeated field. The value has the field's
+	// type, so it's a message for wrapped values.
+	generators map[protoreflect.FullName]func(r *rand.Rand) protoreflect.Value
+	// excludedEnumValues are enum values (by full name) that the schema doesn't support.
+	excludedEnumValues map[protoreflect.FullName]bool
+}
+
+func newRoundTripGenerator(seed int64) *roundTripGenerator {
+	return &roundTripGenerator{
+		rand:               rand.New(rand.NewSource(seed)),
+		fixed:              make(map[protoreflect.FullName]bool),
+		generators:         make(map[protoreflect.FullName]func(r *rand.Rand) protoreflect.Value),
+		excludedEnumValues: make(map[protoreflect.FullName]bool),
+	}
+}
+
+// variation returns a random variation of the fixture.
+func (g *roundTripGenerator) variation(fixture proto.Message) proto.Message {
+	message := proto.Clone(fixture)
+	g.randomizeMessage(message.ProtoReflect(), "")
+	return message
+}
+
+// randomizeMessage randomizes the populated fields of a message. holder is the field holding a wrapper.
+func (g *roundTripGenerator) randomizeMessage(message protoreflect.Message, holder protoreflect.FullName) {
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		name := field.FullName()
+		if isWellKnownType(message.Descriptor()) && holder != "" {
+			name = holder
+		}
+		if g.fixed[name] {
+			return true
+		}
+
+		switch {
+		case field.IsMap():
+			g.randomizeMap(message, field, name)
+		case field.IsList():
+			g.randomizeList(field, value.List(), name)
+		case field.Message() != nil:
+			if generator, ok := g.generators[name]; ok {
+				message.Set(field, generator(g.rand))
+			} else {
+				g.randomizeMessage(value.Message(), name)
+			}
+		default:
+			message.Set(field, g.randomScalar(field, name))
+		}
+		return true
+	})
+}
+
+func (g *roundTripGenerator) randomizeList(field protoreflect.FieldDescriptor, list protoreflect.List, name protoreflect.FullName) {
+	if field.Enum() != nil && g.generators[name] == nil {
+		// Enum lists are sets of values, so the values are kept distinct.
+		values := g.enumValues(field.Enum())
+		g.rand.Shuffle(len(values), func(i, j int) { values[i], values[j] = values[j], values[i] })
+		n := list.Len()
+		if n > len(values) {
+			n = len(values)
+		}
+		list.Truncate(0)
+		for _, v := range values[:n] {
+			list.Append(protoreflect.ValueOfEnum(v))
+		}
+		return
+	}
+
+	for i := 0; i < list.Len(); i++ {
+		switch {
+		case g.generators[name] != nil:
+			list.Set(i, g.generators[name](g.rand))
+		case field.Message() != nil:
+			g.randomizeMessage(list.Get(i).Message(), name)
+		default:
+			list.Set(i, g.randomScalar(field, name))
+		}
+	}
+}
+
+func (g *roundTripGenerator) randomizeMap(message protoreflect.Message, field protoreflect.FieldDescriptor, name protoreflect.FullName) {
+	old := message.Get(field).Map()
+	randomized := message.NewField(field).Map()
+	old.Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+		key := protoreflect.ValueOfString(g.randomString()).MapKey()
+		if field.MapValue().Message() != nil {
+			g.randomizeMessage(v.Message(), name)
+			randomized.Set(key, v)
+		} else {
+			randomized.Set(key, g.randomScalar(field.MapValue(), name))
+		}
+		return true
+	})
+	message.Set(field, protoreflect.ValueOfMap(randomized))
+}
+
+func (g *roundTripGenerator) randomScalar(field protoreflect.FieldDescriptor, name protoreflect.FullName) protoreflect.Value {
+	if generator, ok := g.generators[name]; ok {
+		return generator(g.rand)
+	}
+
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		return protoreflect.ValueOfBool(g.rand.Intn(2) == 0)
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(g.randomString())
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return protoreflect.ValueOfInt32(int32(g.rand.Intn(1000) + 1))
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return protoreflect.ValueOfInt64(int64(g.rand.Intn(1000) + 1))
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return protoreflect.ValueOfUint32(uint32(g.rand.Intn(1000) + 1))
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return protoreflect.ValueOfUint64(uint64(g.rand.Intn(1000) + 1))
+	case protoreflect.FloatKind:
+		// Quarters are exact in float32, so converting to float64 and back doesn't change them.
+		return protoreflect.ValueOfFloat32(float32(g.rand.Intn(1000)+1) / 4)
+	case protoreflect.DoubleKind:
+		return protoreflect.ValueOfFloat64(float64(g.rand.Intn(1000)+1) / 4)
+	case protoreflect.EnumKind:
+		values := g.enumValues(field.Enum())
+		return protoreflect.ValueOfEnum(values[g.rand.Intn(len(values))])
+	default:
+		panic(fmt.Sprintf("unsupported kind %s of %s", field.Kind(), name))
+	}
+}
+
+// enumValues returns the enum's values, without the zero (unspecified) value and the excluded ones.
+func (g *roundTripGenerator) enumValues(enum protoreflect.EnumDescriptor) []protoreflect.EnumNumber {
+	var values []protoreflect.EnumNumber
+	for i := 0; i < enum.Values().Len(); i++ {
+		value := enum.Values().Get(i)
+		if value.Number() == 0 || g.excludedEnumValues[value.FullName()] {
+			continue
+		}
+		values = append(values, value.Number())
+	}
+	return values
+}
+
+func (g *roundTripGenerator) randomString() string {
+	const letters = "abcdefghijklmnopqrstuvwxyz0123456789"
+	b := make([]byte, 8)
+	for i := range b {
+		b[i] = letters[g.rand.Intn(len(letters))]
+	}
+	return "rt-" + string(b)
+}
+
+// The state upgrade tests feed the framework resources' state upgraders with states written by the SDKv2
+// resources they replaced. The states are golden files under testdata/state_upgrade/<resource>, in the raw JSON
+// format of the v0 state, named like the round-trip fixtures of the same objects.
+
+// loadStateUpgradeFixtures reads the v0 states of a resource, by file name.
+func loadStateUpgradeFixtures(t *testing.T, resourceName string) map[string][]byte {
+	t.Helper()
+
+	paths, err := filepath.Glob(filepath.Join("testdata", "state_upgrade", resourceName, "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) == 0 {
+		t.Fatalf("no state upgrade fixtures found for %s", resourceName)
+	}
+
+	fixtures := make(map[string][]byte, len(paths))
+	for _, p := range paths {
+		b, err := os.ReadFile(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fixtures[strings.TrimSuffix(filepath.Base(p), ".json")] = b
+	}
+	return fixtures
+}
+
+// upgradeFrameworkState runs the resource's upgrader from version 0 on a raw v0 state, and returns the
+// upgraded state.
+func upgradeFrameworkState(t *testing.T, r resource.ResourceWithUpgradeState, rawState []byte) tfsdk.State {
+	t.Helper()
+
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("schema: %v", schemaResp.Diagnostics)
+	}
+
+	upgrader, ok := r.UpgradeState(ctx)[0]
+	if !ok {
+		t.Fatal("no state upgrader from version 0")
+	}
+	req := resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: rawState}}
+	resp := resource.UpgradeStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	upgrader.StateUpgrader(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("upgrade state: %v", resp.Diagnostics)
+	}
+	return resp.State
+}
+
+// assertAttrValue fails when an upgraded value isn't the expected one.
+func assertAttrValue(t *testing.T, name string, want, got attr.Value) {
+	t.Helper()
+
+	if !want.Equal(got) {
+		t.Errorf("%s: got %s, want %s", name, got, want)
+	}
+}
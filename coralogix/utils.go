@@ -5,10 +5,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"math/rand"
+	"net/mail"
 	"net/url"
 	"reflect"
-	"regexp"
 	"strconv"
 	"time"
 
@@ -17,11 +18,12 @@ import (
 	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	diag2 "github.com/hashicorp/terraform-plugin-framework/diag"
 	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -29,9 +31,10 @@ import (
 )
 
 var (
-	msInHour   = int(time.Hour.Milliseconds())
-	msInMinute = int(time.Minute.Milliseconds())
-	msInSecond = int(time.Second.Milliseconds())
+	msInHour     = int(time.Hour.Milliseconds())
+	msInMinute   = int(time.Minute.Milliseconds())
+	msInSecond   = int(time.Second.Milliseconds())
+	minutesInDay = int32((24 * time.Hour).Minutes())
 )
 
 func handleRpcError(err error, resource string) diag.Diagnostics {
@@ -67,6 +70,13 @@ func handleRpcErrorWithID(err error, resource, id string) diag.Diagnostics {
 	return handleRpcError(err, resource)
 }
 
+func handleRpcErrorNewFrameworkWithID(err error, resource, id string) string {
+	if status.Code(err) == codes.NotFound {
+		return fmt.Sprintf("no %s with id %s found", resource, id)
+	}
+	return handleRpcErrorNewFramework(err, resource)
+}
+
 // datasourceSchemaFromResourceSchema is a recursive func that
 // converts an existing Resource schema to a Datasource schema.
 // All schema elements are copied, but certain attributes are ignored or changed:
@@ -238,6 +248,159 @@ func convertAttribute(resourceAttribute resourceschema.Attribute) datasourcesche
 	}
 }
 
+// convertInputAttributes converts resource attributes into data source attributes which are configured the same way,
+// keeping whether they're required and their validators. Attributes which can only be computed, and the excluded
+// top level attributes, are dropped. Data source attributes have no defaults - frameworkObjectToMap fills them in.
+func convertInputAttributes(attributes map[string]resourceschema.Attribute, excluded ...string) map[string]datasourceschema.Attribute {
+	skipped := make(map[string]bool, len(excluded))
+	for _, k := range excluded {
+		skipped[k] = true
+	}
+	result := make(map[string]datasourceschema.Attribute, len(attributes))
+	for k, v := range attributes {
+		if skipped[k] || !v.IsRequired() && !v.IsOptional() {
+			continue
+		}
+		result[k] = convertInputAttribute(v)
+	}
+	return result
+}
+
+func convertInputAttribute(resourceAttribute resourceschema.Attribute) datasourceschema.Attribute {
+	switch attr := resourceAttribute.(type) {
+	case resourceschema.BoolAttribute:
+		return datasourceschema.BoolAttribute{
+			Required:            attr.Required,
+			Optional:            attr.Optional,
+			Validators:          attr.Validators,
+			Description:         attr.Description,
+			MarkdownDescription: attr.MarkdownDescription,
+		}
+	case resourceschema.Int64Attribute:
+		return datasourceschema.Int64Attribute{
+			Required:            attr.Required,
+			Optional:            attr.Optional,
+			Validators:          attr.Validators,
+			Description:         attr.Description,
+			MarkdownDescription: attr.MarkdownDescription,
+		}
+	case resourceschema.StringAttribute:
+		return datasourceschema.StringAttribute{
+			Required:            attr.Required,
+			Optional:            attr.Optional,
+			Validators:          attr.Validators,
+			Description:         attr.Description,
+			MarkdownDescription: attr.MarkdownDescription,
+		}
+	case resourceschema.SetAttribute:
+		return datasourceschema.SetAttribute{
+			Required:            attr.Required,
+			Optional:            attr.Optional,
+			ElementType:         attr.ElementType,
+			Validators:          attr.Validators,
+			Description:         attr.Description,
+			MarkdownDescription: attr.MarkdownDescription,
+		}
+	case resourceschema.ListAttribute:
+		return datasourceschema.ListAttribute{
+			Required:            attr.Required,
+			Optional:            attr.Optional,
+			ElementType:         attr.ElementType,
+			Validators:          attr.Validators,
+			Description:         attr.Description,
+			MarkdownDescription: attr.MarkdownDescription,
+		}
+	case resourceschema.ListNestedAttribute:
+		return datasourceschema.ListNestedAttribute{
+			Required: attr.Required,
+			Optional: attr.Optional,
+			NestedObject: datasourceschema.NestedAttributeObject{
+				Attributes: convertInputAttributes(attr.NestedObject.Attributes),
+				Validators: attr.NestedObject.Validators,
+			},
+			Validators:          attr.Validators,
+			Description:         attr.Description,
+			MarkdownDescription: attr.MarkdownDescription,
+		}
+	case resourceschema.SingleNestedAttribute:
+		return datasourceschema.SingleNestedAttribute{
+			Required:            attr.Required,
+			Optional:            attr.Optional,
+			Attributes:          convertInputAttributes(attr.Attributes),
+			Validators:          attr.Validators,
+			Description:         attr.Description,
+			MarkdownDescription: attr.MarkdownDescription,
+		}
+	default:
+		panic(fmt.Sprintf("unsupported resource attribute type: %T", resourceAttribute))
+	}
+}
+
+// frameworkObjectToMap converts an object configured with attributes from convertInputAttributes into a map, the
+// way the SDK reads objects. Null attributes get the defaults of the resource attributes, or nil when they have none.
+func frameworkObjectToMap(ctx context.Context, attributes map[string]resourceschema.Attribute, object types.Object) map[string]interface{} {
+	if object.IsNull() || object.IsUnknown() {
+		return nil
+	}
+	result := make(map[string]interface{}, len(object.Attributes()))
+	for k, v := range object.Attributes() {
+		result[k] = frameworkValueToInterface(ctx, attributes[k], v)
+	}
+	return result
+}
+
+func frameworkValueToInterface(ctx context.Context, resourceAttribute resourceschema.Attribute, value attr.Value) interface{} {
+	if value.IsNull() || value.IsUnknown() {
+		switch attr := resourceAttribute.(type) {
+		case resourceschema.BoolAttribute:
+			if attr.Default != nil {
+				var resp defaults.BoolResponse
+				attr.Default.DefaultBool(ctx, defaults.BoolRequest{}, &resp)
+				return resp.PlanValue.ValueBool()
+			}
+		case resourceschema.StringAttribute:
+			if attr.Default != nil {
+				var resp defaults.StringResponse
+				attr.Default.DefaultString(ctx, defaults.StringRequest{}, &resp)
+				return resp.PlanValue.ValueString()
+			}
+		}
+		return nil
+	}
+
+	switch v := value.(type) {
+	case types.Bool:
+		return v.ValueBool()
+	case types.Int64:
+		return v.ValueInt64()
+	case types.String:
+		return v.ValueString()
+	case types.Set:
+		return frameworkElementsToInterfaces(ctx, resourceAttribute, v.Elements())
+	case types.List:
+		return frameworkElementsToInterfaces(ctx, resourceAttribute, v.Elements())
+	case types.Object:
+		var attributes map[string]resourceschema.Attribute
+		switch attr := resourceAttribute.(type) {
+		case resourceschema.SingleNestedAttribute:
+			attributes = attr.Attributes
+		case resourceschema.ListNestedAttribute:
+			attributes = attr.NestedObject.Attributes
+		}
+		return frameworkObjectToMap(ctx, attributes, v)
+	default:
+		panic(fmt.Sprintf("unsupported value type: %T", value))
+	}
+}
+
+func frameworkElementsToInterfaces(ctx context.Context, resourceAttribute resourceschema.Attribute, elements []attr.Value) []interface{} {
+	result := make([]interface{}, 0, len(elements))
+	for _, element := range elements {
+		result = append(result, frameworkValueToInterface(ctx, resourceAttribute, element))
+	}
+	return result
+}
+
 //func convertBlocks(blocks map[string]resourceschema.Block) map[string]datasourceschema.Block {
 //	result := make(map[string]datasourceschema.Block, len(blocks))
 //	for k, v := range blocks {
@@ -304,6 +467,28 @@ func wrappedStringSliceToTypeStringSlice(s []*wrapperspb.StringValue) types.Set
 	return types.SetValueMust(types.StringType, elements)
 }
 
+func wrappedStringSliceToTypeStringList(s []*wrapperspb.StringValue) types.List {
+	if len(s) == 0 {
+		return types.ListNull(types.StringType)
+	}
+	elements := make([]attr.Value, 0, len(s))
+	for _, v := range s {
+		elements = append(elements, types.StringValue(v.GetValue()))
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
+func stringSliceToTypeStringSet(s []string) types.Set {
+	if len(s) == 0 {
+		return types.SetNull(types.StringType)
+	}
+	elements := make([]attr.Value, 0, len(s))
+	for _, v := range s {
+		elements = append(elements, types.StringValue(v))
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
+
 func typeStringSliceToWrappedStringSlice(s []attr.Value) []*wrapperspb.StringValue {
 	result := make([]*wrapperspb.StringValue, 0, len(s))
 	for _, v := range s {
@@ -315,16 +500,6 @@ func typeStringSliceToWrappedStringSlice(s []attr.Value) []*wrapperspb.StringVal
 	return result
 }
 
-func timeInDaySchema(description string) *schema.Schema {
-	timeRegex := regexp.MustCompile(`^(0\d|1\d|2[0-3]):[0-5]\d$`)
-	return &schema.Schema{
-		Type:         schema.TypeString,
-		Required:     true,
-		ValidateFunc: validation.StringMatch(timeRegex, "not valid time, only HH:MM format is allowed"),
-		Description:  description,
-	}
-}
-
 func toTwoDigitsFormat(digit int32) string {
 	digitStr := fmt.Sprintf("%d", digit)
 	if len(digitStr) == 1 {
@@ -333,69 +508,6 @@ func toTwoDigitsFormat(digit int32) string {
 	return digitStr
 }
 
-func timeSchema(description string) *schema.Schema {
-	return &schema.Schema{
-		Type:     schema.TypeList,
-		Optional: true,
-		MaxItems: 1,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"hours": {
-					Type:         schema.TypeInt,
-					Optional:     true,
-					ValidateFunc: validation.IntAtLeast(0),
-				},
-				"minutes": {
-					Type:         schema.TypeInt,
-					Optional:     true,
-					ValidateFunc: validation.IntAtLeast(0),
-				},
-				"seconds": {
-					Type:         schema.TypeInt,
-					Optional:     true,
-					ValidateFunc: validation.IntAtLeast(0),
-				},
-			},
-		},
-		Description: description,
-	}
-}
-
-func expandTimeToMS(v interface{}) int {
-	l := v.([]interface{})
-	if len(l) == 0 {
-		return 0
-	}
-
-	m := l[0].(map[string]interface{})
-
-	timeMS := msInHour * m["hours"].(int)
-	timeMS += msInMinute * m["minutes"].(int)
-	timeMS += msInSecond * m["seconds"].(int)
-
-	return timeMS
-}
-
-func flattenTimeframe(timeMS int) []interface{} {
-	if timeMS == 0 {
-		return nil
-	}
-
-	hours := timeMS / msInHour
-	timeMS -= hours * msInHour
-
-	minutes := timeMS / msInMinute
-	timeMS -= minutes * msInMinute
-
-	seconds := timeMS / msInSecond
-
-	return []interface{}{map[string]int{
-		"hours":   hours,
-		"minutes": minutes,
-		"seconds": seconds,
-	}}
-}
-
 func sliceToString(data []string) string {
 	b, _ := json.Marshal(data)
 	return fmt.Sprintf("%v", string(b))
@@ -532,6 +644,57 @@ func (u urlValidationFuncFramework) ValidateString(ctx context.Context, req vali
 	}
 }
 
+func mailValidationFunc() schema.SchemaValidateDiagFunc {
+	return func(v interface{}, _ cty.Path) diag.Diagnostics {
+		if err := validateMailAddress(v.(string)); err != nil {
+			return diag.Errorf("%s is not a valid email address - %s", v.(string), err.Error())
+		}
+		return nil
+	}
+}
+
+type mailValidationFuncFramework struct {
+}
+
+func (m mailValidationFuncFramework) Description(_ context.Context) string {
+	return "string must be a valid email address"
+}
+
+func (m mailValidationFuncFramework) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m mailValidationFuncFramework) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	if err := validateMailAddress(value); err != nil {
+		resp.Diagnostics.Append(
+			diag2.NewAttributeErrorDiagnostic(
+				req.Path,
+				"Invalid Attribute Value Format",
+				fmt.Sprintf("Attribute %s is not a valid email address - %s", req.Path, value),
+			),
+		)
+	}
+}
+
+// validateMailAddress accepts a bare address only (user@example.com), since the
+// Coralogix API doesn't accept display names (Name <user@example.com>).
+func validateMailAddress(address string) error {
+	parsed, err := mail.ParseAddress(address)
+	if err != nil {
+		return err
+	}
+	if parsed.Address != address {
+		return fmt.Errorf("expected a bare address such as user@example.com")
+	}
+	return nil
+}
+
 //func urlValidationFuncFramework() schema.SchemaValidateDiagFunc {
 //	return func(v interface{}, _ cty.Path) diag.Diagnostics {
 //		if _, err := url.ParseRequestURI(v.(string)); err != nil {
@@ -618,3 +781,154 @@ func GetKeys[K, V comparable](m map[K]V) []K {
 	}
 	return result
 }
+
+// upgradeObjectFromSDKv2State converts a decoded SDKv2 state object, where every nested block was stored as a list,
+// into a value of the given framework attributes. Unset optional values were stored as their zero value, so they are
+// converted to null.
+func upgradeObjectFromSDKv2State(ctx context.Context, attributes map[string]resourceschema.Attribute, raw map[string]interface{}) (tftypes.Value, error) {
+	attributeTypes := make(map[string]tftypes.Type, len(attributes))
+	values := make(map[string]tftypes.Value, len(attributes))
+	for name, attribute := range attributes {
+		value, err := upgradeAttributeFromSDKv2State(ctx, attribute, raw[name])
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("%s: %w", name, err)
+		}
+		attributeTypes[name] = attribute.GetType().TerraformType(ctx)
+		values[name] = value
+	}
+	return tftypes.NewValue(tftypes.Object{AttributeTypes: attributeTypes}, values), nil
+}
+
+func upgradeAttributeFromSDKv2State(ctx context.Context, attribute resourceschema.Attribute, raw interface{}) (tftypes.Value, error) {
+	attributeType := attribute.GetType().TerraformType(ctx)
+	omittable := attribute.IsOptional() && !attribute.IsComputed()
+
+	switch attribute := attribute.(type) {
+	case resourceschema.SingleNestedAttribute:
+		block := firstSDKv2Block(raw)
+		if block == nil {
+			return tftypes.NewValue(attributeType, nil), nil
+		}
+		return upgradeObjectFromSDKv2State(ctx, attribute.Attributes, block)
+	case resourceschema.ListNestedAttribute:
+		return upgradeNestedCollectionFromSDKv2State(ctx, attributeType, attribute.NestedObject.Attributes, raw)
+	case resourceschema.SetNestedAttribute:
+		return upgradeNestedCollectionFromSDKv2State(ctx, attributeType, attribute.NestedObject.Attributes, raw)
+	case resourceschema.ListAttribute:
+		return upgradeCollectionFromSDKv2State(ctx, attributeType, attribute.ElementType.TerraformType(ctx), raw)
+	case resourceschema.SetAttribute:
+		return upgradeCollectionFromSDKv2State(ctx, attributeType, attribute.ElementType.TerraformType(ctx), raw)
+	case resourceschema.MapAttribute:
+		rawMap, _ := raw.(map[string]interface{})
+		if len(rawMap) == 0 {
+			return tftypes.NewValue(attributeType, nil), nil
+		}
+		elements := make(map[string]tftypes.Value, len(rawMap))
+		for key, element := range rawMap {
+			value, err := upgradePrimitiveFromSDKv2State(attribute.ElementType.TerraformType(ctx), element)
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+			elements[key] = value
+		}
+		return tftypes.NewValue(attributeType, elements), nil
+	}
+
+	value, err := upgradePrimitiveFromSDKv2State(attributeType, raw)
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+	if omittable && isSDKv2ZeroValue(raw) {
+		return tftypes.NewValue(attributeType, nil), nil
+	}
+	return value, nil
+}
+
+func firstSDKv2Block(raw interface{}) map[string]interface{} {
+	switch raw := raw.(type) {
+	case []interface{}:
+		if len(raw) == 0 {
+			return nil
+		}
+		block, _ := raw[0].(map[string]interface{})
+		return block
+	case map[string]interface{}:
+		return raw
+	}
+	return nil
+}
+
+func upgradeNestedCollectionFromSDKv2State(ctx context.Context, collectionType tftypes.Type, attributes map[string]resourceschema.Attribute, raw interface{}) (tftypes.Value, error) {
+	rawElements, _ := raw.([]interface{})
+	if len(rawElements) == 0 {
+		return tftypes.NewValue(collectionType, nil), nil
+	}
+	elements := make([]tftypes.Value, 0, len(rawElements))
+	for _, rawElement := range rawElements {
+		block, _ := rawElement.(map[string]interface{})
+		element, err := upgradeObjectFromSDKv2State(ctx, attributes, block)
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+		elements = append(elements, element)
+	}
+	return tftypes.NewValue(collectionType, elements), nil
+}
+
+func upgradeCollectionFromSDKv2State(_ context.Context, collectionType, elementType tftypes.Type, raw interface{}) (tftypes.Value, error) {
+	rawElements, _ := raw.([]interface{})
+	if len(rawElements) == 0 {
+		return tftypes.NewValue(collectionType, nil), nil
+	}
+	elements := make([]tftypes.Value, 0, len(rawElements))
+	for _, rawElement := range rawElements {
+		element, err := upgradePrimitiveFromSDKv2State(elementType, rawElement)
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+		elements = append(elements, element)
+	}
+	return tftypes.NewValue(collectionType, elements), nil
+}
+
+func upgradePrimitiveFromSDKv2State(valueType tftypes.Type, raw interface{}) (tftypes.Value, error) {
+	if raw == nil {
+		return tftypes.NewValue(valueType, nil), nil
+	}
+
+	switch {
+	case valueType.Is(tftypes.String):
+		if s, ok := raw.(string); ok {
+			return tftypes.NewValue(valueType, s), nil
+		}
+	case valueType.Is(tftypes.Bool):
+		if b, ok := raw.(bool); ok {
+			return tftypes.NewValue(valueType, b), nil
+		}
+	case valueType.Is(tftypes.Number):
+		if n, ok := raw.(json.Number); ok {
+			f, _, err := big.ParseFloat(n.String(), 10, 512, big.ToNearestEven)
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+			return tftypes.NewValue(valueType, f), nil
+		}
+	}
+
+	return tftypes.Value{}, fmt.Errorf("unexpected value %v for type %s", raw, valueType)
+}
+
+func isSDKv2ZeroValue(raw interface{}) bool {
+	switch raw := raw.(type) {
+	case nil:
+		return true
+	case string:
+		return raw == ""
+	case bool:
+		return !raw
+	case json.Number:
+		f, err := raw.Float64()
+		return err == nil && f == 0
+	}
+	return false
+}
@@ -22,45 +22,53 @@ resource "coralogix_alert" "standard_alert" {
     security_severity = "high"
   }
 
-  notifications_group {
-    group_by_fields = ["coralogix.metadata.sdkId", "EventType"]
-    notification {
-      integration_id              = coralogix_webhook.slack_webhook.id
-      retriggering_period_minutes = 60
-    }
-    notification {
-      notify_on                   = "Triggered_and_resolved"
-      email_recipients            = ["example@coralogix.com"]
-      retriggering_period_minutes = 60
-    }
-  }
-  notifications_group {
-    notification {
-      email_recipients            = ["example@coralogix.com"]
-      retriggering_period_minutes = 60
-    }
-  }
-
-  show_in_insights {
+  notifications_group = [
+    {
+      group_by_fields = ["coralogix.metadata.sdkId", "EventType"]
+      notification = [
+        {
+          integration_id              = coralogix_webhook.slack_webhook.id
+          retriggering_period_minutes = 60
+        },
+        {
+          notify_on                   = "Triggered_and_resolved"
+          email_recipients            = ["example@coralogix.com"]
+          retriggering_period_minutes = 60
+        },
+      ]
+    },
+    {
+      notification = [
+        {
+          email_recipients            = ["example@coralogix.com"]
+          retriggering_period_minutes = 60
+        },
+      ]
+    },
+  ]
+
+  show_in_insights = {
     retriggering_period_minutes = 60
     notify_on                   = "Triggered_and_resolved"
   }
 
-  scheduling {
+  scheduling = {
     time_zone = "UTC+2"
-    time_frame {
-      days_enabled = ["Wednesday", "Thursday"]
-      start_time   = "08:30"
-      end_time     = "20:30"
-    }
+    time_frame = [
+      {
+        days_enabled = ["Wednesday", "Thursday"]
+        start_time   = "08:30"
+        end_time     = "20:30"
+      },
+    ]
   }
 
-  standard {
+  standard = {
     applications = ["filter:contains:nginx"] //change here for existing applications from your account
     subsystems   = ["filter:startsWith:subsystem-name"] //change here for existing subsystems from your account
     severities   = ["Warning", "Info"]
     search_query = "remote_addr_enriched:/.*/"
-    condition {
+    condition = {
       more_than         = true
       threshold         = 5
       time_window       = "30Min"
@@ -79,43 +87,47 @@ resource "coralogix_alert" "ratio_alert" {
   description = "Example of ratio alert from terraform"
   severity    = "Critical"
 
-  notifications_group {
-    notification {
-      integration_id              = coralogix_webhook.slack_webhook.id
-      retriggering_period_minutes = 1
-    }
-    notification {
-      notify_on                   = "Triggered_and_resolved"
-      email_recipients            = ["example@coralogix.com"]
-      retriggering_period_minutes = 1
-    }
-  }
-
-  scheduling {
+  notifications_group = [
+    {
+      notification = [
+        {
+          integration_id              = coralogix_webhook.slack_webhook.id
+          retriggering_period_minutes = 1
+        },
+        {
+          notify_on                   = "Triggered_and_resolved"
+          email_recipients            = ["example@coralogix.com"]
+          retriggering_period_minutes = 1
+        },
+      ]
+    },
+  ]
+
+  scheduling = {
     time_zone = "UTC+2"
-    time_frame {
-      days_enabled = ["Wednesday", "Thursday"]
-      start_time   = "08:30"
-      end_time     = "20:30"
-    }
+    time_frame = [
+      {
+        days_enabled = ["Wednesday", "Thursday"]
+        start_time   = "08:30"
+        end_time     = "20:30"
+      },
+    ]
   }
 
-  ratio {
-    query_1 {
-
-    }
-    query_2 {
+  ratio = {
+    query_1 = {}
+    query_2 = {
       applications = ["nginx"] //change here for existing applications from your account
       subsystems   = ["subsystem-name"] //change here for existing subsystems from your account
       severities   = ["Warning"]
     }
-    condition {
+    condition = {
       less_than       = true
       ratio_threshold = 2
       time_window     = "10Min"
       group_by        = ["coralogix.metadata.sdkId"]
       group_by_q1     = true
-      manage_undetected_values {
+      manage_undetected_values = {
         enable_triggering_on_undetected_values = true
         auto_retire_ratio                      = "5Min"
       }
@@ -128,30 +140,36 @@ resource "coralogix_alert" "new_value_alert" {
   description = "Example of new value alert from terraform"
   severity    = "Info"
 
-  notifications_group {
-    notification {
-      integration_id              = coralogix_webhook.slack_webhook.id
-      retriggering_period_minutes = 1
-    }
-    notification {
-      notify_on                   = "Triggered_and_resolved"
-      email_recipients            = ["example@coralogix.com"]
-      retriggering_period_minutes = 1
-    }
-  }
-
-  scheduling {
+  notifications_group = [
+    {
+      notification = [
+        {
+          integration_id              = coralogix_webhook.slack_webhook.id
+          retriggering_period_minutes = 1
+        },
+        {
+          notify_on                   = "Triggered_and_resolved"
+          email_recipients            = ["example@coralogix.com"]
+          retriggering_period_minutes = 1
+        },
+      ]
+    },
+  ]
+
+  scheduling = {
     time_zone = "UTC+2"
-    time_frame {
-      days_enabled = ["Wednesday", "Thursday"]
-      start_time   = "08:30"
-      end_time     = "20:30"
-    }
+    time_frame = [
+      {
+        days_enabled = ["Wednesday", "Thursday"]
+        start_time   = "08:30"
+        end_time     = "20:30"
+      },
+    ]
   }
 
-  new_value {
+  new_value = {
     severities = ["Info"]
-    condition {
+    condition = {
       key_to_track = "remote_addr_geoip.country_name"
       time_window  = "12H"
     }
@@ -163,30 +181,36 @@ resource "coralogix_alert" "time_relative_alert" {
   description = "Example of time relative alert from terraform"
   severity    = "Critical"
 
-  notifications_group {
-    notification {
-      integration_id              = coralogix_webhook.slack_webhook.id
-      retriggering_period_minutes = 1
-    }
-    notification {
-      notify_on                   = "Triggered_and_resolved"
-      email_recipients            = ["example@coralogix.com"]
-      retriggering_period_minutes = 1
-    }
-  }
-
-  scheduling {
+  notifications_group = [
+    {
+      notification = [
+        {
+          integration_id              = coralogix_webhook.slack_webhook.id
+          retriggering_period_minutes = 1
+        },
+        {
+          notify_on                   = "Triggered_and_resolved"
+          email_recipients            = ["example@coralogix.com"]
+          retriggering_period_minutes = 1
+        },
+      ]
+    },
+  ]
+
+  scheduling = {
     time_zone = "UTC+2"
-    time_frame {
-      days_enabled = ["Wednesday", "Thursday"]
-      start_time   = "08:30"
-      end_time     = "20:30"
-    }
+    time_frame = [
+      {
+        days_enabled = ["Wednesday", "Thursday"]
+        start_time   = "08:30"
+        end_time     = "20:30"
+      },
+    ]
   }
 
-  time_relative {
+  time_relative = {
     severities = ["Error"]
-    condition {
+    condition = {
       more_than            = true
       ratio_threshold      = 2
       relative_time_window = "Same_hour_last_week"
@@ -199,31 +223,37 @@ resource "coralogix_alert" "metric_lucene_alert" {
   description = "Example of metric lucene alert from terraform"
   severity    = "Critical"
 
-  notifications_group {
-    notification {
-      integration_id              = coralogix_webhook.slack_webhook.id
-      retriggering_period_minutes = 60
-    }
-    notification {
-      notify_on                   = "Triggered_and_resolved"
-      email_recipients            = ["example@coralogix.com"]
-      retriggering_period_minutes = 60
-    }
-  }
-
-  scheduling {
+  notifications_group = [
+    {
+      notification = [
+        {
+          integration_id              = coralogix_webhook.slack_webhook.id
+          retriggering_period_minutes = 60
+        },
+        {
+          notify_on                   = "Triggered_and_resolved"
+          email_recipients            = ["example@coralogix.com"]
+          retriggering_period_minutes = 60
+        },
+      ]
+    },
+  ]
+
+  scheduling = {
     time_zone = "UTC+2"
-    time_frame {
-      days_enabled = ["Wednesday", "Thursday"]
-      start_time   = "08:30"
-      end_time     = "20:30"
-    }
+    time_frame = [
+      {
+        days_enabled = ["Wednesday", "Thursday"]
+        start_time   = "08:30"
+        end_time     = "20:30"
+      },
+    ]
   }
 
-  metric {
-    lucene {
+  metric = {
+    lucene = {
       search_query = "name:\"Frontend transactions\""
-      condition {
+      condition = {
         metric_field                 = "subsystem"
         arithmetic_operator          = "Percentile"
         arithmetic_operator_modifier = 20
@@ -232,7 +262,7 @@ resource "coralogix_alert" "metric_lucene_alert" {
         threshold                    = 60
         sample_threshold_percentage  = 50
         time_window                  = "30Min"
-        manage_undetected_values {
+        manage_undetected_values = {
           enable_triggering_on_undetected_values = false
         }
       }
@@ -245,31 +275,37 @@ resource "coralogix_alert" "metric_promql_alert" {
   description = "Example of metric promql alert from terraform"
   severity    = "Critical"
 
-  notifications_group {
-    notification {
-      integration_id              = coralogix_webhook.slack_webhook.id
-      retriggering_period_minutes = 1
-    }
-    notification {
-      notify_on                   = "Triggered_and_resolved"
-      email_recipients            = ["example@coralogix.com"]
-      retriggering_period_minutes = 24*60
-    }
-  }
-
-  scheduling {
+  notifications_group = [
+    {
+      notification = [
+        {
+          integration_id              = coralogix_webhook.slack_webhook.id
+          retriggering_period_minutes = 1
+        },
+        {
+          notify_on                   = "Triggered_and_resolved"
+          email_recipients            = ["example@coralogix.com"]
+          retriggering_period_minutes = 24*60
+        },
+      ]
+    },
+  ]
+
+  scheduling = {
     time_zone = "UTC-8"
-    time_frame {
-      days_enabled = ["Wednesday", "Thursday"]
-      start_time   = "08:30"
-      end_time     = "20:30"
-    }
+    time_frame = [
+      {
+        days_enabled = ["Wednesday", "Thursday"]
+        start_time   = "08:30"
+        end_time     = "20:30"
+      },
+    ]
   }
 
-  metric {
-    promql {
+  metric = {
+    promql = {
       search_query = "http_requests_total{status!~\"4..\"}"
-      condition {
+      condition = {
         more_than                       = true
         threshold                       = 3
         sample_threshold_percentage     = 50
@@ -280,36 +316,74 @@ resource "coralogix_alert" "metric_promql_alert" {
   }
 }
 
+resource "coralogix_alert" "metric_promql_anomaly_alert" {
+  name        = "Metric promql anomaly alert example"
+  description = "Example of metric promql more_than_usual alert from terraform"
+  severity    = "Warning"
+
+  notifications_group = [
+    {
+      group_by_fields = ["pod"]
+      notification = [
+        {
+          integration_id              = coralogix_webhook.slack_webhook.id
+          retriggering_period_minutes = 60
+        },
+      ]
+    },
+  ]
+
+  metric = {
+    promql = {
+      search_query = "sum(container_memory_working_set_bytes) by (pod)"
+      condition = {
+        more_than_usual                = true
+        threshold                      = 1000000
+        sample_threshold_percentage    = 50
+        time_window                    = "1H"
+        min_non_null_values_percentage = 80
+        group_by                       = ["pod"]
+      }
+    }
+  }
+}
+
 resource "coralogix_alert" "unique_count_alert" {
   name        = "Unique count alert example"
   description = "Example of unique count alert from terraform"
   severity    = "Info"
 
-  notifications_group {
-    group_by_fields = ["EventType"]
-    notification {
-      integration_id              = coralogix_webhook.slack_webhook.id
-      retriggering_period_minutes = 1
-    }
-    notification {
-      notify_on                   = "Triggered_and_resolved"
-      email_recipients            = ["example@coralogix.com"]
-      retriggering_period_minutes = 1
-    }
-  }
-
-  scheduling {
+  notifications_group = [
+    {
+      group_by_fields = ["EventType"]
+      notification = [
+        {
+          integration_id              = coralogix_webhook.slack_webhook.id
+          retriggering_period_minutes = 1
+        },
+        {
+          notify_on                   = "Triggered_and_resolved"
+          email_recipients            = ["example@coralogix.com"]
+          retriggering_period_minutes = 1
+        },
+      ]
+    },
+  ]
+
+  scheduling = {
     time_zone = "UTC+2"
-    time_frame {
-      days_enabled = ["Wednesday", "Thursday"]
-      start_time   = "08:30"
-      end_time     = "20:30"
-    }
+    time_frame = [
+      {
+        days_enabled = ["Wednesday", "Thursday"]
+        start_time   = "08:30"
+        end_time     = "20:30"
+      },
+    ]
   }
 
-  unique_count {
+  unique_count = {
     severities = ["Info"]
-    condition {
+    condition = {
       unique_count_key               = "remote_addr_geoip.country_name"
       max_unique_values              = 2
       time_window                    = "10Min"
@@ -324,29 +398,35 @@ resource "coralogix_alert" "tracing_alert" {
   description = "Example of tracing alert from terraform"
   severity    = "Info"
 
-  notifications_group {
-    notification {
-      notify_on                   = "Triggered_and_resolved"
-      email_recipients            = ["user@example.com"]
-      retriggering_period_minutes = 1
-    }
-    notification {
-      notify_on                   = "Triggered_and_resolved"
-      integration_id              = coralogix_webhook.slack_webhook.id
-      retriggering_period_minutes = 1
-    }
-  }
-
-  scheduling {
+  notifications_group = [
+    {
+      notification = [
+        {
+          notify_on                   = "Triggered_and_resolved"
+          email_recipients            = ["user@example.com"]
+          retriggering_period_minutes = 1
+        },
+        {
+          notify_on                   = "Triggered_and_resolved"
+          integration_id              = coralogix_webhook.slack_webhook.id
+          retriggering_period_minutes = 1
+        },
+      ]
+    },
+  ]
+
+  scheduling = {
     time_zone = "UTC+2"
-    time_frame {
-      days_enabled = ["Wednesday", "Thursday"]
-      start_time   = "08:30"
-      end_time     = "20:30"
-    }
+    time_frame = [
+      {
+        days_enabled = ["Wednesday", "Thursday"]
+        start_time   = "08:30"
+        end_time     = "20:30"
+      },
+    ]
   }
 
-  tracing {
+  tracing = {
     latency_threshold_milliseconds = 20.5
     applications                   = [
       "application_name", "filter:contains:application-name2", "filter:endsWith:application-name3",
@@ -360,15 +440,17 @@ resource "coralogix_alert" "tracing_alert" {
     services = [
       "serviceName", "filter:contains:serviceName", "filter:endsWith:serviceName", "filter:startsWith:serviceName"
     ]
-    tag_filter {
-      field  = "status"
-      values = ["filter:contains:400", "500"]
-    }
-    tag_filter {
-      field  = "key"
-      values = ["value"]
-    }
-    condition {
+    tag_filter = [
+      {
+        field  = "status"
+        values = ["filter:contains:400", "500"]
+      },
+      {
+        field  = "key"
+        values = ["value"]
+      },
+    ]
+    condition = {
       more_than   = true
       time_window = "5Min"
       threshold   = 2
@@ -381,68 +463,86 @@ resource "coralogix_alert" "flow_alert" {
   description = "Example of flow alert from terraform"
   severity    = "Info"
 
-  notifications_group {
-    notification {
-      notify_on                   = "Triggered_and_resolved"
-      email_recipients            = ["user@example.com"]
-      retriggering_period_minutes = 1
-    }
-    notification {
-      notify_on                   = "Triggered_and_resolved"
-      integration_id              = coralogix_webhook.slack_webhook.id
-      retriggering_period_minutes = 1
-    }
-  }
-
-  scheduling {
+  notifications_group = [
+    {
+      notification = [
+        {
+          notify_on                   = "Triggered_and_resolved"
+          email_recipients            = ["user@example.com"]
+          retriggering_period_minutes = 1
+        },
+        {
+          notify_on                   = "Triggered_and_resolved"
+          integration_id              = coralogix_webhook.slack_webhook.id
+          retriggering_period_minutes = 1
+        },
+      ]
+    },
+  ]
+
+  scheduling = {
     time_zone = "UTC+2"
-    time_frame {
-      days_enabled = ["Wednesday", "Thursday"]
-      start_time   = "08:30"
-      end_time     = "20:30"
-    }
+    time_frame = [
+      {
+        days_enabled = ["Wednesday", "Thursday"]
+        start_time   = "08:30"
+        end_time     = "20:30"
+      },
+    ]
   }
 
-  flow {
-    stage {
-      group {
-        sub_alerts {
-          operator = "OR"
-          flow_alert {
-            user_alert_id = coralogix_alert.new_value_alert.id
-          }
+  flow = {
+    stage = [
+      {
+        group = [
+          {
+            sub_alerts = {
+              operator = "OR"
+              flow_alert = [
+                {
+                  user_alert_id = coralogix_alert.new_value_alert.id
+                },
+              ]
+            }
+            next_operator = "OR"
+          },
+          {
+            sub_alerts = {
+              operator = "AND"
+              flow_alert = [
+                {
+                  not           = true
+                  user_alert_id = coralogix_alert.unique_count_alert.id
+                },
+              ]
+            }
+            next_operator = "AND"
+          },
+        ]
+        time_window = {
+          minutes = 20
         }
-        next_operator = "OR"
-      }
-      group {
-        sub_alerts {
-          operator = "AND"
-          flow_alert {
-            not           = true
-            user_alert_id = coralogix_alert.unique_count_alert.id
-          }
-        }
-        next_operator = "AND"
-      }
-      time_window {
-        minutes = 20
-      }
-    }
-    stage {
-      group {
-        sub_alerts {
-          operator = "AND"
-          flow_alert {
-            user_alert_id = coralogix_alert.standard_alert.id
-          }
-          flow_alert {
-            not           = true
-            user_alert_id = coralogix_alert.metric_promql_alert.id
-          }
-        }
-        next_operator = "OR"
-      }
-    }
+      },
+      {
+        group = [
+          {
+            sub_alerts = {
+              operator = "AND"
+              flow_alert = [
+                {
+                  user_alert_id = coralogix_alert.standard_alert.id
+                },
+                {
+                  not           = true
+                  user_alert_id = coralogix_alert.metric_promql_alert.id
+                },
+              ]
+            }
+            next_operator = "OR"
+          },
+        ]
+      },
+    ]
   }
 }
 
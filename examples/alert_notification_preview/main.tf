@@ -0,0 +1,54 @@
+terraform {
+  required_providers {
+    coralogix = {
+      version = "~> 1.5"
+      source  = "coralogix/coralogix"
+    }
+  }
+}
+
+provider "coralogix" {
+  #api_key = "<add your api key here or add env variable CORALOGIX_API_KEY>"
+  #env = "<add the environment you want to work at or add env variable CORALOGIX_ENV>"
+}
+
+resource "coralogix_webhook" "custom_webhook" {
+  name = "custom-webhook"
+  custom {
+    url     = "https://example-url.com/"
+    method  = "post"
+    headers = jsonencode({ "Content-Type" : "application/json" })
+    payload = jsonencode({
+      "name" : "$ALERT_NAME",
+      "description" : "$ALERT_DESCRIPTION",
+      "application" : "$APPLICATION_NAME",
+      "hits" : "$HIT_COUNT",
+      "log" : "$LOG_TEXT",
+    })
+  }
+}
+
+data "coralogix_alert_notification_preview" "custom_webhook_preview" {
+  alert {
+    name        = "Errors in payments"
+    description = "$HIT_COUNT errors in $SUBSYSTEM_NAME"
+    meta_labels = {
+      team = "payments"
+    }
+  }
+  webhook {
+    type    = "custom"
+    payload = coralogix_webhook.custom_webhook.custom[0].payload
+  }
+  sample_values = {
+    SUBSYSTEM_NAME = "payments-api"
+  }
+}
+
+output "rendered_payload" {
+  value = data.coralogix_alert_notification_preview.custom_webhook_preview.rendered_payload
+}
+
+output "unknown_placeholders" {
+  value = data.coralogix_alert_notification_preview.custom_webhook_preview.unknown_placeholders
+}
@@ -0,0 +1,72 @@
+terraform {
+  required_providers {
+    coralogix = {
+      version = "~> 1.5"
+      source  = "coralogix/coralogix"
+    }
+  }
+}
+
+provider "coralogix" {
+  #api_key = "<add your api key here or add env variable CORALOGIX_API_KEY>"
+  #env = "<add the environment you want to work at or add env variable CORALOGIX_ENV>"
+}
+
+data "coralogix_dashboard_widget" "status_4xx" {
+  title = "status 4XX"
+  definition {
+    line_chart {
+      query_definition {
+        query {
+          metrics {
+            promql_query = "http_requests_total{status!~\"4..\"}"
+          }
+        }
+      }
+      legend {
+        is_visible = true
+        column     = ["Max", "Last"]
+      }
+    }
+  }
+}
+
+data "coralogix_dashboard_widget" "errors_count" {
+  title = "errors count"
+  definition {
+    line_chart {
+      query_definition {
+        query {
+          logs {
+            lucene_query = "coralogix.metadata.severity=5"
+            aggregations {
+              count {
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}
+
+data "coralogix_dashboard_section" "overview" {
+  row {
+    height       = 19
+    widgets_json = [
+      data.coralogix_dashboard_widget.status_4xx.json,
+      data.coralogix_dashboard_widget.errors_count.json,
+    ]
+  }
+}
+
+data "coralogix_dashboard_document" "service" {
+  name                = "service overview"
+  description         = "composed from coralogix_dashboard_widget and coralogix_dashboard_section"
+  sections_json       = [data.coralogix_dashboard_section.overview.json]
+  relative_time_frame = "1h"
+}
+
+resource "coralogix_dashboard" "service" {
+  content_json = data.coralogix_dashboard_document.service.content_json
+}
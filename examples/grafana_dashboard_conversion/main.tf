@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    coralogix = {
+      version = "~> 1.5"
+      source  = "coralogix/coralogix"
+    }
+  }
+}
+
+provider "coralogix" {
+  #api_key = "<add your api key here or add env variable CORALOGIX_API_KEY>"
+  #env = "<add the environment you want to work at or add env variable CORALOGIX_ENV>"
+}
+
+data "coralogix_grafana_dashboard_conversion" "service_overview" {
+  grafana_json = file("${path.module}/grafana_dashboard.json")
+}
+
+resource "coralogix_dashboard" "service_overview" {
+  content_json = data.coralogix_grafana_dashboard_conversion.service_overview.content_json
+}
+
+output "unconverted_panels" {
+  value = data.coralogix_grafana_dashboard_conversion.service_overview.unconverted_panels
+}
@@ -0,0 +1,61 @@
+terraform {
+  required_providers {
+    coralogix = {
+      version = "~> 1.5"
+      source  = "coralogix/coralogix"
+    }
+  }
+}
+
+provider "coralogix" {
+  #api_key = "<add your api key here or add env variable CORALOGIX_API_KEY>"
+  #env = "<add the environment you want to work at or add env variable CORALOGIX_ENV>"
+}
+
+data "coralogix_grok_pattern" "apache_access_log" {
+  pattern = "%%{COMBINEDAPACHELOG}"
+}
+
+data "coralogix_grok_pattern" "checkout_log" {
+  pattern = "%%{TIMESTAMP_ISO8601:timestamp} %%{LOGLEVEL:level} \\[%%{ORDER_ID:[order][id]}\\] %%{GREEDYDATA:message}"
+  custom_patterns = {
+    ORDER_ID = "ORD-%%{INT}"
+  }
+}
+
+resource "coralogix_rules_group" "grok_parsing" {
+  name         = "Grok parsing"
+  description  = "Parse rules migrated from Logstash grok filters"
+  applications = ["nginx", "checkout"]
+
+  rule_subgroups = [
+    {
+      rules = [
+        {
+          parse = {
+            name               = "apache access log"
+            source_field       = "text"
+            destination_field  = "text"
+            regular_expression = data.coralogix_grok_pattern.apache_access_log.regular_expression
+          }
+        }
+      ]
+    },
+    {
+      rules = [
+        {
+          parse = {
+            name               = "checkout log"
+            source_field       = "text"
+            destination_field  = "text"
+            regular_expression = data.coralogix_grok_pattern.checkout_log.regular_expression
+          }
+        }
+      ]
+    }
+  ]
+}
+
+output "checkout_log_fields" {
+  value = data.coralogix_grok_pattern.checkout_log.field_names
+}
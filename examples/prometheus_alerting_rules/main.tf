@@ -0,0 +1,43 @@
+terraform {
+  required_providers {
+    coralogix = {
+      version = "~> 1.5"
+      source  = "coralogix/coralogix"
+    }
+  }
+}
+
+provider "coralogix" {
+  #api_key = "<add your api key here or add env variable CORALOGIX_API_KEY>"
+  #env = "<add the environment you want to work at or add env variable CORALOGIX_ENV>"
+}
+
+data "coralogix_prometheus_alerting_rules" "rules" {
+  yaml_content = file("${path.module}/alerting-rules.yaml")
+}
+
+resource "coralogix_alert" "prometheus" {
+  for_each = { for alert in data.coralogix_prometheus_alerting_rules.rules.alert : alert.name => alert }
+
+  name        = each.value.name
+  description = each.value.description
+  severity    = each.value.severity
+  meta_labels = each.value.meta_labels
+
+  metric = {
+    promql = {
+      search_query = each.value.search_query
+      condition = {
+        more_than                   = each.value.condition == "more_than" ? true : null
+        less_than                   = each.value.condition == "less_than" ? true : null
+        threshold                   = each.value.threshold
+        time_window                 = each.value.time_window
+        sample_threshold_percentage = 50
+      }
+    }
+  }
+}
+
+output "unconverted_rules" {
+  value = data.coralogix_prometheus_alerting_rules.rules.unconverted_rules
+}
@@ -20,78 +20,77 @@ resource "coralogix_rules_group" "rules_group_example" {
   severities   = ["Warning"]
   order        = 1
 
-  rule_subgroups {
-    rules {
-      extract {
-        name               = "Severity Rule"
-        description        = "Look for default severity text"
-        source_field       = "text"
-        regular_expression = "message\\s*:s*(?P<bytes>\\d+)\\s*.*?status\\sis\\s(?P<status>\\[^\"]+)"
-      }
+  rule_subgroups = [
+    {
+      rules = [
+        {
+          extract = {
+            name               = "Severity Rule"
+            description        = "Look for default severity text"
+            source_field       = "text"
+            regular_expression = "message\\s*:s*(?P<bytes>\\d+)\\s*.*?status\\sis\\s(?P<status>\\[^\"]+)"
+          }
+        },
+        {
+          json_extract = {
+            name              = "Worker to category"
+            description       = "Extracts value from 'worker' and populates 'Category'"
+            json_key          = "worker"
+            destination_field = "Category"
+          }
+        },
+        {
+          replace = {
+            name               = "Delete prefix"
+            description        = "Deletes data before Json"
+            source_field       = "text"
+            destination_field  = "text"
+            replacement_string = "{"
+            regular_expression = ".*{"
+          }
+        },
+        {
+          block = {
+            name               = "Block 28000"
+            description        = "Block 2800 pg error"
+            source_field       = "text"
+            regular_expression = "sql_error_code\\s*=\\s*28000"
+          }
+        }
+      ]
+    },
+    {
+      rules = [
+        {
+          parse = {
+            name               = "HttpRequestParser1"
+            description        = "Parse the fields of the HTTP request"
+            source_field       = "text"
+            destination_field  = "text"
+            regular_expression = "(?P<remote_addr>\\d{1,3}.\\d{1,3}.\\d{1,3}.\\d{1,3})\\s*-\\s*(?P<user>[^ ]+)\\s*\\[(?P<timestemp>\\d{4}-\\d{2}\\-\\d{2}T\\d{2}\\:\\d{2}\\:\\d{2}\\.\\d{1,6}Z)\\]\\s*\\\\\\\"(?P<method>[A-z]+)\\s[\\/\\\\]+(?P<request>[^\\s]+)\\s*(?P<protocol>[A-z0-9\\/\\.]+)\\\\\\\"\\s*(?P<status>\\d+)\\s*(?P<body_bytes_sent>\\d+)?\\s*?\\\\\\\"(?P<http_referer>[^\"]+)\\\"\\s*\\\\\\\"(?P<http_user_agent>[^\"]+)\\\"\\s(?P<request_time>\\d{1,6})\\s*(?P<response_time>\\d{1,6})"
+          }
+        },
+        {
+          parse = {
+            name               = "HttpRequestParser2"
+            description        = "Parse the fields of the HTTP request - will be applied after HttpRequestParser1"
+            source_field       = "text"
+            destination_field  = "text"
+            regular_expression = "(?P<remote_addr>\\d{1,3}.\\d{1,3}.\\d{1,3}.\\d{1,3})\\s*-\\s*(?P<user>[^ ]+)\\s*\\[(?P<timestemp>\\d{4}-\\d{2}\\-\\d{2}T\\d{2}\\:\\d{2}\\:\\d{2}\\.\\d{1,6}Z)\\]\\s*\\\\\\\"(?P<method>[A-z]+)\\s[\\/\\\\]+(?P<request>[^\\s]+)\\s*(?P<protocol>[A-z0-9\\/\\.]+)\\\\\\\"\\s*(?P<status>\\d+)\\s*(?P<body_bytes_sent>\\d+)?\\s*?\\\\\\\"(?P<http_referer>[^\"]+)\\\"\\s*\\\\\\\"(?P<http_user_agent>[^\"]+)\\\"\\s(?P<request_time>\\d{1,6})\\s*(?P<response_time>\\d{1,6})"
+          }
+        },
+        {
+          json_stringify = {
+            name              = "json_stringify rule"
+            source_field      = "text"
+            destination_field = "text"
+            keep_source_field = false
+            #for better example look at - https://coralogix.com/docs/log-parsing-rules/#stringify-json-fields
+          }
+        }
+      ]
     }
-
-    rules {
-      json_extract {
-        name              = "Worker to category"
-        description       = "Extracts value from 'worker' and populates 'Category'"
-        json_key          = "worker"
-        destination_field = "Category"
-      }
-    }
-
-    rules {
-      replace {
-        name               = "Delete prefix"
-        description        = "Deletes data before Json"
-        source_field       = "text"
-        destination_field  = "text"
-        replacement_string = "{"
-        regular_expression = ".*{"
-      }
-    }
-
-    rules {
-      block {
-        name               = "Block 28000"
-        description        = "Block 2800 pg error"
-        source_field       = "text"
-        regular_expression = "sql_error_code\\s*=\\s*28000"
-      }
-    }
-  }
-
-  rule_subgroups {
-    rules {
-      parse {
-        name               = "HttpRequestParser1"
-        description        = "Parse the fields of the HTTP request"
-        source_field       = "text"
-        destination_field  = "text"
-        regular_expression = "(?P<remote_addr>\\d{1,3}.\\d{1,3}.\\d{1,3}.\\d{1,3})\\s*-\\s*(?P<user>[^ ]+)\\s*\\[(?P<timestemp>\\d{4}-\\d{2}\\-\\d{2}T\\d{2}\\:\\d{2}\\:\\d{2}\\.\\d{1,6}Z)\\]\\s*\\\\\\\"(?P<method>[A-z]+)\\s[\\/\\\\]+(?P<request>[^\\s]+)\\s*(?P<protocol>[A-z0-9\\/\\.]+)\\\\\\\"\\s*(?P<status>\\d+)\\s*(?P<body_bytes_sent>\\d+)?\\s*?\\\\\\\"(?P<http_referer>[^\"]+)\\\"\\s*\\\\\\\"(?P<http_user_agent>[^\"]+)\\\"\\s(?P<request_time>\\d{1,6})\\s*(?P<response_time>\\d{1,6})"
-      }
-    }
-
-    rules {
-      parse {
-        name               = "HttpRequestParser2"
-        description        = "Parse the fields of the HTTP request - will be applied after HttpRequestParser1"
-        source_field       = "text"
-        destination_field  = "text"
-        regular_expression = "(?P<remote_addr>\\d{1,3}.\\d{1,3}.\\d{1,3}.\\d{1,3})\\s*-\\s*(?P<user>[^ ]+)\\s*\\[(?P<timestemp>\\d{4}-\\d{2}\\-\\d{2}T\\d{2}\\:\\d{2}\\:\\d{2}\\.\\d{1,6}Z)\\]\\s*\\\\\\\"(?P<method>[A-z]+)\\s[\\/\\\\]+(?P<request>[^\\s]+)\\s*(?P<protocol>[A-z0-9\\/\\.]+)\\\\\\\"\\s*(?P<status>\\d+)\\s*(?P<body_bytes_sent>\\d+)?\\s*?\\\\\\\"(?P<http_referer>[^\"]+)\\\"\\s*\\\\\\\"(?P<http_user_agent>[^\"]+)\\\"\\s(?P<request_time>\\d{1,6})\\s*(?P<response_time>\\d{1,6})"
-      }
-    }
-
-    rules {
-      json_stringify {
-        name              = "json_stringify rule"
-        source_field      = "text"
-        destination_field = "text"
-        keep_source_field = "false"
-        #for better example look at - https://coralogix.com/docs/log-parsing-rules/#stringify-json-fields
-      }
-    }
-
-  }
+  ]
 }
 
 data "coralogix_rules_group" "imported_rules_group_example" {
@@ -106,17 +105,21 @@ resource "coralogix_rules_group" "extract_timestamp_example" {
   severities   = ["Warning"]
   order        = 4
 
-  rule_subgroups {
-    rules {
-      extract_timestamp {
-        name                  = "example extract-timestamp rule from terraform"
-        description           = "rule created by coralogix terraform provider"
-        source_field          = "text"
-        field_format_standard = "Strftime"
-        time_format           = "%Y-%m-%dT%H:%M:%S.%f%z"
-      }
+  rule_subgroups = [
+    {
+      rules = [
+        {
+          extract_timestamp = {
+            name                  = "example extract-timestamp rule from terraform"
+            description           = "rule created by coralogix terraform provider"
+            source_field          = "text"
+            field_format_standard = "Strftime"
+            time_format           = "%Y-%m-%dT%H:%M:%S.%f%z"
+          }
+        }
+      ]
     }
-  }
+  ]
 }
 
 resource "coralogix_rules_group" "remove_fields_example" {
@@ -126,15 +129,20 @@ resource "coralogix_rules_group" "remove_fields_example" {
   subsystems   = ["subsystem-name"] //change here for existing subsystems from your account
   severities   = ["Warning"]
   order        = 3
-  rule_subgroups {
-    rules {
-      remove_fields {
-        name            = "Example remove-fields rule from terraform"
-        description     = "rule created by coralogix terraform provider"
-        excluded_fields = ["coralogix.metadata.applicationName", "coralogix.metadata.className"]
-      }
+
+  rule_subgroups = [
+    {
+      rules = [
+        {
+          remove_fields = {
+            name            = "Example remove-fields rule from terraform"
+            description     = "rule created by coralogix terraform provider"
+            excluded_fields = ["coralogix.metadata.applicationName", "coralogix.metadata.className"]
+          }
+        }
+      ]
     }
-  }
+  ]
 }
 
 resource "coralogix_rules_group" "parse_json_field_example" {
@@ -143,17 +151,22 @@ resource "coralogix_rules_group" "parse_json_field_example" {
   applications = ["nginx"] //change here for existing applications from your account
   subsystems   = ["subsystem-name"] //change here for existing subsystems from your account
   severities   = ["Warning"]
-  order = 2
-  rule_subgroups {
-    rules {
-      parse_json_field {
-        name                   = "Example parse-json-field rule from terraform"
-        description            = "rule created by coralogix terraform provider"
-        source_field           = "text"
-        destination_field      = "text"
-        keep_source_field      = "true"
-        keep_destination_field = "true"
-      }
+  order        = 2
+
+  rule_subgroups = [
+    {
+      rules = [
+        {
+          parse_json_field = {
+            name                   = "Example parse-json-field rule from terraform"
+            description            = "rule created by coralogix terraform provider"
+            source_field           = "text"
+            destination_field      = "text"
+            keep_source_field      = true
+            keep_destination_field = true
+          }
+        }
+      ]
     }
-  }
+  ]
 }
\ No newline at end of file
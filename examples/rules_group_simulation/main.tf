@@ -0,0 +1,61 @@
+terraform {
+  required_providers {
+    coralogix = {
+      version = "~> 1.5"
+      source  = "coralogix/coralogix"
+    }
+  }
+}
+
+provider "coralogix" {
+  #api_key = "<add your api key here or add env variable CORALOGIX_API_KEY>"
+  #env = "<add the environment you want to work at or add env variable CORALOGIX_ENV>"
+}
+
+data "coralogix_rules_group_simulation" "nginx" {
+  rules_group = {
+    name         = "nginx"
+    applications = ["nginx"]
+
+    rule_subgroups = [
+      {
+        rules = [
+          {
+            block = {
+              name               = "block healthchecks"
+              source_field       = "text"
+              regular_expression = "healthcheck"
+            }
+          },
+          {
+            parse = {
+              name               = "parse access logs"
+              source_field       = "text"
+              destination_field  = "text"
+              regular_expression = "^(?P<ip>\\S+) (?P<method>[A-Z]+) (?P<path>\\S+) (?P<status>\\d+)$"
+            }
+          }
+        ]
+      }
+    ]
+  }
+
+  logs = [
+    {
+      text             = "GET /healthcheck"
+      application_name = "nginx"
+    },
+    {
+      text             = "10.0.0.1 GET /index 500"
+      application_name = "nginx"
+    }
+  ]
+}
+
+output "parsed_access_log" {
+  value = jsondecode(data.coralogix_rules_group_simulation.nginx.result[1].text)
+}
+
+output "healthcheck_blocked" {
+  value = data.coralogix_rules_group_simulation.nginx.result[0].blocked
+}
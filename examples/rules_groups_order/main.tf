@@ -0,0 +1,60 @@
+terraform {
+  required_providers {
+    coralogix = {
+      version = "~> 1.5"
+      source  = "coralogix/coralogix"
+    }
+  }
+}
+
+provider "coralogix" {
+  #api_key = "<add your api key here or add env variable CORALOGIX_API_KEY>"
+  #env = "<add the environment you want to work at or add env variable CORALOGIX_ENV>"
+}
+
+resource "coralogix_rules_group" "block_healthchecks" {
+  name         = "Block healthchecks"
+  description  = "Drop load-balancer healthcheck logs"
+  applications = ["nginx"]
+
+  rule_subgroups = [
+    {
+      rules = [
+        {
+          block = {
+            name               = "healthcheck"
+            source_field       = "text"
+            regular_expression = "GET /healthz"
+          }
+        }
+      ]
+    }
+  ]
+}
+
+resource "coralogix_rules_group" "parse_nginx" {
+  name         = "Parse nginx"
+  description  = "Extract the request fields"
+  applications = ["nginx"]
+
+  rule_subgroups = [
+    {
+      rules = [
+        {
+          extract = {
+            name               = "request"
+            source_field       = "text"
+            regular_expression = "(?P<method>[A-Z]+) (?P<path>\\S+) HTTP/(?P<protocol>\\S+)"
+          }
+        }
+      ]
+    }
+  ]
+}
+
+resource "coralogix_rules_groups_order" "nginx" {
+  rule_groups_ids = [
+    coralogix_rules_group.block_healthchecks.id,
+    coralogix_rules_group.parse_nginx.id,
+  ]
+}
@@ -0,0 +1,42 @@
+terraform {
+  required_providers {
+    coralogix = {
+      version = "~> 1.5"
+      source  = "coralogix/coralogix"
+    }
+  }
+}
+
+provider "coralogix" {
+  #api_key = "<add your api key here or add env variable CORALOGIX_API_KEY>"
+  #env = "<add the environment you want to work at or add env variable CORALOGIX_ENV>"
+}
+
+resource "coralogix_action" "google_search" {
+  is_private  = false
+  source_type = "Log"
+  name        = "google search action"
+  url         = "https://www.google.com/search?q={{$p.selected_value}}"
+}
+
+resource "coralogix_webhook" "slack_webhook" {
+  name = "slack-webhook"
+  slack {
+    url = "https://join.slack.com/example"
+  }
+}
+
+data "coralogix_unmanaged_objects" "drift" {
+  resource_types = ["coralogix_action", "coralogix_webhook"]
+  managed_ids = {
+    coralogix_action  = [coralogix_action.google_search.id]
+    coralogix_webhook = [coralogix_webhook.slack_webhook.id]
+  }
+}
+
+check "no_unmanaged_objects" {
+  assert {
+    condition     = length(data.coralogix_unmanaged_objects.drift.objects) == 0
+    error_message = "Objects not managed by Terraform: ${join(", ", [for object in data.coralogix_unmanaged_objects.drift.objects : "${object.resource_type}.${object.id} (${object.name})"])}"
+  }
+}
@@ -23,6 +23,10 @@ data "coralogix_webhook" "imported_coralogix_webhook_example" {
   id = coralogix_webhook.slack_webhook.id
 }
 
+data "coralogix_webhook" "imported_by_name_coralogix_webhook_example" {
+  name = coralogix_webhook.slack_webhook.name
+}
+
 resource "coralogix_webhook" "custom_webhook" {
   name = "custom-webhook"
   custom {
@@ -244,16 +248,20 @@ resource "coralogix_alert" "standard_alert" {
   description    = "Example of standard alert from terraform"
   severity = "Critical"
 
-  notifications_group {
-    notification {
-      integration_id              = coralogix_webhook.slack_webhook.id
-      retriggering_period_minutes = 60
-    }
-  }
+  notifications_group = [
+    {
+      notification = [
+        {
+          integration_id              = coralogix_webhook.slack_webhook.id
+          retriggering_period_minutes = 60
+        },
+      ]
+    },
+  ]
 
-  standard {
+  standard = {
     search_query = "remote_addr_enriched:/.*/"
-    condition {
+    condition = {
       immediately = true
     }
   }
@@ -3,6 +3,9 @@ package main
 import (
 	"context"
 	"log"
+	"os"
+	// Embedded time zone database, so IANA time zones resolve on hosts without one.
+	_ "time/tzdata"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
@@ -18,6 +21,13 @@ import (
 func main() {
 	ctx := context.Background()
 
+	if len(os.Args) > 1 && os.Args[1] == "generate" {
+		if err := coralogix.Generate(ctx, os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	oldProvider, _ := tf5to6server.UpgradeServer(ctx, coralogix.OldProvider().GRPCProvider)
 
 	providers := []func() tfprotov6.ProviderServer{